@@ -0,0 +1,117 @@
+// Package archiver provides a service that archives approved attestations (with signatures)
+// streamed from the consensus chain into a local database. It serves them via the same
+// attest Query gRPC API as halo, independently of halo state pruning.
+package archiver
+
+import (
+	"context"
+
+	"github.com/omni-network/omni/lib/buildinfo"
+	"github.com/omni-network/omni/lib/cchain"
+	cprovider "github.com/omni-network/omni/lib/cchain/provider"
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/log"
+	"github.com/omni-network/omni/lib/netconf"
+	"github.com/omni-network/omni/lib/xchain"
+
+	"github.com/cometbft/cometbft/rpc/client"
+	"github.com/cometbft/cometbft/rpc/client/http"
+
+	dbm "github.com/cosmos/cosmos-db"
+)
+
+func Run(ctx context.Context, cfg Config) error {
+	log.Info(ctx, "Starting archiver")
+
+	buildinfo.Instrument(ctx)
+
+	// Start metrics first, so app is "up"
+	monitorChan := serveMonitoring(cfg.MonitoringAddr)
+
+	tmClient, err := newClient(cfg.HaloURL)
+	if err != nil {
+		return err
+	}
+
+	cprov := cprovider.NewABCI(tmClient, cfg.Network)
+
+	network, err := netconf.AwaitOnConsensusChain(ctx, cfg.Network, cprov, nil)
+	if err != nil {
+		return err
+	}
+
+	db, err := initializeDB(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	store := newStore(db)
+
+	for _, chain := range network.Chains {
+		for _, chainVer := range chain.ChainVersions() {
+			if err := startArchiving(ctx, cprov, store, chainVer, network.ChainVersionName(chainVer)); err != nil {
+				return err
+			}
+		}
+	}
+
+	grpcChan := serveGRPC(cfg.GRPCAddr, store)
+
+	select {
+	case <-ctx.Done():
+		log.Info(ctx, "Shutdown detected, stopping...")
+		return nil
+	case err := <-monitorChan:
+		return err
+	case err := <-grpcChan:
+		return err
+	}
+}
+
+// startArchiving starts streaming approved attestations of the chain version into the store,
+// resuming from the latest archived attestation.
+func startArchiving(ctx context.Context, cprov cchain.Provider, store *store, chainVer xchain.ChainVersion, name string) error {
+	fromOffset := uint64(1)
+	latest, ok, err := store.Latest(chainVer)
+	if err != nil {
+		return errors.Wrap(err, "latest archived attestation", "chain_version", name)
+	} else if ok {
+		fromOffset = latest.AttestHeader.AttestOffset + 1
+	}
+
+	log.Info(ctx, "Archiving attestations", "chain_version", name, "from_offset", fromOffset)
+
+	cprov.StreamAsync(ctx, chainVer, fromOffset, "archiver", func(_ context.Context, att xchain.Attestation) error {
+		if err := store.Add(att); err != nil {
+			return errors.Wrap(err, "archive attestation", "offset", att.AttestOffset)
+		}
+
+		archivedOffset.WithLabelValues(name).Set(float64(att.AttestOffset))
+
+		return nil
+	})
+
+	return nil
+}
+
+func newClient(tmNodeAddr string) (client.Client, error) {
+	c, err := http.New("tcp://"+tmNodeAddr, "/websocket")
+	if err != nil {
+		return nil, errors.Wrap(err, "new tendermint client")
+	}
+
+	return c, nil
+}
+
+func initializeDB(ctx context.Context, cfg Config) (dbm.DB, error) {
+	if cfg.DBDir == "" {
+		log.Warn(ctx, "No --db-dir provided, using in-memory DB", nil)
+		return dbm.NewMemDB(), nil
+	}
+
+	db, err := dbm.NewGoLevelDB("archive", cfg.DBDir, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "new golevel db")
+	}
+
+	return db, nil
+}
@@ -0,0 +1,23 @@
+package archiver
+
+import (
+	"github.com/omni-network/omni/lib/netconf"
+)
+
+type Config struct {
+	Network        netconf.ID
+	HaloURL        string
+	GRPCAddr       string
+	MonitoringAddr string
+	DBDir          string
+}
+
+func DefaultConfig() Config {
+	return Config{
+		Network:        "",
+		HaloURL:        "localhost:26657",
+		GRPCAddr:       ":9090",
+		MonitoringAddr: ":26660",
+		DBDir:          "./db",
+	}
+}
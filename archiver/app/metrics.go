@@ -0,0 +1,13 @@
+package archiver
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var archivedOffset = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Namespace: "archiver",
+	Subsystem: "store",
+	Name:      "archived_offset",
+	Help:      "The latest archived attestation offset per source chain version. Alert if not growing",
+}, []string{"chain_version"})
@@ -0,0 +1,39 @@
+package archiver
+
+import (
+	"net/http"
+	"net/http/pprof"
+	"time"
+
+	"github.com/omni-network/omni/lib/errors"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// serveMonitoring starts a goroutine that serves the monitoring API.
+// It returns a channel that will receive an error if the server fails to start.
+func serveMonitoring(address string) <-chan error {
+	errChan := make(chan error)
+	go func() {
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", promhttp.Handler())
+
+		// Copied from net/http/pprof/pprof.go
+		mux.HandleFunc("/debug/pprof/", pprof.Index)
+		mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+		mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+		mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+		mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+
+		srv := &http.Server{
+			Addr:              address,
+			ReadHeaderTimeout: 5 * time.Second,
+			IdleTimeout:       5 * time.Second,
+			WriteTimeout:      5 * time.Second,
+			Handler:           mux,
+		}
+		errChan <- errors.Wrap(srv.ListenAndServe(), "serve monitoring")
+	}()
+
+	return errChan
+}
@@ -0,0 +1,96 @@
+package archiver
+
+import (
+	"context"
+	"net"
+
+	atypes "github.com/omni-network/omni/halo/attest/types"
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/xchain"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ atypes.QueryServer = (*server)(nil)
+
+// attestationsFromLimit matches the halo attest keeper's limit.
+const attestationsFromLimit = 100
+
+// server serves archived attestations via the halo attest Query gRPC API.
+// Only approved attestation queries are supported, i.e., not ListAllAttestations, WindowCompare or AttestationUptimes.
+type server struct {
+	atypes.UnimplementedQueryServer
+
+	store *store
+}
+
+func (s *server) AttestationsFrom(_ context.Context, req *atypes.AttestationsFromRequest) (*atypes.AttestationsFromResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	chainVer := xchain.ChainVersion{ID: req.ChainId, ConfLevel: xchain.ConfLevel(req.ConfLevel)}
+
+	atts, err := s.store.AttestationsFrom(chainVer, req.FromOffset, attestationsFromLimit)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &atypes.AttestationsFromResponse{Attestations: atts}, nil
+}
+
+func (s *server) LatestAttestation(_ context.Context, req *atypes.LatestAttestationRequest) (*atypes.LatestAttestationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	chainVer := xchain.ChainVersion{ID: req.ChainId, ConfLevel: xchain.ConfLevel(req.ConfLevel)}
+
+	att, ok, err := s.store.Latest(chainVer)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	} else if !ok {
+		return nil, status.Error(codes.NotFound, "no approved attestations for chain")
+	}
+
+	return &atypes.LatestAttestationResponse{Attestation: att}, nil
+}
+
+func (s *server) EarliestAttestation(_ context.Context, req *atypes.EarliestAttestationRequest) (*atypes.EarliestAttestationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	chainVer := xchain.ChainVersion{ID: req.ChainId, ConfLevel: xchain.ConfLevel(req.ConfLevel)}
+
+	att, ok, err := s.store.Earliest(chainVer)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	} else if !ok {
+		return nil, status.Error(codes.NotFound, "no approved attestations for chain")
+	}
+
+	return &atypes.EarliestAttestationResponse{Attestation: att}, nil
+}
+
+// serveGRPC starts serving the attestation query gRPC API on the provided address.
+// It returns a channel that receives the server error.
+func serveGRPC(address string, store *store) <-chan error {
+	errChan := make(chan error)
+	go func() {
+		lis, err := net.Listen("tcp", address)
+		if err != nil {
+			errChan <- errors.Wrap(err, "listen grpc")
+			return
+		}
+
+		srv := grpc.NewServer()
+		atypes.RegisterQueryServer(srv, &server{store: store})
+
+		errChan <- errors.Wrap(srv.Serve(lis), "serve grpc")
+	}()
+
+	return errChan
+}
@@ -0,0 +1,155 @@
+package archiver
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	atypes "github.com/omni-network/omni/halo/attest/types"
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/xchain"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// attPrefix is the key prefix of archived attestations.
+// Keys are <attPrefix><chain_id><conf_level><attest_offset> with big-endian integers,
+// so attestations of a chain version are iterated in offset order.
+var attPrefix = []byte("att/")
+
+// store persists approved attestations (with signatures) per chain version.
+type store struct {
+	db dbm.DB
+}
+
+func newStore(db dbm.DB) *store {
+	return &store{db: db}
+}
+
+// Add persists the approved attestation. It must strictly follow the latest
+// archived attestation of its chain version, if any.
+func (s *store) Add(att xchain.Attestation) error {
+	latest, ok, err := s.Latest(att.ChainVersion)
+	if err != nil {
+		return err
+	} else if ok && att.AttestOffset != latest.AttestHeader.AttestOffset+1 {
+		return errors.New("non-sequential attestation",
+			"expected", latest.AttestHeader.AttestOffset+1,
+			"actual", att.AttestOffset,
+		)
+	}
+
+	bz, err := proto.Marshal(atypes.AttestationToProto(att))
+	if err != nil {
+		return errors.Wrap(err, "marshal attestation")
+	}
+
+	if err := s.db.SetSync(attKey(att.ChainVersion, att.AttestOffset), bz); err != nil {
+		return errors.Wrap(err, "set attestation")
+	}
+
+	return nil
+}
+
+// AttestationsFrom returns at most limit strictly-sequential attestations of the chain version
+// from the provided offset (inclusive). It returns an empty slice if the offset isn't archived.
+func (s *store) AttestationsFrom(chainVer xchain.ChainVersion, fromOffset uint64, limit int) ([]*atypes.Attestation, error) {
+	iter, err := s.db.Iterator(attKey(chainVer, fromOffset), prefixEnd(chainVerPrefix(chainVer)))
+	if err != nil {
+		return nil, errors.Wrap(err, "iterator")
+	}
+	defer iter.Close()
+
+	var resp []*atypes.Attestation
+	next := fromOffset
+	for ; iter.Valid() && len(resp) < limit; iter.Next() {
+		att, err := unmarshalAtt(iter.Value())
+		if err != nil {
+			return nil, err
+		} else if att.AttestHeader.AttestOffset != next {
+			break // Only return strictly-sequential attestations.
+		}
+
+		resp = append(resp, att)
+		next++
+	}
+
+	if err := iter.Error(); err != nil {
+		return nil, errors.Wrap(err, "iterate attestations")
+	}
+
+	return resp, nil
+}
+
+// Latest returns the latest archived attestation of the chain version or false if none exist.
+func (s *store) Latest(chainVer xchain.ChainVersion) (*atypes.Attestation, bool, error) {
+	prefix := chainVerPrefix(chainVer)
+	iter, err := s.db.ReverseIterator(prefix, prefixEnd(prefix))
+	if err != nil {
+		return nil, false, errors.Wrap(err, "reverse iterator")
+	}
+
+	return firstAtt(iter)
+}
+
+// Earliest returns the earliest archived attestation of the chain version or false if none exist.
+func (s *store) Earliest(chainVer xchain.ChainVersion) (*atypes.Attestation, bool, error) {
+	prefix := chainVerPrefix(chainVer)
+	iter, err := s.db.Iterator(prefix, prefixEnd(prefix))
+	if err != nil {
+		return nil, false, errors.Wrap(err, "iterator")
+	}
+
+	return firstAtt(iter)
+}
+
+// firstAtt returns the first attestation of the iterator or false if empty. It closes the iterator.
+func firstAtt(iter dbm.Iterator) (*atypes.Attestation, bool, error) {
+	defer iter.Close()
+
+	if err := iter.Error(); err != nil {
+		return nil, false, errors.Wrap(err, "iterate attestations")
+	} else if !iter.Valid() {
+		return nil, false, nil
+	}
+
+	att, err := unmarshalAtt(iter.Value())
+	if err != nil {
+		return nil, false, err
+	}
+
+	return att, true, nil
+}
+
+func unmarshalAtt(bz []byte) (*atypes.Attestation, error) {
+	att := new(atypes.Attestation)
+	if err := proto.Unmarshal(bz, att); err != nil {
+		return nil, errors.Wrap(err, "unmarshal attestation")
+	}
+
+	return att, nil
+}
+
+func chainVerPrefix(chainVer xchain.ChainVersion) []byte {
+	key := bytes.Clone(attPrefix)
+	key = binary.BigEndian.AppendUint64(key, chainVer.ID)
+
+	return append(key, byte(chainVer.ConfLevel))
+}
+
+func attKey(chainVer xchain.ChainVersion, attestOffset uint64) []byte {
+	return binary.BigEndian.AppendUint64(chainVerPrefix(chainVer), attestOffset)
+}
+
+// prefixEnd returns the end key (exclusive) of iterating over all keys with the prefix.
+func prefixEnd(prefix []byte) []byte {
+	end := bytes.Clone(prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		end[i]++
+		if end[i] != 0 {
+			return end[:i+1]
+		}
+	}
+
+	return nil
+}
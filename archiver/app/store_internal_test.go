@@ -0,0 +1,145 @@
+package archiver
+
+import (
+	"context"
+	"testing"
+
+	atypes "github.com/omni-network/omni/halo/attest/types"
+	"github.com/omni-network/omni/lib/k1util"
+	"github.com/omni-network/omni/lib/xchain"
+
+	k1 "github.com/cometbft/cometbft/crypto/secp256k1"
+
+	dbm "github.com/cosmos/cosmos-db"
+	fuzz "github.com/google/gofuzz"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestStore(t *testing.T) {
+	t.Parallel()
+
+	s := newStore(dbm.NewMemDB())
+	chainVer := xchain.ChainVersion{ID: 100, ConfLevel: xchain.ConfFinalized}
+	otherVer := xchain.ChainVersion{ID: 100, ConfLevel: xchain.ConfLatest}
+
+	_, ok, err := s.Latest(chainVer)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// Archive is resumable from any offset.
+	var atts []xchain.Attestation
+	for offset := uint64(5); offset <= 10; offset++ {
+		att := randomAtt(t, chainVer, offset)
+		require.NoError(t, s.Add(att))
+		atts = append(atts, att)
+	}
+	require.NoError(t, s.Add(randomAtt(t, otherVer, 1)))
+
+	// Attestations must be sequential.
+	require.ErrorContains(t, s.Add(randomAtt(t, chainVer, 12)), "non-sequential attestation")
+	require.ErrorContains(t, s.Add(randomAtt(t, chainVer, 10)), "non-sequential attestation")
+
+	latest, ok, err := s.Latest(chainVer)
+	require.NoError(t, err)
+	require.True(t, ok)
+	requireAtt(t, atts[len(atts)-1], latest)
+
+	earliest, ok, err := s.Earliest(chainVer)
+	require.NoError(t, err)
+	require.True(t, ok)
+	requireAtt(t, atts[0], earliest)
+
+	resp, err := s.AttestationsFrom(chainVer, 6, 3)
+	require.NoError(t, err)
+	require.Len(t, resp, 3)
+	for i, att := range resp {
+		requireAtt(t, atts[i+1], att)
+	}
+
+	resp, err = s.AttestationsFrom(chainVer, 9, 100)
+	require.NoError(t, err)
+	require.Len(t, resp, 2)
+
+	// Offsets not archived return empty.
+	for _, offset := range []uint64{1, 11} {
+		resp, err = s.AttestationsFrom(chainVer, offset, 100)
+		require.NoError(t, err)
+		require.Empty(t, resp)
+	}
+}
+
+func TestServer(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	s := newStore(dbm.NewMemDB())
+	srv := &server{store: s}
+	chainVer := xchain.ChainVersion{ID: 100, ConfLevel: xchain.ConfFinalized}
+
+	_, err := srv.LatestAttestation(ctx, &atypes.LatestAttestationRequest{ChainId: chainVer.ID, ConfLevel: uint32(chainVer.ConfLevel)})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	var atts []xchain.Attestation
+	for offset := uint64(1); offset <= attestationsFromLimit+10; offset++ {
+		att := randomAtt(t, chainVer, offset)
+		require.NoError(t, s.Add(att))
+		atts = append(atts, att)
+	}
+
+	latest, err := srv.LatestAttestation(ctx, &atypes.LatestAttestationRequest{ChainId: chainVer.ID, ConfLevel: uint32(chainVer.ConfLevel)})
+	require.NoError(t, err)
+	requireAtt(t, atts[len(atts)-1], latest.Attestation)
+
+	resp, err := srv.AttestationsFrom(ctx, &atypes.AttestationsFromRequest{ChainId: chainVer.ID, ConfLevel: uint32(chainVer.ConfLevel), FromOffset: 1})
+	require.NoError(t, err)
+	require.Len(t, resp.Attestations, attestationsFromLimit)
+
+	// Archived attestations are identical to those streamed.
+	actual, err := atypes.AttestationsFromProto(resp.Attestations)
+	require.NoError(t, err)
+	require.Equal(t, atts[:attestationsFromLimit], actual)
+}
+
+func randomAtt(t *testing.T, chainVer xchain.ChainVersion, offset uint64) xchain.Attestation {
+	t.Helper()
+
+	att := xchain.Attestation{
+		AttestHeader: xchain.AttestHeader{
+			ConsensusChainID: 1654,
+			ChainVersion:     chainVer,
+			AttestOffset:     offset,
+		},
+		BlockHeader: xchain.BlockHeader{
+			ChainID:     chainVer.ID,
+			BlockHeight: offset * 10,
+		},
+		ValidatorSetID: 1,
+	}
+	fuzz.New().NilChance(0).Fuzz(&att.BlockHash)
+	fuzz.New().NilChance(0).Fuzz(&att.MsgRoot)
+
+	attRoot, err := att.AttestationRoot()
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		key := k1.GenPrivKey()
+		sig, err := k1util.Sign(key, attRoot)
+		require.NoError(t, err)
+		addr, err := k1util.PubKeyToAddress(key.PubKey())
+		require.NoError(t, err)
+
+		att.Signatures = append(att.Signatures, xchain.SigTuple{ValidatorAddress: addr, Signature: sig})
+	}
+
+	return att
+}
+
+func requireAtt(t *testing.T, expected xchain.Attestation, actual *atypes.Attestation) {
+	t.Helper()
+
+	att, err := atypes.AttestationFromProto(actual)
+	require.NoError(t, err)
+	require.Equal(t, expected, att)
+}
@@ -0,0 +1,41 @@
+// Package cmd provides the cli for running the archiver.
+package cmd
+
+import (
+	archiver "github.com/omni-network/omni/archiver/app"
+	"github.com/omni-network/omni/lib/buildinfo"
+	libcmd "github.com/omni-network/omni/lib/cmd"
+	"github.com/omni-network/omni/lib/log"
+
+	"github.com/spf13/cobra"
+)
+
+// New returns a new root cobra command that handles our command line tool.
+func New() *cobra.Command {
+	cmd := libcmd.NewRootCmd(
+		"archiver",
+		"Archiver is a service that archives approved attestations and serves them independently of halo state pruning",
+		buildinfo.NewVersionCmd(),
+	)
+
+	cfg := archiver.DefaultConfig()
+	bindRunFlags(cmd.Flags(), &cfg)
+
+	logCfg := log.DefaultConfig()
+	log.BindFlags(cmd.Flags(), &logCfg)
+
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		ctx, err := log.Init(cmd.Context(), logCfg)
+		if err != nil {
+			return err
+		}
+
+		if err := libcmd.LogFlags(ctx, cmd.Flags()); err != nil {
+			return err
+		}
+
+		return archiver.Run(ctx, cfg)
+	}
+
+	return cmd
+}
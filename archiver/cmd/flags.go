@@ -0,0 +1,16 @@
+package cmd
+
+import (
+	archiver "github.com/omni-network/omni/archiver/app"
+	"github.com/omni-network/omni/lib/netconf"
+
+	"github.com/spf13/pflag"
+)
+
+func bindRunFlags(flags *pflag.FlagSet, cfg *archiver.Config) {
+	netconf.BindFlag(flags, &cfg.Network)
+	flags.StringVar(&cfg.HaloURL, "halo-url", cfg.HaloURL, "The URL of the halo node e.g localhost:26657. Initial sync requires an archive node if the archive starts empty")
+	flags.StringVar(&cfg.GRPCAddr, "grpc-addr", cfg.GRPCAddr, "The address to bind the attestation query gRPC server")
+	flags.StringVar(&cfg.MonitoringAddr, "monitoring-addr", cfg.MonitoringAddr, "The address to bind the monitoring server")
+	flags.StringVar(&cfg.DBDir, "db-dir", cfg.DBDir, "The path to the database directory")
+}
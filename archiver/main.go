@@ -0,0 +1,11 @@
+// Command archiver is the main entry point for the archiver.
+package main
+
+import (
+	appcmd "github.com/omni-network/omni/archiver/cmd"
+	libcmd "github.com/omni-network/omni/lib/cmd"
+)
+
+func main() {
+	libcmd.Main(appcmd.New())
+}
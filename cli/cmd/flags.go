@@ -75,13 +75,18 @@ func bindEOAConfig(cmd *cobra.Command, cfg *eoaConfig) {
 
 func bindDelegateConfig(cmd *cobra.Command, cfg *delegateConfig) {
 	bindEOAConfig(cmd, &cfg.eoaConfig)
-	const flagSelf = "self"
+	const (
+		flagSelf      = "self"
+		flagValidator = "validator"
+	)
 	cmd.Flags().Uint64Var(&cfg.Amount, flagDelegationAmount, cfg.Amount, "Delegation amount in OMNI (minimum 1 OMNI)")
 	cmd.Flags().BoolVar(&cfg.Self, flagSelf, false, "Enables self-delegation setting target validator address to provided private key")
+	cmd.Flags().StringVar(&cfg.ValidatorAddr, flagValidator, cfg.ValidatorAddr, "Target validator operator address to delegate to")
 
 	_ = cmd.MarkFlagRequired(flagConsPubKeyHex)
 	_ = cmd.MarkFlagRequired(flagDelegationAmount)
-	_ = cmd.MarkFlagRequired(flagSelf)
+	cmd.MarkFlagsOneRequired(flagSelf, flagValidator)
+	cmd.MarkFlagsMutuallyExclusive(flagSelf, flagValidator)
 }
 
 func bindCreateValConfig(cmd *cobra.Command, cfg *createValConfig) {
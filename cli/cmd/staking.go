@@ -234,13 +234,16 @@ func createValidator(ctx context.Context, cfg createValConfig) error {
 
 type delegateConfig struct {
 	eoaConfig
-	Amount uint64
-	Self   bool
+	Amount        uint64
+	Self          bool
+	ValidatorAddr string
 }
 
 func (d delegateConfig) validate() error {
-	if !d.Self {
-		return errors.New("required --self", "required_value", "true")
+	if d.Self == (d.ValidatorAddr != "") {
+		return errors.New("either --self or --validator required")
+	} else if d.ValidatorAddr != "" && !common.IsHexAddress(d.ValidatorAddr) {
+		return errors.New("invalid --validator address", "validator", d.ValidatorAddr)
 	}
 
 	if d.Amount < minDelegation {
@@ -256,7 +259,7 @@ func newDelegateCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "delegate",
 		Short: "Delegate Omni tokens to a validator",
-		Long:  `Delegate an amount of Omni tokens to a validator from your wallet. Only self-delegation by validators supported at the moment.`,
+		Long:  `Delegate an amount of Omni tokens to a validator from your wallet. Either self-delegate as a validator (--self) or delegate to any validator (--validator).`,
 		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			if err := cfg.validate(); err != nil {
@@ -289,14 +292,24 @@ func delegate(ctx context.Context, cfg delegateConfig) error {
 		return err
 	}
 
-	// check if we already have an existing validator
-	if _, ok, err := cprov.SDKValidator(ctx, delegatorAddr); err != nil {
+	validatorAddr := delegatorAddr // Self-delegation
+	if !cfg.Self {
+		validatorAddr = common.HexToAddress(cfg.ValidatorAddr)
+	}
+
+	// check if the target validator exists
+	if _, ok, err := cprov.SDKValidator(ctx, validatorAddr); err != nil {
 		return err
-	} else if !ok {
+	} else if !ok && cfg.Self {
 		return &CliError{
-			Msg:     "Operator address is not a validator: " + delegatorAddr.Hex(),
+			Msg:     "Operator address is not a validator: " + validatorAddr.Hex(),
 			Suggest: "Ensure operator is already created as validator, see create-validator command",
 		}
+	} else if !ok {
+		return &CliError{
+			Msg:     "Validator address is not a validator: " + validatorAddr.Hex(),
+			Suggest: "Ensure the --validator address is an existing validator's operator address",
+		}
 	}
 
 	contract, err := bindings.NewStaking(common.HexToAddress(predeploys.Staking), backend)
@@ -310,7 +323,7 @@ func delegate(ctx context.Context, cfg delegateConfig) error {
 	} else if bal <= float64(cfg.Amount) {
 		return &CliError{
 			Msg:     fmt.Sprintf("Delegator address has insufficient balance=%.2f OMNI, address=%s", bal, delegatorAddr),
-			Suggest: "Fund the delegator address with sufficient OMNI for self-delegation and gas",
+			Suggest: "Fund the delegator address with sufficient OMNI for delegation and gas",
 		}
 	}
 
@@ -318,11 +331,11 @@ func delegate(ctx context.Context, cfg delegateConfig) error {
 	if err != nil {
 		return err
 	}
-	txOpts.Value = new(big.Int).Mul(umath.NewBigInt(cfg.Amount), big.NewInt(params.Ether)) // Send self-delegation
+	txOpts.Value = new(big.Int).Mul(umath.NewBigInt(cfg.Amount), big.NewInt(params.Ether)) // Send delegation
 
-	tx, err := contract.Delegate(txOpts, delegatorAddr)
+	tx, err := contract.Delegate(txOpts, validatorAddr)
 	if err != nil {
-		return errors.Wrap(err, "create validator")
+		return errors.Wrap(err, "delegate")
 	}
 
 	rec, err := backend.WaitMined(ctx, tx)
@@ -333,6 +346,7 @@ func delegate(ctx context.Context, cfg delegateConfig) error {
 	log.Info(ctx, "🎉 Delegate transaction sent and included on-chain",
 		"link", cfg.Network.Static().OmniScanTXURL(tx.Hash()),
 		"block", rec.BlockNumber.Uint64(),
+		"validator", validatorAddr.Hex(),
 	)
 
 	return nil
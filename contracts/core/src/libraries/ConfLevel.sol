@@ -5,8 +5,8 @@ pragma solidity ^0.8.12;
  * @title ConfLevel
  * @notice XMsg confirmation levels. Matches ConfLevels in lib/xchain/types.go
  * @dev We prefer explicit constants over Enums, because we want uint8 values to start at 1, not 0, as they do in
- *      lib/xchain/types.go, such that 0 can represent "unset". Note only latest and finalized levels are supported
- *      on-chain.
+ *      lib/xchain/types.go, such that 0 can represent "unset". Note only latest, safe and finalized levels are
+ *      supported on-chain.
  */
 library ConfLevel {
     /**
@@ -14,6 +14,11 @@ library ConfLevel {
      */
     uint8 internal constant Latest = 1;
 
+    /**
+     * @notice XMsg confirmation level "safe", last byte of xmsg.shardId.
+     */
+    uint8 internal constant Safe = 2;
+
     /**
      * @notice XMsg confirmation level "finalized", last byte of xmsg.shardId.
      */
@@ -23,7 +28,7 @@ library ConfLevel {
      * @notice Returns true if the given level is valid.
      */
     function isValid(uint8 level) internal pure returns (bool) {
-        return level == Latest || level == Finalized;
+        return level == Latest || level == Safe || level == Finalized;
     }
 
     /**
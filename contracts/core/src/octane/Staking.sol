@@ -25,6 +25,17 @@ contract Staking is OwnableUpgradeable, EIP712Upgradeable {
      */
     event CreateValidator(address indexed validator, bytes pubkey, uint256 deposit);
 
+    /**
+     * @notice Emitted when a validator is created with a commission rate
+     * @param validator                 (MsgCreateValidator.validator_addr) The address of the validator to create
+     * @param pubkey                    (MsgCreateValidator.pubkey) The validators consensus public key. 33 bytes compressed secp256k1 public key
+     * @param deposit                   (MsgCreateValidator.selfDelegation) The validators initial stake
+     * @param commissionRatePercentage  (MsgCreateValidator.commission.rate) The commission rate percentage charged on delegator rewards
+     */
+    event CreateValidatorWithCommission(
+        address indexed validator, bytes pubkey, uint256 deposit, uint32 commissionRatePercentage
+    );
+
     /**
      * @notice Emitted when a delegation is made to a validator
      * @param delegator     (MsgDelegate.delegator_addr) The address of the delegator
@@ -33,6 +44,34 @@ contract Staking is OwnableUpgradeable, EIP712Upgradeable {
      */
     event Delegate(address indexed delegator, address indexed validator, uint256 amount);
 
+    /**
+     * @notice Emitted when a delegator undelegates from a validator
+     * @param delegator     (MsgUndelegate.delegator_addr) The address of the delegator
+     * @param validator     (MsgUndelegate.validator_addr) The address of the validator to undelegate from
+     * @param amount        (MsgUndelegate.amount) The amount of tokens to undelegate
+     */
+    event Undelegate(address indexed delegator, address indexed validator, uint256 amount);
+
+    /**
+     * @notice Emitted when a validator edits its description or commission
+     * @param validator                 (MsgEditValidator.validator_addr) The address of the validator to edit
+     * @param moniker                   (MsgEditValidator.description.moniker) The new moniker, empty to leave unchanged
+     * @param identity                  (MsgEditValidator.description.identity) The new identity, empty to leave unchanged
+     * @param website                   (MsgEditValidator.description.website) The new website, empty to leave unchanged
+     * @param securityContact           (MsgEditValidator.description.security_contact) The new security contact, empty to leave unchanged
+     * @param details                   (MsgEditValidator.description.details) The new details, empty to leave unchanged
+     * @param commissionRatePercentage  (MsgEditValidator.commission_rate) The new commission rate percentage, -1 to leave unchanged
+     */
+    event EditValidator(
+        address indexed validator,
+        string moniker,
+        string identity,
+        string website,
+        string securityContact,
+        string details,
+        int32 commissionRatePercentage
+    );
+
     /**
      * @notice Emitted when a validator is allowed to create a validator
      * @param validator     The validator address
@@ -149,21 +188,81 @@ contract Staking is OwnableUpgradeable, EIP712Upgradeable {
     }
 
     /**
-     * @notice Increase your validators self delegation.
-     *         NOTE: Only self delegations to existing validators are currently supported.
-     *         If msg.sender is not a validator, the delegation will be lost.
+     * @notice Create a new validator with a commission rate charged on delegator rewards
+     * @param x The x coordinate of the validators consensus public key
+     * @param y The y coordinate of the validators consensus public key
+     * @param signature The signature of the validators consensus public key
+     * @param commissionRatePercentage The commission rate percentage charged on delegator rewards
+     * @dev Proxies x/staking.MsgCreateValidator
+     */
+    function createValidatorWithCommission(
+        bytes32 x,
+        bytes32 y,
+        bytes calldata signature,
+        uint32 commissionRatePercentage
+    ) external payable {
+        require(!isAllowlistEnabled || isAllowedValidator[msg.sender], "Staking: not allowed");
+        require(msg.value >= MinDeposit, "Staking: insufficient deposit");
+        require(commissionRatePercentage <= 100, "Staking: invalid commission rate");
+        require(Secp256k1.verifyPubkey(x, y), "Staking: invalid pubkey");
+        require(_verifySignature(x, y, signature), "Staking: invalid signature");
+
+        bytes memory pubkey = Secp256k1.compressPublicKey(x, y);
+        emit CreateValidatorWithCommission(msg.sender, pubkey, msg.value, commissionRatePercentage);
+    }
+
+    /**
+     * @notice Delegate to a validator, either as self delegation or as a third-party delegator.
+     *         If the validator does not exist, the delegation will be lost.
+     * @param validator The address of the validator to delegate to
      * @dev Proxies x/staking.MsgDelegate
      */
     function delegate(address validator) external payable {
         require(!isAllowlistEnabled || isAllowedValidator[validator], "Staking: not allowed val");
         require(msg.value >= MinDelegation, "Staking: insufficient deposit");
 
-        // only support self delegation for now
-        require(msg.sender == validator, "Staking: only self delegation");
-
         emit Delegate(msg.sender, validator, msg.value);
     }
 
+    /**
+     * @notice Undelegate from a validator.
+     *         The undelegated stake, and any accrued rewards, are withdrawn to msg.sender
+     *         once the consensus chain unbonding period has passed.
+     *         If msg.sender has insufficient delegation, the undelegation will fail.
+     * @param validator The address of the validator to undelegate from
+     * @param amount    The amount of tokens to undelegate
+     * @dev Proxies x/staking.MsgUndelegate
+     */
+    function undelegate(address validator, uint256 amount) external {
+        require(amount > 0, "Staking: zero amount");
+
+        emit Undelegate(msg.sender, validator, amount);
+    }
+
+    /**
+     * @notice Edit your validator's description or commission rate.
+     *         If msg.sender is not a validator, the edit will fail.
+     * @param moniker                   The new moniker, empty to leave unchanged
+     * @param identity                  The new identity, empty to leave unchanged
+     * @param website                   The new website, empty to leave unchanged
+     * @param securityContact           The new security contact, empty to leave unchanged
+     * @param details                   The new details, empty to leave unchanged
+     * @param commissionRatePercentage  The new commission rate percentage, -1 to leave unchanged
+     * @dev Proxies x/staking.MsgEditValidator
+     */
+    function editValidator(
+        string calldata moniker,
+        string calldata identity,
+        string calldata website,
+        string calldata securityContact,
+        string calldata details,
+        int32 commissionRatePercentage
+    ) external {
+        require(commissionRatePercentage >= -1 && commissionRatePercentage <= 100, "Staking: invalid commission rate");
+
+        emit EditValidator(msg.sender, moniker, identity, website, securityContact, details, commissionRatePercentage);
+    }
+
     //////////////////////////////////////////////////////////////////////////////
     //                                  Admin                                   //
     //////////////////////////////////////////////////////////////////////////////
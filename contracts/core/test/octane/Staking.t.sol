@@ -13,9 +13,28 @@ contract Staking_Test is Test {
     /// @dev Matches Staking.CreateValidator event
     event CreateValidator(address indexed validator, bytes pubkey, uint256 deposit);
 
+    /// @dev Matches Staking.CreateValidatorWithCommission event
+    event CreateValidatorWithCommission(
+        address indexed validator, bytes pubkey, uint256 deposit, uint32 commissionRatePercentage
+    );
+
     /// @dev Matches Staking.Delegate event
     event Delegate(address indexed delegator, address indexed validator, uint256 amount);
 
+    /// @dev Matches Staking.Undelegate event
+    event Undelegate(address indexed delegator, address indexed validator, uint256 amount);
+
+    /// @dev Matches Staking.EditValidator event
+    event EditValidator(
+        address indexed validator,
+        string moniker,
+        string identity,
+        string website,
+        string securityContact,
+        string details,
+        int32 commissionRatePercentage
+    );
+
     address owner;
     address validator;
     StakingHarness staking;
@@ -118,11 +137,6 @@ contract Staking_Test is Test {
         vm.expectRevert("Staking: insufficient deposit");
         staking.delegate{ value: minDelegation - 1 }(validator);
 
-        // requires self-delegation
-        vm.expectRevert("Staking: only self delegation");
-        vm.prank(validator);
-        staking.delegate{ value: minDelegation }(makeAddr("someone else"));
-
         // if allowlist enabled, must be in allowlist
         vm.prank(owner);
         staking.enableAllowlist();
@@ -142,6 +156,80 @@ contract Staking_Test is Test {
 
         vm.prank(validator);
         staking.delegate{ value: minDelegation }(validator);
+
+        // succeeds for third-party delegators
+        address delegator = makeAddr("delegator");
+        vm.deal(delegator, minDelegation);
+
+        vm.expectEmit();
+        emit Delegate(delegator, validator, minDelegation);
+
+        vm.prank(delegator);
+        staking.delegate{ value: minDelegation }(validator);
+    }
+
+    function test_createValidatorWithCommission() public {
+        bytes32 privkey = 0x5aae8cd28d4456aba1d24542558bc2fac787e2fdc2210c20f2f3375e82174205;
+        bytes32 x = 0x534d719d4f56544f42e22cab20886dd64fb713a5c72b31f929d856654a11dc0c;
+        bytes32 y = 0x5609e3c7f55c46a197ead4a96caa63eeade00b4a775e7709f6e673157a724d6c;
+        bytes memory pubkey = Secp256k1.compressPublicKey(x, y);
+        (uint8 v, bytes32 r, bytes32 s) = vm.sign(uint256(privkey), staking.getValidatorPubkeyDigest(x, y));
+        bytes memory signature = abi.encodePacked(r, s, v);
+        uint256 deposit = staking.MinDeposit();
+        vm.deal(validator, deposit);
+
+        // requires valid commission rate
+        vm.expectRevert("Staking: invalid commission rate");
+        vm.prank(validator);
+        staking.createValidatorWithCommission{ value: deposit }(x, y, signature, 101);
+
+        // succeeds
+        vm.expectEmit();
+        emit CreateValidatorWithCommission(validator, pubkey, deposit, 10);
+
+        vm.prank(validator);
+        staking.createValidatorWithCommission{ value: deposit }(x, y, signature, 10);
+    }
+
+    function test_undelegate() public {
+        address delegator = makeAddr("delegator");
+
+        // requires non-zero amount
+        vm.expectRevert("Staking: zero amount");
+        vm.prank(delegator);
+        staking.undelegate(validator, 0);
+
+        // succeeds
+        vm.expectEmit();
+        emit Undelegate(delegator, validator, 1 ether);
+
+        vm.prank(delegator);
+        staking.undelegate(validator, 1 ether);
+    }
+
+    function test_editValidator() public {
+        // requires valid commission rate
+        vm.expectRevert("Staking: invalid commission rate");
+        vm.prank(validator);
+        staking.editValidator("moniker", "", "", "", "", 101);
+
+        vm.expectRevert("Staking: invalid commission rate");
+        vm.prank(validator);
+        staking.editValidator("moniker", "", "", "", "", -2);
+
+        // succeeds
+        vm.expectEmit();
+        emit EditValidator(validator, "moniker", "identity", "website", "contact", "details", 10);
+
+        vm.prank(validator);
+        staking.editValidator("moniker", "identity", "website", "contact", "details", 10);
+
+        // succeeds leaving commission unchanged
+        vm.expectEmit();
+        emit EditValidator(validator, "", "", "", "", "details", -1);
+
+        vm.prank(validator);
+        staking.editValidator("", "", "", "", "details", -1);
     }
 }
 
@@ -88,8 +88,10 @@ contract PortalRegistry_Test is Test {
         reg.register(dep);
 
         // success
+        dep.shards = new uint64[](3);
         dep.shards[0] = ConfLevel.Finalized;
         dep.shards[1] = ConfLevel.Latest;
+        dep.shards[2] = ConfLevel.Safe;
         vm.expectEmit();
         emit PortalRegistered(
             dep.chainId, dep.addr, dep.deployHeight, dep.attestInterval, dep.blockPeriodNs, dep.shards, dep.name
@@ -102,6 +104,7 @@ contract PortalRegistry_Test is Test {
         assertEq(reg.get(dep.chainId).deployHeight, dep.deployHeight);
         assertEq(reg.get(dep.chainId).shards[0], dep.shards[0]);
         assertEq(reg.get(dep.chainId).shards[1], dep.shards[1]);
+        assertEq(reg.get(dep.chainId).shards[2], dep.shards[2]);
         assertEq(reg.list().length, 1);
 
         // cannot register the same chain twice
@@ -22,7 +22,13 @@ library Solve {
         None,
         DestCallReverts,
         InsufficientFee,
-        InsufficientInventory
+        InsufficientInventory,
+        NoTarget,
+        UnsupportedSrcChain,
+        UnsupportedToken,
+        InsufficientDeposit,
+        InvalidCall,
+        Expired
     }
 
     /**
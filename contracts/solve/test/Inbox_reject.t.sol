@@ -3,6 +3,7 @@ pragma solidity =0.8.24;
 
 import { Ownable } from "solady/src/auth/Ownable.sol";
 import { SolveInbox } from "src/SolveInbox.sol";
+import { ISolveInbox } from "src/interfaces/ISolveInbox.sol";
 import { Solve } from "src/Solve.sol";
 import { InboxBase } from "./InboxBase.sol";
 
@@ -65,6 +66,21 @@ contract SolveInbox_reject_Test is InboxBase {
         assertEq(uint8(inbox.getRequest(id).status), uint8(Solve.Status.Rejected), "inbox.getRequest(id).status");
     }
 
+    function test_reject_reason() public {
+        // create valid request
+        vm.deal(user, 1 ether);
+        Solve.Call memory call = randCall();
+        Solve.TokenDeposit[] memory deposits = new Solve.TokenDeposit[](0);
+        vm.prank(user);
+        bytes32 id = inbox.request{ value: 1 ether }(call, deposits);
+
+        // reject request with the reason emitted
+        vm.expectEmit(true, true, true, true);
+        emit ISolveInbox.Rejected(id, solver, Solve.RejectReason.Expired);
+        vm.prank(solver);
+        inbox.reject(id, Solve.RejectReason.Expired);
+    }
+
     function test_reject_two_requests() public {
         // create valid requests
         vm.deal(user, 2 ether);
@@ -6,6 +6,7 @@ import (
 	"github.com/omni-network/omni/contracts/bindings"
 	"github.com/omni-network/omni/e2e/app"
 	"github.com/omni-network/omni/e2e/app/eoa"
+	magellan2 "github.com/omni-network/omni/halo/app/upgrades/magellan"
 	uluwatu1 "github.com/omni-network/omni/halo/app/upgrades/uluwatu"
 	"github.com/omni-network/omni/halo/genutil/evm/predeploys"
 	"github.com/omni-network/omni/lib/errors"
@@ -17,7 +18,7 @@ import (
 
 var upgradePlans = map[netconf.ID]bindings.UpgradePlan{
 	netconf.Staging: {
-		Name:   uluwatu1.UpgradeName,
+		Name:   magellan2.UpgradeName,
 		Height: 0, // Dynamically calculated for ephemeral networks
 	},
 	netconf.Omega: {
@@ -18,7 +18,7 @@ import (
 	"github.com/omni-network/omni/e2e/app/static"
 	"github.com/omni-network/omni/e2e/types"
 	"github.com/omni-network/omni/e2e/vmcompose"
-	uluwatu1 "github.com/omni-network/omni/halo/app/upgrades/uluwatu"
+	magellan2 "github.com/omni-network/omni/halo/app/upgrades/magellan"
 	halocmd "github.com/omni-network/omni/halo/cmd"
 	halocfg "github.com/omni-network/omni/halo/config"
 	"github.com/omni-network/omni/halo/genutil"
@@ -51,7 +51,7 @@ const (
 	PrivvalKeyFile   = "config/priv_validator_key.json"
 	PrivvalStateFile = "data/priv_validator_state.json"
 
-	latestUpgrade = uluwatu1.UpgradeName
+	latestUpgrade = magellan2.UpgradeName
 )
 
 // Setup sets up the testnet configuration.
@@ -536,6 +536,8 @@ func writeSolverConfig(ctx context.Context, def Definition, logCfg log.Config) e
 	solverCfg.PrivateKey = privKeyFile
 	solverCfg.Network = def.Testnet.Network
 	solverCfg.RPCEndpoints = endpoints
+	solverCfg.MinProfitMargin = -1           // Test tokens are not priced
+	solverCfg.ClaimBatchPeriod = time.Second // Claim promptly, e2e tests wait for claims
 
 	if err := solverapp.WriteConfigTOML(solverCfg, logCfg, filepath.Join(confRoot, configFile)); err != nil {
 		return errors.Wrap(err, "write solver config")
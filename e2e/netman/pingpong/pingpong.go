@@ -179,7 +179,7 @@ func (d *XDapp) StartAllEdges(ctx context.Context, latest, parallel, count uint6
 
 				shards := from.Chain.Shards
 				for i := uint64(0); i < parallel; i++ {
-					// First are latest, rest is finalized
+					// First are latest, next is safe, rest is finalized
 					conf := xchain.ConfFinalized
 
 					// Only use latest shard if the chain has it and
@@ -189,6 +189,11 @@ func (d *XDapp) StartAllEdges(ctx context.Context, latest, parallel, count uint6
 						slices.Contains(shards, xchain.ShardLatest0) &&
 						i < latest {
 						conf = xchain.ConfLatest
+					} else if i == latest &&
+						slices.Contains(shards, xchain.ShardSafe0) &&
+						slices.Contains(to.Chain.Shards, xchain.ShardSafe0) {
+						// Use safe shard for the first non-latest ping pong if both chains have it.
+						conf = xchain.ConfSafe
 					}
 
 					txOpts, backend, err := d.backends.BindOpts(ctx, from.Chain.ID, d.deployer)
@@ -9,6 +9,7 @@ import (
 	"github.com/omni-network/omni/lib/errors"
 	"github.com/omni-network/omni/lib/evmchain"
 	"github.com/omni-network/omni/lib/log"
+	"github.com/omni-network/omni/lib/xchain"
 	solver "github.com/omni-network/omni/solver/types"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -29,6 +30,10 @@ func (a App) Address() common.Address {
 	return a.L1Vault
 }
 
+func (App) FulfillConfLevel() xchain.ConfLevel {
+	return xchain.ConfLatest
+}
+
 func (a App) TokenPrereqs(call bindings.SolveCall) ([]bindings.SolveTokenPrereq, error) {
 	args, err := unpackDeposit(call.Data)
 	if err != nil {
@@ -44,19 +49,19 @@ func (a App) TokenPrereqs(call bindings.SolveCall) ([]bindings.SolveTokenPrereq,
 	}, nil
 }
 
-func (a App) Verify(srcChainID uint64, call bindings.SolveCall, deposits []bindings.SolveDeposit) error {
+func (a App) Verify(srcChainID uint64, call bindings.SolveCall, deposits []bindings.SolveDeposit) (solver.RejectReason, error) {
 	// we only accept deposits from mock L2
 	if srcChainID != evmchain.IDMockL2 {
-		return errors.New("source chain not supported", "src", srcChainID)
+		return solver.RejectUnsupportedSrcChain, errors.New("source chain not supported", "src", srcChainID)
 	}
 
 	args, err := unpackDeposit(call.Data)
 	if err != nil {
-		return errors.Wrap(err, "invalid deposit")
+		return solver.RejectInvalidCall, errors.Wrap(err, "invalid deposit")
 	}
 
 	if _, err := a.TokenPrereqs(call); err != nil {
-		return errors.Wrap(err, "token prereqs")
+		return solver.RejectInvalidCall, errors.Wrap(err, "token prereqs")
 	}
 
 	var l2token *bindings.SolveDeposit
@@ -68,17 +73,17 @@ func (a App) Verify(srcChainID uint64, call bindings.SolveCall, deposits []bindi
 
 	// if no l2 deposit, we can't accept
 	if l2token == nil {
-		return errors.New("no L2 token deposit")
+		return solver.RejectUnsupportedToken, errors.New("no L2 token deposit")
 	}
 
 	// if l2 deposit amount does not match call amount, we can't accept
 	if l2token.Amount.Cmp(args.Amount) != 0 {
-		return errors.New("insufficient L2 token deposit")
+		return solver.RejectInsufficientDeposit, errors.New("insufficient L2 token deposit")
 	}
 
 	// TODO: require native deposit that covers gas / risk / overhead
 
-	return nil
+	return solver.RejectNone, nil
 }
 
 func (a App) DebugCall(ctx context.Context, call bindings.SolveCall) error {
@@ -8,6 +8,7 @@ import (
 	"github.com/omni-network/omni/contracts/bindings"
 	"github.com/omni-network/omni/lib/errors"
 	"github.com/omni-network/omni/lib/log"
+	"github.com/omni-network/omni/lib/xchain"
 	solver "github.com/omni-network/omni/solver/types"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -29,6 +30,10 @@ func (t App) Address() common.Address {
 	return t.L1wstETHCollateral
 }
 
+func (App) FulfillConfLevel() xchain.ConfLevel {
+	return xchain.ConfLatest
+}
+
 func (t App) TokenPrereqs(call bindings.SolveCall) ([]bindings.SolveTokenPrereq, error) {
 	args, err := unpackDeposit(call.Data)
 	if err != nil {
@@ -44,19 +49,19 @@ func (t App) TokenPrereqs(call bindings.SolveCall) ([]bindings.SolveTokenPrereq,
 	}, nil
 }
 
-func (t App) Verify(srcChainID uint64, call bindings.SolveCall, deposits []bindings.SolveDeposit) error {
+func (t App) Verify(srcChainID uint64, call bindings.SolveCall, deposits []bindings.SolveDeposit) (solver.RejectReason, error) {
 	// for now, we only accept deposits from a single, explicit l2
 	if srcChainID != t.L2.ChainID {
-		return errors.New("source chain not supported", "src", srcChainID)
+		return solver.RejectUnsupportedSrcChain, errors.New("source chain not supported", "src", srcChainID)
 	}
 
 	args, err := unpackDeposit(call.Data)
 	if err != nil {
-		return errors.Wrap(err, "invalid deposit")
+		return solver.RejectInvalidCall, errors.Wrap(err, "invalid deposit")
 	}
 
 	if _, err := t.TokenPrereqs(call); err != nil {
-		return errors.Wrap(err, "token prereqs")
+		return solver.RejectInvalidCall, errors.Wrap(err, "token prereqs")
 	}
 
 	var l2Deposit *bindings.SolveDeposit
@@ -68,12 +73,12 @@ func (t App) Verify(srcChainID uint64, call bindings.SolveCall, deposits []bindi
 
 	// if no l2 deposit, we can'a accept
 	if l2Deposit == nil {
-		return errors.New("no L2 token deposit")
+		return solver.RejectUnsupportedToken, errors.New("no L2 token deposit")
 	}
 
 	// if l2 deposit amount does not match call amount, we can'a accept
 	if l2Deposit.Amount.Cmp(args.Amount) < 0 {
-		return errors.New("insufficient L2 token deposit",
+		return solver.RejectInsufficientDeposit, errors.New("insufficient L2 token deposit",
 			"expected", args.Amount,
 			"actual", l2Deposit.Amount,
 		)
@@ -81,7 +86,7 @@ func (t App) Verify(srcChainID uint64, call bindings.SolveCall, deposits []bindi
 
 	// TODO: require native deposit that covers gas / risk / overhead
 
-	return nil
+	return solver.RejectNone, nil
 }
 
 func (App) DebugCall(ctx context.Context, call bindings.SolveCall) error {
@@ -1,9 +1,11 @@
 package e2e_test
 
 import (
+	"slices"
 	"testing"
 
 	"github.com/omni-network/omni/lib/netconf"
+	"github.com/omni-network/omni/lib/xchain"
 
 	"github.com/stretchr/testify/require"
 )
@@ -36,6 +38,46 @@ func TestPortalOffsets(t *testing.T) {
 	})
 }
 
+// TestSafeShard ensures that devnet enables the safe shard on all portal chains
+// and that safe xmsgs are sent and received between chains supporting it.
+func TestSafeShard(t *testing.T) {
+	t.Parallel()
+	testPortal(t, func(t *testing.T, network netconf.Network, source Portal, dests []Portal) {
+		t.Helper()
+		if network.ID == netconf.Devnet {
+			require.Contains(t, source.Chain.Shards, xchain.ShardSafe0, "chain %v shards", source.Chain.ID)
+		} else if !slices.Contains(source.Chain.Shards, xchain.ShardSafe0) {
+			return // Public chains don't support the safe shard yet.
+		}
+
+		var sent, received uint64
+		for _, dest := range dests {
+			if source.Chain.ID == dest.Chain.ID {
+				continue
+			} else if !slices.Contains(dest.Chain.Shards, xchain.ShardSafe0) {
+				continue // Safe xmsgs are only sent between chains supporting it.
+			}
+
+			sourceOffset, err := source.Contract.OutXMsgOffset(nil, dest.Chain.ID, uint64(xchain.ShardSafe0))
+			require.NoError(t, err)
+
+			destOffset, err := dest.Contract.InXMsgOffset(nil, source.Chain.ID, uint64(xchain.ShardSafe0))
+			require.NoError(t, err)
+
+			require.LessOrEqual(t, destOffset, sourceOffset,
+				"dest chain %v safe offset=%d, source chain %v safe offset=%d",
+				dest.Chain.ID, destOffset, source.Chain.ID, sourceOffset)
+
+			sent += sourceOffset
+			received += destOffset
+		}
+
+		// require at least some safe xmsgs were sent and received
+		require.Positive(t, sent, "no safe xmsgs sent from source chain %v", source.Chain.ID)
+		require.Positive(t, received, "no safe xmsgs received from source chain %v", source.Chain.ID)
+	})
+}
+
 // TestSupportedChains ensures that all portals have been relayed supported chains from the PortalRegistry, via the XRegistry.
 func TestSupportedChains(t *testing.T) {
 	// TODO: enable when cchain setNetwork xmsgs are enabled
@@ -9,54 +9,58 @@ import (
 
 //nolint:gochecknoglobals // Static mappings
 var (
-	allShards = []xchain.ShardID{xchain.ShardFinalized0, xchain.ShardLatest0} // All EVM chains support both finalized and latest shards.
+	// allShards are supported by ephemeral EVM chains.
+	allShards = []xchain.ShardID{xchain.ShardFinalized0, xchain.ShardSafe0, xchain.ShardLatest0}
+
+	// publicShards are supported by public EVM chains; the safe shard requires upgraded portal registries.
+	publicShards = []xchain.ShardID{xchain.ShardFinalized0, xchain.ShardLatest0}
 
 	chainEthereum = EVMChain{
 		Metadata: mustMetadata(evmchain.IDEthereum),
 		IsPublic: true,
-		Shards:   allShards,
+		Shards:   publicShards,
 	}
 
 	chainBase = EVMChain{
 		Metadata: mustMetadata(evmchain.IDBase),
 		IsPublic: true,
-		Shards:   allShards,
+		Shards:   publicShards,
 	}
 
 	chainOptimism = EVMChain{
 		Metadata: mustMetadata(evmchain.IDOptimism),
 		IsPublic: true,
-		Shards:   allShards,
+		Shards:   publicShards,
 	}
 
 	chainArbitrum = EVMChain{
 		Metadata: mustMetadata(evmchain.IDArbitrumOne),
 		IsPublic: true,
-		Shards:   allShards,
+		Shards:   publicShards,
 	}
 
 	chainHolesky = EVMChain{
 		Metadata: mustMetadata(evmchain.IDHolesky),
 		IsPublic: true,
-		Shards:   allShards,
+		Shards:   publicShards,
 	}
 
 	chainArbSepolia = EVMChain{
 		Metadata: mustMetadata(evmchain.IDArbSepolia),
 		IsPublic: true,
-		Shards:   allShards,
+		Shards:   publicShards,
 	}
 
 	chainOpSepolia = EVMChain{
 		Metadata: mustMetadata(evmchain.IDOpSepolia),
 		IsPublic: true,
-		Shards:   allShards,
+		Shards:   publicShards,
 	}
 
 	chainBaseSepolia = EVMChain{
 		Metadata: mustMetadata(evmchain.IDBaseSepolia),
 		IsPublic: true,
-		Shards:   allShards,
+		Shards:   publicShards,
 	}
 )
 
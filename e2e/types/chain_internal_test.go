@@ -29,6 +29,6 @@ func TestPublicChains(t *testing.T) {
 		require.NoError(t, err, "name=%s", meta.Name)
 		require.Equal(t, meta, chain.Metadata, "name=%s", meta.Name)
 		require.True(t, chain.IsPublic, "name=%s", meta.Name)
-		require.Equal(t, allShards, chain.Shards, "name=%s", meta.Name)
+		require.Equal(t, publicShards, chain.Shards, "name=%s", meta.Name)
 	}
 }
@@ -8,7 +8,7 @@ import (
 	atypes "github.com/omni-network/omni/halo/attest/types"
 	"github.com/omni-network/omni/halo/comet"
 	"github.com/omni-network/omni/halo/evmslashing"
-	"github.com/omni-network/omni/halo/evmstaking"
+	evmstakingkeeper "github.com/omni-network/omni/halo/evmstaking2/keeper"
 	"github.com/omni-network/omni/halo/evmupgrade"
 	registrykeeper "github.com/omni-network/omni/halo/registry/keeper"
 	rtypes "github.com/omni-network/omni/halo/registry/types"
@@ -81,9 +81,9 @@ type App struct {
 	RegistryKeeper        registrykeeper.Keeper
 	EvidenceKeeper        evidencekeeper.Keeper
 	UpgradeKeeper         *upgradekeeper.Keeper
+	EVMStakingKeeper      *evmstakingkeeper.Keeper
 
 	SlashingEventProc evmslashing.EventProcessor
-	StakingEventProc  evmstaking.EventProcessor
 	UpgradeEventProc  evmupgrade.EventProcessor
 }
 
@@ -135,8 +135,8 @@ func newApp(
 		&app.RegistryKeeper,
 		&app.EvidenceKeeper,
 		&app.UpgradeKeeper,
+		&app.EVMStakingKeeper,
 		&app.SlashingEventProc,
-		&app.StakingEventProc,
 		&app.UpgradeEventProc,
 	); err != nil {
 		return nil, errors.Wrap(err, "dep inject")
@@ -144,8 +144,12 @@ func newApp(
 
 	// Wire provider.
 	app.EVMEngKeeper.SetVoteProvider(app.AttestKeeper)
+	app.EVMEngKeeper.SetUpgradeKeeper(app.UpgradeKeeper)
 	app.AttestKeeper.SetValidatorProvider(app.ValSyncKeeper)
 	app.AttestKeeper.SetPortalRegistry(app.RegistryKeeper)
+	app.AttestKeeper.SetSlasher(app.SlashingKeeper)
+	app.AttestKeeper.SetUpgradeKeeper(app.UpgradeKeeper)
+	app.EVMStakingKeeper.SetUpgradeKeeper(app.UpgradeKeeper)
 
 	baseAppOpts = append(baseAppOpts, func(bapp *baseapp.BaseApp) {
 		// Use evm engine to create block proposals.
@@ -205,10 +209,6 @@ func (App) LegacyAmino() *codec.LegacyAmino {
 	return nil
 }
 
-func (App) ExportAppStateAndValidators(_ bool, _, _ []string) (servertypes.ExportedApp, error) {
-	return servertypes.ExportedApp{}, errors.New("not implemented")
-}
-
 // SimulationManager implements the SimulationApp interface.
 func (App) SimulationManager() *module.SimulationManager {
 	return nil
@@ -5,6 +5,8 @@ import (
 	attesttypes "github.com/omni-network/omni/halo/attest/types"
 	"github.com/omni-network/omni/halo/evmslashing"
 	"github.com/omni-network/omni/halo/evmstaking"
+	evmstaking2module "github.com/omni-network/omni/halo/evmstaking2/module"
+	evmstaking2types "github.com/omni-network/omni/halo/evmstaking2/types"
 	"github.com/omni-network/omni/halo/evmupgrade"
 	portalmodule "github.com/omni-network/omni/halo/portal/module"
 	portaltypes "github.com/omni-network/omni/halo/portal/types"
@@ -44,11 +46,15 @@ import (
 
 const (
 	// TODO(corver): Maybe move these to genesis itself.
-	genesisVoteWindowUp   uint64 = 64 // Allow early votes for <latest attestation - 64>
-	genesisVoteWindowDown uint64 = 2  // Only allow late votes for <latest attestation - 2>
-	genesisVoteExtLimit   uint64 = 256
-	genesisTrimLag        uint64 = 1      // Allow deleting attestations in block after approval.
-	genesisCTrimLag       uint64 = 72_000 // Delete consensus attestations state after +-1 day (given a period of 1.2s).
+	genesisVoteWindowUp     uint64 = 64 // Allow early votes for <latest attestation - 64>
+	genesisVoteWindowDown   uint64 = 2  // Only allow late votes for <latest attestation - 2>
+	genesisVoteExtLimit     uint64 = 256
+	genesisTrimLag          uint64 = 1      // Allow deleting attestations in block after approval.
+	genesisCTrimLag         uint64 = 72_000 // Delete consensus attestations state after +-1 day (given a period of 1.2s).
+	genesisLivenessWindow   uint64 = 20_000 // Track attestation liveness over the last 20k expected votes per validator.
+	genesisLivenessMinVoted uint64 = 10_000 // Jail validators missing more than half their votes in the window.
+
+	genesisEVMStakingDeliverInterval int64 = 5 // Deliver buffered EVM staking events every 5 blocks (+-6s given a period of 1.2s).
 )
 
 //nolint:gochecknoglobals // Cosmos-style
@@ -64,6 +70,9 @@ var (
 		upgradetypes.ModuleName,
 		valsynctypes.ModuleName,
 		engevmtypes.ModuleName,
+		registrytypes.ModuleName,
+		portaltypes.ModuleName,
+		attesttypes.ModuleName,
 	}
 
 	beginBlockers = []string{
@@ -76,7 +85,8 @@ var (
 
 	endBlockers = []string{
 		attesttypes.ModuleName,
-		valsynctypes.ModuleName, // Wraps staking module end blocker (must come after attest module)
+		evmstaking2types.ModuleName, // Delivers buffered EVM staking events (must come before valsync module)
+		valsynctypes.ModuleName,     // Wraps staking module end blocker (must come after attest module)
 		upgradetypes.ModuleName,
 	}
 
@@ -179,12 +189,20 @@ var (
 						VoteExtensionLimit: genesisVoteExtLimit,
 						TrimLag:            genesisTrimLag,
 						ConsensusTrimLag:   genesisCTrimLag,
+						LivenessWindow:     genesisLivenessWindow,
+						LivenessMinVoted:   genesisLivenessMinVoted,
 					}),
 				},
 				{
 					Name:   valsynctypes.ModuleName,
 					Config: appconfig.WrapAny(&valsyncmodule.Module{}),
 				},
+				{
+					Name: evmstaking2types.ModuleName,
+					Config: appconfig.WrapAny(&evmstaking2module.Module{
+						DeliverInterval: genesisEVMStakingDeliverInterval,
+					}),
+				},
 				{
 					Name:   portaltypes.ModuleName,
 					Config: appconfig.WrapAny(&portalmodule.Module{}),
@@ -0,0 +1,204 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/log"
+	"github.com/omni-network/omni/lib/netconf"
+	etypes "github.com/omni-network/omni/octane/evmengine/types"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+
+	dbm "github.com/cosmos/cosmos-db"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	gtypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+// ExportConfig configures the consensus state genesis export.
+type ExportConfig struct {
+	// Height to export, defaults to the latest height if zero.
+	Height int64
+	// OutputFile is the path of the exported genesis file.
+	OutputFile string
+	// ExecutionGenesisFile is the optional path of the execution genesis file to pair the export with.
+	// Its block hash replaces the exported execution head, starting a new execution chain at height 0.
+	ExecutionGenesisFile string
+}
+
+// Export exports the application state of all modules and the bonded validators at the configured height
+// as a genesis file. Starting a halo node from the exported genesis continues at the next height.
+// By default, it continues building on top of the exported execution head, so the execution chain must be retained.
+// If an execution genesis file is configured, it continues from that execution genesis block instead.
+func Export(ctx context.Context, cfg Config, eCfg ExportConfig) error {
+	if eCfg.OutputFile == "" {
+		return errors.New("output file required")
+	}
+
+	db, err := dbm.NewDB("application", cfg.BackendType(), cfg.DataDir())
+	if err != nil {
+		return errors.Wrap(err, "create db")
+	}
+	defer db.Close()
+
+	baseAppOpts, err := makeBaseAppOpts(cfg)
+	if err != nil {
+		return errors.Wrap(err, "make base app opts")
+	}
+
+	engineCl, err := newEngineClient(ctx, cfg, cfg.Network, nil)
+	if err != nil {
+		return err
+	}
+
+	privVal, err := loadPrivVal(cfg)
+	if err != nil {
+		return errors.Wrap(err, "load validator key")
+	}
+
+	voter, err := newVoterLoader(privVal.Key.PrivKey)
+	if err != nil {
+		return errors.Wrap(err, "new voter loader")
+	}
+
+	//nolint:contextcheck // False positive.
+	app, err := newApp(
+		newSDKLogger(ctx),
+		db,
+		engineCl,
+		voter,
+		netconf.ChainVersionNamer(cfg.Network),
+		netconf.ChainNamer(cfg.Network),
+		burnEVMFees{},
+		serverAppOptsFromCfg(cfg),
+		make(chan<- error, 1),
+		baseAppOpts...,
+	)
+	if err != nil {
+		return errors.Wrap(err, "new app")
+	}
+
+	if eCfg.Height > 0 {
+		if err := app.LoadHeight(eCfg.Height); err != nil {
+			return errors.Wrap(err, "load height", "height", eCfg.Height)
+		}
+	}
+
+	exported, err := app.ExportAppStateAndValidators(false, nil, nil)
+	if err != nil {
+		return errors.Wrap(err, "export app state")
+	}
+
+	if eCfg.ExecutionGenesisFile != "" {
+		hash, err := executionGenesisHash(eCfg.ExecutionGenesisFile)
+		if err != nil {
+			return err
+		}
+
+		exported.AppState, err = replaceExecutionBlockHash(app, exported.AppState, hash)
+		if err != nil {
+			return err
+		}
+	}
+
+	appGen, err := gtypes.AppGenesisFromFile(cfg.Comet.GenesisFile())
+	if err != nil {
+		return errors.Wrap(err, "load genesis file")
+	}
+
+	consensus := gtypes.NewConsensusGenesis(exported.ConsensusParams, exported.Validators)
+	// NewConsensusGenesis has a bug, it doesn't set VoteExtensionsEnableHeight
+	consensus.Params.ABCI.VoteExtensionsEnableHeight = exported.ConsensusParams.GetAbci().GetVoteExtensionsEnableHeight()
+
+	appGen.AppState = exported.AppState
+	appGen.InitialHeight = exported.Height
+	appGen.Consensus = consensus
+
+	if err := appGen.ValidateAndComplete(); err != nil {
+		return errors.Wrap(err, "validate genesis")
+	}
+
+	if err := appGen.SaveAs(eCfg.OutputFile); err != nil {
+		return errors.Wrap(err, "save genesis")
+	}
+
+	log.Info(ctx, "Exported consensus state genesis",
+		"height", exported.Height-1,
+		"initial_height", exported.Height,
+		"validators", len(exported.Validators),
+		"file", eCfg.OutputFile,
+	)
+
+	return nil
+}
+
+// ExportAppStateAndValidators exports the application state of all modules and the bonded validators
+// at the latest loaded height. Zero height exports (resetting heights and state) aren't supported,
+// since importing the exported state continues at the next height.
+func (a App) ExportAppStateAndValidators(forZeroHeight bool, jailAllowedAddrs, modulesToExport []string) (servertypes.ExportedApp, error) {
+	if forZeroHeight || len(jailAllowedAddrs) > 0 {
+		return servertypes.ExportedApp{}, errors.New("zero height export not supported")
+	}
+
+	ctx := a.NewContextLegacy(true, cmtproto.Header{Height: a.LastBlockHeight()})
+
+	genState, err := a.ModuleManager.ExportGenesisForModules(ctx, a.appCodec, modulesToExport)
+	if err != nil {
+		return servertypes.ExportedApp{}, errors.Wrap(err, "export modules genesis")
+	}
+
+	appState, err := json.MarshalIndent(genState, "", "  ")
+	if err != nil {
+		return servertypes.ExportedApp{}, errors.Wrap(err, "marshal app state")
+	}
+
+	validators, err := staking.WriteValidators(ctx, a.StakingKeeper)
+	if err != nil {
+		return servertypes.ExportedApp{}, errors.Wrap(err, "export validators")
+	}
+
+	return servertypes.ExportedApp{
+		AppState:        appState,
+		Validators:      validators,
+		Height:          a.LastBlockHeight() + 1,
+		ConsensusParams: a.GetConsensusParams(ctx),
+	}, nil
+}
+
+// replaceExecutionBlockHash returns the app state with the evmengine execution head replaced by the execution genesis block.
+func replaceExecutionBlockHash(app *App, appState json.RawMessage, hash common.Hash) (json.RawMessage, error) {
+	var genState map[string]json.RawMessage
+	if err := json.Unmarshal(appState, &genState); err != nil {
+		return nil, errors.Wrap(err, "unmarshal app state")
+	}
+
+	genState[etypes.ModuleName] = app.appCodec.MustMarshalJSON(etypes.NewGenesisState(hash))
+
+	resp, err := json.MarshalIndent(genState, "", "  ")
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal app state")
+	}
+
+	return resp, nil
+}
+
+// executionGenesisHash returns the genesis block hash of the execution genesis file.
+func executionGenesisHash(file string) (common.Hash, error) {
+	bz, err := os.ReadFile(file)
+	if err != nil {
+		return common.Hash{}, errors.Wrap(err, "read execution genesis file")
+	}
+
+	var genesis core.Genesis
+	if err := json.Unmarshal(bz, &genesis); err != nil {
+		return common.Hash{}, errors.Wrap(err, "unmarshal execution genesis")
+	}
+
+	return genesis.ToBlock().Hash(), nil
+}
@@ -65,6 +65,7 @@ func (l *voterLoader) LazyLoad(
 	netID netconf.ID,
 	omniEVMCl ethclient.Client,
 	endpoints xchain.RPCEndpoints,
+	rpcQuorum int,
 	cprov cchain.Provider,
 	privKey crypto.PrivKey,
 	voterStateFile string,
@@ -136,12 +137,12 @@ func (l *voterLoader) LazyLoad(
 				continue
 			}
 
-			rpc, err := endpoints.ByNameOrID(chain.Name, chain.ID)
+			urls, err := endpoints.URLsByNameOrID(chain.Name, chain.ID)
 			if err != nil {
 				return err
 			}
 
-			ethCl, err := ethclient.Dial(chain.Name, rpc)
+			ethCl, err := ethclient.DialMulti(chain.Name, urls, ethclient.WithQuorum(rpcQuorum))
 			if err != nil {
 				return err
 			}
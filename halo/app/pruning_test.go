@@ -10,6 +10,7 @@ import (
 	"time"
 
 	haloapp "github.com/omni-network/omni/halo/app"
+	uluwatu1 "github.com/omni-network/omni/halo/app/upgrades/uluwatu"
 	cprovider "github.com/omni-network/omni/lib/cchain/provider"
 	"github.com/omni-network/omni/lib/evmchain"
 	"github.com/omni-network/omni/lib/log"
@@ -30,7 +31,7 @@ func TestPruningHistory(t *testing.T) {
 	ctx, err := log.Init(ctx, log.Config{Color: log.ColorForce, Level: "debug", Format: log.FormatConsole})
 	require.NoError(t, err)
 
-	cfg := setupSimnet(t)
+	cfg := setupSimnet(t, uluwatu1.UpgradeName)
 
 	// Prune everything > 2 blocks old (every interval=10 blocks)
 	cfg.PruningOption = pruningtypes.PruningOptionEverything
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/hex"
 	"os"
+	"testing"
 	"time"
 
 	"github.com/omni-network/omni/halo/comet"
@@ -194,6 +195,7 @@ func Start(ctx context.Context, cfg Config) (<-chan error, func(context.Context)
 			cfg.Network,
 			engineCl,
 			cfg.RPCEndpoints,
+			cfg.RPCQuorum,
 			cProvider,
 			privVal.Key.PrivKey,
 			cfg.VoterStateFile(),
@@ -394,14 +396,31 @@ func chainIDFromGenesis(cfg Config) (string, error) {
 	return genDoc.ChainID, nil
 }
 
+// newSimnetEngine returns the simnet engine API mock, it is aliased for testing.
+//
+//nolint:gochecknoglobals // Aliased for testing.
+var newSimnetEngine = func(pubkey crypto.PubKey) (ethclient.EngineClient, error) {
+	return ethclient.NewEngineMock(
+		ethclient.WithPortalRegister(netconf.SimnetNetwork()),
+		ethclient.WithFarFutureUpgradePlan(),
+		ethclient.WithMockSelfDelegation(pubkey, 1),
+	)
+}
+
+// SetSimnetEngineForT sets the simnet engine API mock constructor for the duration of the test.
+func SetSimnetEngineForT(t *testing.T, fn func(pubkey crypto.PubKey) (ethclient.EngineClient, error)) {
+	t.Helper()
+	cached := newSimnetEngine
+	newSimnetEngine = fn
+	t.Cleanup(func() {
+		newSimnetEngine = cached
+	})
+}
+
 // newEngineClient returns a new engine API client.
 func newEngineClient(ctx context.Context, cfg Config, network netconf.ID, pubkey crypto.PubKey) (ethclient.EngineClient, error) {
 	if network == netconf.Simnet {
-		return ethclient.NewEngineMock(
-			ethclient.WithPortalRegister(netconf.SimnetNetwork()),
-			ethclient.WithFarFutureUpgradePlan(),
-			ethclient.WithMockSelfDelegation(pubkey, 1),
-		)
+		return newSimnetEngine(pubkey)
 	}
 
 	jwtBytes, err := ethclient.LoadJWTHexFile(cfg.EngineJWTFile)
@@ -39,7 +39,7 @@ func TestSmoke(t *testing.T) {
 	ctx, err := log.Init(ctx, log.Config{Color: log.ColorForce, Level: "debug", Format: log.FormatConsole})
 	require.NoError(t, err)
 
-	cfg := setupSimnet(t)
+	cfg := setupSimnet(t, uluwatu1.UpgradeName)
 
 	// Start the server async
 	async, stopfunc, err := haloapp.Start(ctx, cfg)
@@ -193,7 +193,7 @@ func testCProvider(t *testing.T, ctx context.Context, cprov cprovider.Provider)
 	}, time.Second*5, time.Millisecond*100)
 }
 
-func setupSimnet(t *testing.T) haloapp.Config {
+func setupSimnet(t *testing.T, genesisUpgrade string) haloapp.Config {
 	t.Helper()
 	homeDir := t.TempDir()
 
@@ -225,7 +225,7 @@ func setupSimnet(t *testing.T) haloapp.Config {
 		HomeDir:        homeDir,
 		Network:        netconf.Simnet,
 		ExecutionHash:  executionGenesis.Hash(),
-		GenesisUpgrade: uluwatu1.UpgradeName,
+		GenesisUpgrade: genesisUpgrade,
 	})
 	tutil.RequireNoError(t, err)
 
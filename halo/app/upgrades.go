@@ -1,6 +1,7 @@
 package app
 
 import (
+	magellan2 "github.com/omni-network/omni/halo/app/upgrades/magellan"
 	uluwatu1 "github.com/omni-network/omni/halo/app/upgrades/uluwatu"
 	"github.com/omni-network/omni/lib/errors"
 
@@ -19,6 +20,11 @@ func (a App) setUpgradeHandlers() error {
 			Handler: uluwatu1.CreateUpgradeHandler(a.ModuleManager, a.Configurator(), a.SlashingKeeper),
 			Store:   uluwatu1.StoreUpgrades,
 		},
+		{
+			Name:    magellan2.UpgradeName,
+			Handler: magellan2.CreateUpgradeHandler(a.ModuleManager, a.Configurator()),
+			Store:   magellan2.StoreUpgrades,
+		},
 	}
 
 	for _, u := range upgrades {
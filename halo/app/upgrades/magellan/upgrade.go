@@ -0,0 +1,32 @@
+// Package magellan defines the second omni consensus chain upgrade named after the Portuguese explorer.
+// It enables attestation double sign slashing and attestation liveness tracking in the attest module,
+// including their new attest module store state.
+// It also replaces the legacy EVM staking event processor with the evmstaking2 module,
+// adding its store and enabling undelegations, validator edits and execution payload withdrawals.
+// It doesn't include any store migrations.
+package magellan
+
+import (
+	"context"
+
+	evmstakingtypes "github.com/omni-network/omni/halo/evmstaking2/types"
+
+	storetypes "cosmossdk.io/store/types"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+const UpgradeName = "2_magellan"
+
+var StoreUpgrades = storetypes.StoreUpgrades{
+	Added: []string{evmstakingtypes.ModuleName},
+}
+
+func CreateUpgradeHandler(
+	mm *module.Manager,
+	configurator module.Configurator,
+) upgradetypes.UpgradeHandler {
+	return func(ctx context.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		return mm.RunMigrations(ctx, configurator, fromVM)
+	}
+}
@@ -0,0 +1,139 @@
+// Only run this if -race=false, since CosmosSDK has known data races when doing gRPC queries.
+//go:build !race
+
+//nolint:paralleltest // CosmosSDK dependency injection prevents parallel execution
+package app_test
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	haloapp "github.com/omni-network/omni/halo/app"
+	magellan2 "github.com/omni-network/omni/halo/app/upgrades/magellan"
+	"github.com/omni-network/omni/lib/ethclient"
+	"github.com/omni-network/omni/lib/k1util"
+	"github.com/omni-network/omni/lib/log"
+	"github.com/omni-network/omni/lib/netconf"
+	"github.com/omni-network/omni/lib/tutil"
+
+	"github.com/cometbft/cometbft/crypto"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestUndelegateWithdrawal ensures that an EVM staking undelegation results in an execution payload
+// withdrawal of the unbonded stake to the delegator, once the unbonding period has passed.
+func TestUndelegateWithdrawal(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ctx, err := log.Init(ctx, log.Config{Color: log.ColorForce, Level: "debug", Format: log.FormatConsole})
+	require.NoError(t, err)
+
+	cfg := setupSimnet(t, magellan2.UpgradeName)
+	setUnbondingTime(t, cfg, time.Second)
+
+	engineCl := new(withdrawalsEngine)
+	var valAddr common.Address
+	haloapp.SetSimnetEngineForT(t, func(pubkey crypto.PubKey) (ethclient.EngineClient, error) {
+		valAddr, err = k1util.PubKeyToAddress(pubkey)
+		if err != nil {
+			return nil, err
+		}
+
+		mock, err := ethclient.NewEngineMock(
+			ethclient.WithPortalRegister(netconf.SimnetNetwork()),
+			ethclient.WithMockSelfDelegation(pubkey, 1),
+			ethclient.WithMockUndelegation(pubkey, 1),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		engineCl.EngineClient = mock
+
+		return engineCl, nil
+	})
+
+	// Start the server async
+	async, stopfunc, err := haloapp.Start(ctx, cfg)
+	require.NoError(t, err)
+	go func() {
+		tutil.RequireNoError(t, <-async)
+	}()
+
+	// Wait for the undelegated stake to be withdrawn to the validator.
+	gwei := uint64(params.Ether / params.GWei)
+	require.Eventually(t, func() bool {
+		return engineCl.Withdrawn(valAddr) >= gwei
+	}, time.Minute, time.Millisecond*100)
+
+	cancel()
+
+	// Stop the server, with a fresh context
+	require.NoError(t, stopfunc(context.Background()))
+}
+
+// setUnbondingTime sets the x/staking unbonding time in the genesis file.
+func setUnbondingTime(t *testing.T, cfg haloapp.Config, unbonding time.Duration) {
+	t.Helper()
+
+	genDoc, err := cmttypes.GenesisDocFromFile(cfg.Comet.GenesisFile())
+	require.NoError(t, err)
+
+	var appState map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(genDoc.AppState, &appState))
+
+	var staking map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(appState["staking"], &staking))
+	var stakingParams map[string]any
+	require.NoError(t, json.Unmarshal(staking["params"], &stakingParams))
+	stakingParams["unbonding_time"] = unbonding.String()
+
+	staking["params"], err = json.Marshal(stakingParams)
+	require.NoError(t, err)
+	appState["staking"], err = json.Marshal(staking)
+	require.NoError(t, err)
+	genDoc.AppState, err = json.Marshal(appState)
+	require.NoError(t, err)
+
+	require.NoError(t, genDoc.SaveAs(cfg.Comet.GenesisFile()))
+}
+
+// withdrawalsEngine wraps an engine client and sums the withdrawals of new payloads by address.
+type withdrawalsEngine struct {
+	ethclient.EngineClient
+
+	mu        sync.Mutex
+	withdrawn map[common.Address]uint64
+}
+
+func (e *withdrawalsEngine) NewPayloadV3(ctx context.Context, params engine.ExecutableData, versionedHashes []common.Hash, beaconRoot *common.Hash) (engine.PayloadStatusV1, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.withdrawn == nil {
+		e.withdrawn = make(map[common.Address]uint64)
+	}
+	for _, withdrawal := range params.Withdrawals {
+		e.withdrawn[withdrawal.Address] += withdrawal.Amount
+	}
+
+	return e.EngineClient.NewPayloadV3(ctx, params, versionedHashes, beaconRoot)
+}
+
+// Withdrawn returns the total amount of gwei withdrawn to the address.
+func (e *withdrawalsEngine) Withdrawn(addr common.Address) uint64 {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return e.withdrawn[addr]
+}
@@ -436,16 +436,394 @@ func NewSignatureTable(db ormtable.Schema) (SignatureTable, error) {
 	return signatureTable{table.(ormtable.AutoIncrementTable)}, nil
 }
 
+type ValidatorLivenessTable interface {
+	Insert(ctx context.Context, validatorLiveness *ValidatorLiveness) error
+	Update(ctx context.Context, validatorLiveness *ValidatorLiveness) error
+	Save(ctx context.Context, validatorLiveness *ValidatorLiveness) error
+	Delete(ctx context.Context, validatorLiveness *ValidatorLiveness) error
+	Has(ctx context.Context, validator_address []byte) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, validator_address []byte) (*ValidatorLiveness, error)
+	List(ctx context.Context, prefixKey ValidatorLivenessIndexKey, opts ...ormlist.Option) (ValidatorLivenessIterator, error)
+	ListRange(ctx context.Context, from, to ValidatorLivenessIndexKey, opts ...ormlist.Option) (ValidatorLivenessIterator, error)
+	DeleteBy(ctx context.Context, prefixKey ValidatorLivenessIndexKey) error
+	DeleteRange(ctx context.Context, from, to ValidatorLivenessIndexKey) error
+
+	doNotImplement()
+}
+
+type ValidatorLivenessIterator struct {
+	ormtable.Iterator
+}
+
+func (i ValidatorLivenessIterator) Value() (*ValidatorLiveness, error) {
+	var validatorLiveness ValidatorLiveness
+	err := i.UnmarshalMessage(&validatorLiveness)
+	return &validatorLiveness, err
+}
+
+type ValidatorLivenessIndexKey interface {
+	id() uint32
+	values() []interface{}
+	validatorLivenessIndexKey()
+}
+
+// primary key starting index..
+type ValidatorLivenessPrimaryKey = ValidatorLivenessValidatorAddressIndexKey
+
+type ValidatorLivenessValidatorAddressIndexKey struct {
+	vs []interface{}
+}
+
+func (x ValidatorLivenessValidatorAddressIndexKey) id() uint32                 { return 0 }
+func (x ValidatorLivenessValidatorAddressIndexKey) values() []interface{}      { return x.vs }
+func (x ValidatorLivenessValidatorAddressIndexKey) validatorLivenessIndexKey() {}
+
+func (this ValidatorLivenessValidatorAddressIndexKey) WithValidatorAddress(validator_address []byte) ValidatorLivenessValidatorAddressIndexKey {
+	this.vs = []interface{}{validator_address}
+	return this
+}
+
+type validatorLivenessTable struct {
+	table ormtable.Table
+}
+
+func (this validatorLivenessTable) Insert(ctx context.Context, validatorLiveness *ValidatorLiveness) error {
+	return this.table.Insert(ctx, validatorLiveness)
+}
+
+func (this validatorLivenessTable) Update(ctx context.Context, validatorLiveness *ValidatorLiveness) error {
+	return this.table.Update(ctx, validatorLiveness)
+}
+
+func (this validatorLivenessTable) Save(ctx context.Context, validatorLiveness *ValidatorLiveness) error {
+	return this.table.Save(ctx, validatorLiveness)
+}
+
+func (this validatorLivenessTable) Delete(ctx context.Context, validatorLiveness *ValidatorLiveness) error {
+	return this.table.Delete(ctx, validatorLiveness)
+}
+
+func (this validatorLivenessTable) Has(ctx context.Context, validator_address []byte) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, validator_address)
+}
+
+func (this validatorLivenessTable) Get(ctx context.Context, validator_address []byte) (*ValidatorLiveness, error) {
+	var validatorLiveness ValidatorLiveness
+	found, err := this.table.PrimaryKey().Get(ctx, &validatorLiveness, validator_address)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &validatorLiveness, nil
+}
+
+func (this validatorLivenessTable) List(ctx context.Context, prefixKey ValidatorLivenessIndexKey, opts ...ormlist.Option) (ValidatorLivenessIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return ValidatorLivenessIterator{it}, err
+}
+
+func (this validatorLivenessTable) ListRange(ctx context.Context, from, to ValidatorLivenessIndexKey, opts ...ormlist.Option) (ValidatorLivenessIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return ValidatorLivenessIterator{it}, err
+}
+
+func (this validatorLivenessTable) DeleteBy(ctx context.Context, prefixKey ValidatorLivenessIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this validatorLivenessTable) DeleteRange(ctx context.Context, from, to ValidatorLivenessIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this validatorLivenessTable) doNotImplement() {}
+
+var _ ValidatorLivenessTable = validatorLivenessTable{}
+
+func NewValidatorLivenessTable(db ormtable.Schema) (ValidatorLivenessTable, error) {
+	table := db.GetTable(&ValidatorLiveness{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&ValidatorLiveness{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return validatorLivenessTable{table}, nil
+}
+
+type MissedVoteTable interface {
+	Insert(ctx context.Context, missedVote *MissedVote) error
+	Update(ctx context.Context, missedVote *MissedVote) error
+	Save(ctx context.Context, missedVote *MissedVote) error
+	Delete(ctx context.Context, missedVote *MissedVote) error
+	Has(ctx context.Context, validator_address []byte, window_index uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, validator_address []byte, window_index uint64) (*MissedVote, error)
+	List(ctx context.Context, prefixKey MissedVoteIndexKey, opts ...ormlist.Option) (MissedVoteIterator, error)
+	ListRange(ctx context.Context, from, to MissedVoteIndexKey, opts ...ormlist.Option) (MissedVoteIterator, error)
+	DeleteBy(ctx context.Context, prefixKey MissedVoteIndexKey) error
+	DeleteRange(ctx context.Context, from, to MissedVoteIndexKey) error
+
+	doNotImplement()
+}
+
+type MissedVoteIterator struct {
+	ormtable.Iterator
+}
+
+func (i MissedVoteIterator) Value() (*MissedVote, error) {
+	var missedVote MissedVote
+	err := i.UnmarshalMessage(&missedVote)
+	return &missedVote, err
+}
+
+type MissedVoteIndexKey interface {
+	id() uint32
+	values() []interface{}
+	missedVoteIndexKey()
+}
+
+// primary key starting index..
+type MissedVotePrimaryKey = MissedVoteValidatorAddressWindowIndexIndexKey
+
+type MissedVoteValidatorAddressWindowIndexIndexKey struct {
+	vs []interface{}
+}
+
+func (x MissedVoteValidatorAddressWindowIndexIndexKey) id() uint32            { return 0 }
+func (x MissedVoteValidatorAddressWindowIndexIndexKey) values() []interface{} { return x.vs }
+func (x MissedVoteValidatorAddressWindowIndexIndexKey) missedVoteIndexKey()   {}
+
+func (this MissedVoteValidatorAddressWindowIndexIndexKey) WithValidatorAddress(validator_address []byte) MissedVoteValidatorAddressWindowIndexIndexKey {
+	this.vs = []interface{}{validator_address}
+	return this
+}
+
+func (this MissedVoteValidatorAddressWindowIndexIndexKey) WithValidatorAddressWindowIndex(validator_address []byte, window_index uint64) MissedVoteValidatorAddressWindowIndexIndexKey {
+	this.vs = []interface{}{validator_address, window_index}
+	return this
+}
+
+type missedVoteTable struct {
+	table ormtable.Table
+}
+
+func (this missedVoteTable) Insert(ctx context.Context, missedVote *MissedVote) error {
+	return this.table.Insert(ctx, missedVote)
+}
+
+func (this missedVoteTable) Update(ctx context.Context, missedVote *MissedVote) error {
+	return this.table.Update(ctx, missedVote)
+}
+
+func (this missedVoteTable) Save(ctx context.Context, missedVote *MissedVote) error {
+	return this.table.Save(ctx, missedVote)
+}
+
+func (this missedVoteTable) Delete(ctx context.Context, missedVote *MissedVote) error {
+	return this.table.Delete(ctx, missedVote)
+}
+
+func (this missedVoteTable) Has(ctx context.Context, validator_address []byte, window_index uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, validator_address, window_index)
+}
+
+func (this missedVoteTable) Get(ctx context.Context, validator_address []byte, window_index uint64) (*MissedVote, error) {
+	var missedVote MissedVote
+	found, err := this.table.PrimaryKey().Get(ctx, &missedVote, validator_address, window_index)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &missedVote, nil
+}
+
+func (this missedVoteTable) List(ctx context.Context, prefixKey MissedVoteIndexKey, opts ...ormlist.Option) (MissedVoteIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return MissedVoteIterator{it}, err
+}
+
+func (this missedVoteTable) ListRange(ctx context.Context, from, to MissedVoteIndexKey, opts ...ormlist.Option) (MissedVoteIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return MissedVoteIterator{it}, err
+}
+
+func (this missedVoteTable) DeleteBy(ctx context.Context, prefixKey MissedVoteIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this missedVoteTable) DeleteRange(ctx context.Context, from, to MissedVoteIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this missedVoteTable) doNotImplement() {}
+
+var _ MissedVoteTable = missedVoteTable{}
+
+func NewMissedVoteTable(db ormtable.Schema) (MissedVoteTable, error) {
+	table := db.GetTable(&MissedVote{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&MissedVote{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return missedVoteTable{table}, nil
+}
+
+type DoubleSignVoteTable interface {
+	Insert(ctx context.Context, doubleSignVote *DoubleSignVote) error
+	Update(ctx context.Context, doubleSignVote *DoubleSignVote) error
+	Save(ctx context.Context, doubleSignVote *DoubleSignVote) error
+	Delete(ctx context.Context, doubleSignVote *DoubleSignVote) error
+	Has(ctx context.Context, validator_address []byte, chain_id uint64, conf_level uint32, attest_offset uint64, attestation_root []byte) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, validator_address []byte, chain_id uint64, conf_level uint32, attest_offset uint64, attestation_root []byte) (*DoubleSignVote, error)
+	List(ctx context.Context, prefixKey DoubleSignVoteIndexKey, opts ...ormlist.Option) (DoubleSignVoteIterator, error)
+	ListRange(ctx context.Context, from, to DoubleSignVoteIndexKey, opts ...ormlist.Option) (DoubleSignVoteIterator, error)
+	DeleteBy(ctx context.Context, prefixKey DoubleSignVoteIndexKey) error
+	DeleteRange(ctx context.Context, from, to DoubleSignVoteIndexKey) error
+
+	doNotImplement()
+}
+
+type DoubleSignVoteIterator struct {
+	ormtable.Iterator
+}
+
+func (i DoubleSignVoteIterator) Value() (*DoubleSignVote, error) {
+	var doubleSignVote DoubleSignVote
+	err := i.UnmarshalMessage(&doubleSignVote)
+	return &doubleSignVote, err
+}
+
+type DoubleSignVoteIndexKey interface {
+	id() uint32
+	values() []interface{}
+	doubleSignVoteIndexKey()
+}
+
+// primary key starting index..
+type DoubleSignVotePrimaryKey = DoubleSignVoteValidatorAddressChainIdConfLevelAttestOffsetAttestationRootIndexKey
+
+type DoubleSignVoteValidatorAddressChainIdConfLevelAttestOffsetAttestationRootIndexKey struct {
+	vs []interface{}
+}
+
+func (x DoubleSignVoteValidatorAddressChainIdConfLevelAttestOffsetAttestationRootIndexKey) id() uint32 {
+	return 0
+}
+func (x DoubleSignVoteValidatorAddressChainIdConfLevelAttestOffsetAttestationRootIndexKey) values() []interface{} {
+	return x.vs
+}
+func (x DoubleSignVoteValidatorAddressChainIdConfLevelAttestOffsetAttestationRootIndexKey) doubleSignVoteIndexKey() {
+}
+
+func (this DoubleSignVoteValidatorAddressChainIdConfLevelAttestOffsetAttestationRootIndexKey) WithValidatorAddress(validator_address []byte) DoubleSignVoteValidatorAddressChainIdConfLevelAttestOffsetAttestationRootIndexKey {
+	this.vs = []interface{}{validator_address}
+	return this
+}
+
+func (this DoubleSignVoteValidatorAddressChainIdConfLevelAttestOffsetAttestationRootIndexKey) WithValidatorAddressChainId(validator_address []byte, chain_id uint64) DoubleSignVoteValidatorAddressChainIdConfLevelAttestOffsetAttestationRootIndexKey {
+	this.vs = []interface{}{validator_address, chain_id}
+	return this
+}
+
+func (this DoubleSignVoteValidatorAddressChainIdConfLevelAttestOffsetAttestationRootIndexKey) WithValidatorAddressChainIdConfLevel(validator_address []byte, chain_id uint64, conf_level uint32) DoubleSignVoteValidatorAddressChainIdConfLevelAttestOffsetAttestationRootIndexKey {
+	this.vs = []interface{}{validator_address, chain_id, conf_level}
+	return this
+}
+
+func (this DoubleSignVoteValidatorAddressChainIdConfLevelAttestOffsetAttestationRootIndexKey) WithValidatorAddressChainIdConfLevelAttestOffset(validator_address []byte, chain_id uint64, conf_level uint32, attest_offset uint64) DoubleSignVoteValidatorAddressChainIdConfLevelAttestOffsetAttestationRootIndexKey {
+	this.vs = []interface{}{validator_address, chain_id, conf_level, attest_offset}
+	return this
+}
+
+func (this DoubleSignVoteValidatorAddressChainIdConfLevelAttestOffsetAttestationRootIndexKey) WithValidatorAddressChainIdConfLevelAttestOffsetAttestationRoot(validator_address []byte, chain_id uint64, conf_level uint32, attest_offset uint64, attestation_root []byte) DoubleSignVoteValidatorAddressChainIdConfLevelAttestOffsetAttestationRootIndexKey {
+	this.vs = []interface{}{validator_address, chain_id, conf_level, attest_offset, attestation_root}
+	return this
+}
+
+type doubleSignVoteTable struct {
+	table ormtable.Table
+}
+
+func (this doubleSignVoteTable) Insert(ctx context.Context, doubleSignVote *DoubleSignVote) error {
+	return this.table.Insert(ctx, doubleSignVote)
+}
+
+func (this doubleSignVoteTable) Update(ctx context.Context, doubleSignVote *DoubleSignVote) error {
+	return this.table.Update(ctx, doubleSignVote)
+}
+
+func (this doubleSignVoteTable) Save(ctx context.Context, doubleSignVote *DoubleSignVote) error {
+	return this.table.Save(ctx, doubleSignVote)
+}
+
+func (this doubleSignVoteTable) Delete(ctx context.Context, doubleSignVote *DoubleSignVote) error {
+	return this.table.Delete(ctx, doubleSignVote)
+}
+
+func (this doubleSignVoteTable) Has(ctx context.Context, validator_address []byte, chain_id uint64, conf_level uint32, attest_offset uint64, attestation_root []byte) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, validator_address, chain_id, conf_level, attest_offset, attestation_root)
+}
+
+func (this doubleSignVoteTable) Get(ctx context.Context, validator_address []byte, chain_id uint64, conf_level uint32, attest_offset uint64, attestation_root []byte) (*DoubleSignVote, error) {
+	var doubleSignVote DoubleSignVote
+	found, err := this.table.PrimaryKey().Get(ctx, &doubleSignVote, validator_address, chain_id, conf_level, attest_offset, attestation_root)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &doubleSignVote, nil
+}
+
+func (this doubleSignVoteTable) List(ctx context.Context, prefixKey DoubleSignVoteIndexKey, opts ...ormlist.Option) (DoubleSignVoteIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return DoubleSignVoteIterator{it}, err
+}
+
+func (this doubleSignVoteTable) ListRange(ctx context.Context, from, to DoubleSignVoteIndexKey, opts ...ormlist.Option) (DoubleSignVoteIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return DoubleSignVoteIterator{it}, err
+}
+
+func (this doubleSignVoteTable) DeleteBy(ctx context.Context, prefixKey DoubleSignVoteIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this doubleSignVoteTable) DeleteRange(ctx context.Context, from, to DoubleSignVoteIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this doubleSignVoteTable) doNotImplement() {}
+
+var _ DoubleSignVoteTable = doubleSignVoteTable{}
+
+func NewDoubleSignVoteTable(db ormtable.Schema) (DoubleSignVoteTable, error) {
+	table := db.GetTable(&DoubleSignVote{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&DoubleSignVote{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return doubleSignVoteTable{table}, nil
+}
+
 type AttestationStore interface {
 	AttestationTable() AttestationTable
 	SignatureTable() SignatureTable
+	ValidatorLivenessTable() ValidatorLivenessTable
+	MissedVoteTable() MissedVoteTable
+	DoubleSignVoteTable() DoubleSignVoteTable
 
 	doNotImplement()
 }
 
 type attestationStore struct {
-	attestation AttestationTable
-	signature   SignatureTable
+	attestation       AttestationTable
+	signature         SignatureTable
+	validatorLiveness ValidatorLivenessTable
+	missedVote        MissedVoteTable
+	doubleSignVote    DoubleSignVoteTable
 }
 
 func (x attestationStore) AttestationTable() AttestationTable {
@@ -456,6 +834,18 @@ func (x attestationStore) SignatureTable() SignatureTable {
 	return x.signature
 }
 
+func (x attestationStore) ValidatorLivenessTable() ValidatorLivenessTable {
+	return x.validatorLiveness
+}
+
+func (x attestationStore) MissedVoteTable() MissedVoteTable {
+	return x.missedVote
+}
+
+func (x attestationStore) DoubleSignVoteTable() DoubleSignVoteTable {
+	return x.doubleSignVote
+}
+
 func (attestationStore) doNotImplement() {}
 
 var _ AttestationStore = attestationStore{}
@@ -471,8 +861,26 @@ func NewAttestationStore(db ormtable.Schema) (AttestationStore, error) {
 		return nil, err
 	}
 
+	validatorLivenessTable, err := NewValidatorLivenessTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	missedVoteTable, err := NewMissedVoteTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	doubleSignVoteTable, err := NewDoubleSignVoteTable(db)
+	if err != nil {
+		return nil, err
+	}
+
 	return attestationStore{
 		attestationTable,
 		signatureTable,
+		validatorLivenessTable,
+		missedVoteTable,
+		doubleSignVoteTable,
 	}, nil
 }
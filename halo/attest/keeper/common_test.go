@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"testing"
 
+	"github.com/omni-network/omni/halo/app/upgrades/magellan"
 	"github.com/omni-network/omni/halo/attest/keeper"
 	"github.com/omni-network/omni/halo/attest/testutil"
 	"github.com/omni-network/omni/halo/attest/types"
@@ -10,11 +11,14 @@ import (
 	"github.com/omni-network/omni/lib/netconf"
 	"github.com/omni-network/omni/lib/xchain"
 
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
+	evidencetypes "cosmossdk.io/x/evidence/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdktestutil "github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	stypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 )
@@ -25,6 +29,8 @@ type mocks struct {
 	namer       *testutil.MockChainNamer
 	valProvider *testutil.MockValProvider
 	registry    *testutil.MockRegistry
+	slasher     *testutil.MockSlasher
+	upgrades    *testutil.MockUpgradeKeeper
 }
 
 type expectation func(sdk.Context, mocks)
@@ -80,6 +86,47 @@ func activeSetQueried(height uint64) expectation {
 	}
 }
 
+// magellanDone returns an expectation that the 2_magellan network upgrade was executed (or not).
+func magellanDone(done bool) expectation {
+	return func(_ sdk.Context, m mocks) {
+		var height int64
+		if done {
+			height = 1
+		}
+		m.upgrades.EXPECT().GetDoneHeight(gomock.Any(), magellan.UpgradeName).Return(height, nil).AnyTimes()
+	}
+}
+
+// doubleSignTombstoned returns an expectation that the validators are already tombstoned, so not slashed for double signing.
+func doubleSignTombstoned(vals ...vtypes.Validator) expectation {
+	return func(_ sdk.Context, m mocks) {
+		for _, val := range vals {
+			cmtAddr, _ := val.CometAddress()
+			m.slasher.EXPECT().IsTombstoned(gomock.Any(), sdk.ConsAddress(cmtAddr)).Return(true)
+		}
+	}
+}
+
+// doubleSignSlashed returns an expectation that the validators are slashed, jailed (if not already jailed)
+// and tombstoned once for double signing.
+func doubleSignSlashed(jailed bool, vals ...vtypes.Validator) expectation {
+	return func(_ sdk.Context, m mocks) {
+		for _, val := range vals {
+			cmtAddr, _ := val.CometAddress()
+			consAddr := sdk.ConsAddress(cmtAddr)
+			m.slasher.EXPECT().IsTombstoned(gomock.Any(), consAddr).Return(false)
+			m.skeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), consAddr).Return(stypes.Validator{Jailed: jailed}, nil)
+			m.slasher.EXPECT().SlashFractionDoubleSign(gomock.Any()).Return(math.LegacyNewDecWithPrec(5, 2), nil)
+			m.slasher.EXPECT().SlashWithInfractionReason(gomock.Any(), consAddr, gomock.Any(), val.Power, int64(0), stypes.Infraction_INFRACTION_DOUBLE_SIGN).Return(nil)
+			if !jailed {
+				m.slasher.EXPECT().Jail(gomock.Any(), consAddr).Return(nil)
+			}
+			m.slasher.EXPECT().JailUntil(gomock.Any(), consAddr, evidencetypes.DoubleSignJailEndTime).Return(nil)
+			m.slasher.EXPECT().Tombstone(gomock.Any(), consAddr).Return(nil)
+		}
+	}
+}
+
 func setupKeeper(t *testing.T, expectations ...expectation) (*keeper.Keeper, sdk.Context) {
 	t.Helper()
 
@@ -98,6 +145,8 @@ func setupKeeper(t *testing.T, expectations ...expectation) (*keeper.Keeper, sdk
 		namer:       testutil.NewMockChainNamer(ctrl),
 		valProvider: testutil.NewMockValProvider(ctrl),
 		registry:    testutil.NewMockRegistry(ctrl),
+		slasher:     testutil.NewMockSlasher(ctrl),
+		upgrades:    testutil.NewMockUpgradeKeeper(ctrl),
 	}
 
 	if len(expectations) == 0 {
@@ -111,11 +160,13 @@ func setupKeeper(t *testing.T, expectations ...expectation) (*keeper.Keeper, sdk
 	const voteWindowUp = 1
 	const voteWindowDown = 0
 	const voteLimit = 4
-	k, err := keeper.New(codec, storeSvc, m.skeeper, m.namer.ChainName, m.voter, voteWindowUp, voteWindowDown, voteLimit, trimLag, cTrimLag)
+	k, err := keeper.New(codec, storeSvc, m.skeeper, m.namer.ChainName, m.voter, voteWindowUp, voteWindowDown, voteLimit, trimLag, cTrimLag, 0, 0)
 	require.NoError(t, err, "new keeper")
 
 	k.SetValidatorProvider(m.valProvider)
 	k.SetPortalRegistry(m.registry)
+	k.SetSlasher(m.slasher)
+	k.SetUpgradeKeeper(m.upgrades)
 
 	return k, ctx
 }
@@ -0,0 +1,242 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/omni-network/omni/halo/attest/types"
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/log"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"cosmossdk.io/orm/types/ormerrors"
+	evidencetypes "cosmossdk.io/x/evidence/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// punishDoubleSign persists the conflicting votes of the double signing validator as evidence and
+// slashes, jails and tombstones the validator. The vote must be a double sign as per isDoubleSign.
+// It is a noop before the 2_magellan network upgrade.
+//
+// Evidence is only punished once, i.e., if the conflicting vote is included again, it isn't slashed again.
+// Slashing is applied atomically and failures are logged but not returned, since
+// that would halt the chain. The evidence is persisted regardless.
+func (k *Keeper) punishDoubleSign(ctx context.Context, agg *types.AggVote, sig *types.SigTuple) error {
+	if ok, err := k.isMagellan(ctx); err != nil {
+		return err
+	} else if !ok {
+		return nil
+	}
+
+	existingSig, err := k.sigTable.GetByChainIdConfLevelAttestOffsetValidatorAddress(ctx, agg.BlockHeader.ChainId, agg.AttestHeader.ConfLevel, agg.AttestHeader.AttestOffset, sig.ValidatorAddress)
+	if err != nil {
+		return errors.Wrap(err, "get conflicting signature")
+	}
+
+	existingAtt, err := k.attTable.Get(ctx, existingSig.GetAttId())
+	if err != nil {
+		return errors.Wrap(err, "get conflicting attestation")
+	}
+
+	existing := &types.AggVote{
+		AttestHeader: &types.AttestHeader{
+			ConsensusChainId: agg.AttestHeader.ConsensusChainId,
+			SourceChainId:    existingAtt.GetChainId(),
+			ConfLevel:        existingAtt.GetConfLevel(),
+			AttestOffset:     existingAtt.GetAttestOffset(),
+		},
+		BlockHeader: &types.BlockHeader{
+			ChainId:     existingAtt.GetChainId(),
+			BlockHeight: existingAtt.GetBlockHeight(),
+			BlockHash:   existingAtt.GetBlockHash(),
+		},
+		MsgRoot: existingAtt.GetMsgRoot(),
+		Signatures: []*types.SigTuple{{
+			ValidatorAddress: existingSig.GetValidatorAddress(),
+			Signature:        existingSig.GetSignature(),
+		}},
+	}
+
+	conflicting := &types.AggVote{
+		AttestHeader: agg.AttestHeader,
+		BlockHeader:  agg.BlockHeader,
+		MsgRoot:      agg.MsgRoot,
+		Signatures:   []*types.SigTuple{sig},
+	}
+
+	addr := common.BytesToAddress(sig.ValidatorAddress)
+	attrs := []any{
+		"validator", addr,
+		"chain", k.namer(agg.AttestHeader.XChainVersion()),
+		"attest_offset", agg.AttestHeader.AttestOffset,
+	}
+
+	if _, err := k.storeDoubleSignVote(ctx, existing); err != nil {
+		return err
+	}
+
+	if isNew, err := k.storeDoubleSignVote(ctx, conflicting); err != nil {
+		return err
+	} else if !isNew {
+		log.Debug(ctx, "Not slashing already punished double sign evidence", attrs...)
+		return nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	cacheCtx, write := sdkCtx.CacheContext()
+	if slashed, err := k.slashDoubleSign(cacheCtx, addr); err != nil {
+		log.Error(ctx, "Failed slashing double signing validator", err, attrs...)
+	} else if !slashed {
+		log.Info(ctx, "Not slashing already tombstoned double signing validator", attrs...)
+	} else {
+		write()
+		doubleSignSlashCounter.WithLabelValues(addr.Hex()).Inc()
+		log.Warn(ctx, "🔪 Slashed, jailed and tombstoned double signing validator", nil, attrs...)
+	}
+
+	return nil
+}
+
+// slashDoubleSign slashes the validator by the double sign slash fraction, jails it (if not already jailed) and
+// tombstones it, so it can never unjail itself, similar to x/evidence equivocation handling.
+// It returns false if the validator was already tombstoned, since it is then already punished.
+func (k *Keeper) slashDoubleSign(ctx sdk.Context, addr common.Address) (bool, error) {
+	// Double signed votes are included in vote extensions of the previous block.
+	infractionHeight := ctx.BlockHeight() - 1
+
+	valset, err := k.valProvider.ActiveSetByHeight(ctx, uint64(infractionHeight))
+	if err != nil {
+		return false, errors.Wrap(err, "active set")
+	}
+
+	var consAddr sdk.ConsAddress
+	var power int64
+	for _, val := range valset.Validators {
+		ethAddr, err := val.EthereumAddress()
+		if err != nil {
+			return false, err
+		} else if ethAddr != addr {
+			continue
+		}
+
+		cmtAddr, err := val.CometAddress()
+		if err != nil {
+			return false, err
+		}
+
+		consAddr, power = sdk.ConsAddress(cmtAddr), val.Power
+	}
+	if consAddr == nil {
+		return false, errors.New("double signing validator not in active set")
+	}
+
+	if k.slasher.IsTombstoned(ctx, consAddr) {
+		return false, nil
+	}
+
+	val, err := k.skeeper.ValidatorByConsAddr(ctx, consAddr)
+	if err != nil {
+		return false, errors.Wrap(err, "get validator")
+	}
+
+	fraction, err := k.slasher.SlashFractionDoubleSign(ctx)
+	if err != nil {
+		return false, errors.Wrap(err, "slash fraction")
+	}
+
+	err = k.slasher.SlashWithInfractionReason(ctx, consAddr, fraction, power, infractionHeight, stypes.Infraction_INFRACTION_DOUBLE_SIGN)
+	if err != nil {
+		return false, errors.Wrap(err, "slash")
+	}
+
+	// Jail the validator if not already jailed (e.g. for downtime), since x/staking doesn't allow jailing twice.
+	if !val.IsJailed() {
+		if err := k.slasher.Jail(ctx, consAddr); err != nil {
+			return false, errors.Wrap(err, "jail")
+		}
+	}
+
+	if err := k.slasher.JailUntil(ctx, consAddr, evidencetypes.DoubleSignJailEndTime); err != nil {
+		return false, errors.Wrap(err, "jail until")
+	}
+
+	if err := k.slasher.Tombstone(ctx, consAddr); err != nil {
+		return false, errors.Wrap(err, "tombstone")
+	}
+
+	return true, nil
+}
+
+// storeDoubleSignVote persists the double sign vote, keyed by validator, attest header and attestation root.
+// It returns false if the vote was already persisted.
+func (k *Keeper) storeDoubleSignVote(ctx context.Context, vote *types.AggVote) (bool, error) {
+	if len(vote.Signatures) != 1 {
+		return false, errors.New("double sign vote must have single signature [BUG]")
+	}
+
+	attRoot, err := vote.AttestationRoot()
+	if err != nil {
+		return false, errors.Wrap(err, "attestation root")
+	}
+
+	err = k.doubleSignTable.Insert(ctx, &DoubleSignVote{
+		ValidatorAddress: vote.Signatures[0].GetValidatorAddress(),
+		ChainId:          vote.AttestHeader.GetSourceChainId(),
+		ConfLevel:        vote.AttestHeader.GetConfLevel(),
+		AttestOffset:     vote.AttestHeader.GetAttestOffset(),
+		AttestationRoot:  attRoot[:],
+		ConsensusChainId: vote.AttestHeader.GetConsensusChainId(),
+		BlockHeight:      vote.BlockHeader.GetBlockHeight(),
+		BlockHash:        vote.BlockHeader.GetBlockHash(),
+		MsgRoot:          vote.GetMsgRoot(),
+		Signature:        vote.Signatures[0].GetSignature(),
+		CreatedHeight:    uint64(sdk.UnwrapSDKContext(ctx).BlockHeight()),
+	})
+	if errors.Is(err, ormerrors.AlreadyExists) {
+		return false, nil
+	} else if err != nil {
+		return false, errors.Wrap(err, "insert double sign vote")
+	}
+
+	return true, nil
+}
+
+// DoubleSignVotes returns all persisted conflicting (double signed) votes of the validator, for auditing.
+// Votes are ordered by chain, confirmation level and attest offset, so conflicting votes are adjacent.
+func (k *Keeper) DoubleSignVotes(ctx context.Context, validator common.Address) ([]*types.AggVote, error) {
+	iter, err := k.doubleSignTable.List(ctx, DoubleSignVotePrimaryKey{}.WithValidatorAddress(validator.Bytes()))
+	if err != nil {
+		return nil, errors.Wrap(err, "list double sign votes")
+	}
+	defer iter.Close()
+
+	var resp []*types.AggVote
+	for iter.Next() {
+		vote, err := iter.Value()
+		if err != nil {
+			return nil, errors.Wrap(err, "value double sign vote")
+		}
+
+		resp = append(resp, &types.AggVote{
+			AttestHeader: &types.AttestHeader{
+				ConsensusChainId: vote.GetConsensusChainId(),
+				SourceChainId:    vote.GetChainId(),
+				ConfLevel:        vote.GetConfLevel(),
+				AttestOffset:     vote.GetAttestOffset(),
+			},
+			BlockHeader: &types.BlockHeader{
+				ChainId:     vote.GetChainId(),
+				BlockHeight: vote.GetBlockHeight(),
+				BlockHash:   vote.GetBlockHash(),
+			},
+			MsgRoot: vote.GetMsgRoot(),
+			Signatures: []*types.SigTuple{{
+				ValidatorAddress: vote.GetValidatorAddress(),
+				Signature:        vote.GetSignature(),
+			}},
+		})
+	}
+
+	return resp, nil
+}
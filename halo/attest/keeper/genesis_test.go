@@ -0,0 +1,58 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/omni-network/omni/halo/attest/keeper"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesisExportImport(t *testing.T) {
+	t.Parallel()
+
+	exporter, exportCtx := setupKeeper(t, mockDefaultExpectations, magellanDone(true), doubleSignSlashed(false, val1, val2))
+	require.NoError(t, exporter.Add(exportCtx, defaultMsg().Msg()))
+
+	// Double sign the default vote, persisting double sign evidence.
+	doubleSign := defaultAggVote().WithMsgRoot(common.BytesToHash([]byte("different root"))).Vote()
+	require.NoError(t, exporter.Add(exportCtx, defaultMsg().WithVotes(doubleSign).Msg()))
+
+	exported, err := exporter.ExportGenesis(exportCtx)
+	require.NoError(t, err)
+
+	importer, importCtx := setupKeeper(t)
+
+	// Default genesis is a noop
+	require.NoError(t, importer.InitGenesis(importCtx, json.RawMessage("{}")))
+	require.NoError(t, importer.InitGenesis(importCtx, nil))
+
+	require.NoError(t, importer.InitGenesis(importCtx, exported))
+
+	reexported, err := importer.ExportGenesis(importCtx)
+	require.NoError(t, err)
+	require.JSONEq(t, string(exported), string(reexported))
+
+	expectAtts, expectSigs := dumpTables(t, exportCtx, exporter)
+	require.NotEmpty(t, expectAtts)
+	require.NotEmpty(t, expectSigs)
+
+	atts, sigs := dumpTables(t, importCtx, importer)
+	require.Empty(t, cmp.Diff(expectAtts, atts, cmpopts.IgnoreUnexported(keeper.Attestation{})))
+	require.Empty(t, cmp.Diff(expectSigs, sigs, cmpopts.IgnoreUnexported(keeper.Signature{})))
+
+	addr, err := val1.EthereumAddress()
+	require.NoError(t, err)
+	expectVotes, err := exporter.DoubleSignVotes(exportCtx, addr)
+	require.NoError(t, err)
+	require.Len(t, expectVotes, 2)
+
+	votes, err := importer.DoubleSignVotes(importCtx, addr)
+	require.NoError(t, err)
+	require.Equal(t, expectVotes, votes)
+}
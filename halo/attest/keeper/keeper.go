@@ -3,7 +3,9 @@ package keeper
 import (
 	"bytes"
 	"context"
+	"time"
 
+	"github.com/omni-network/omni/halo/app/upgrades/magellan"
 	"github.com/omni-network/omni/halo/attest/types"
 	rtypes "github.com/omni-network/omni/halo/registry/types"
 	vtypes "github.com/omni-network/omni/halo/valsync/types"
@@ -19,12 +21,13 @@ import (
 
 	ormv1alpha1 "cosmossdk.io/api/cosmos/orm/v1alpha1"
 	"cosmossdk.io/core/store"
+	"cosmossdk.io/math"
 	"cosmossdk.io/orm/model/ormdb"
 	"cosmossdk.io/orm/model/ormlist"
 	"cosmossdk.io/orm/types/ormerrors"
-	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	"github.com/cosmos/gogoproto/proto"
 )
@@ -38,15 +41,21 @@ var _ sdk.VerifyVoteExtensionHandler = (*Keeper)(nil).VerifyVoteExtension
 // Keeper is the attestation keeper.
 // It keeps tracks of all attestations included on-chain and detects when they are approved.
 type Keeper struct {
-	attTable       AttestationTable
-	sigTable       SignatureTable
-	cdc            codec.BinaryCodec
-	storeService   store.KVStoreService
-	skeeper        baseapp.ValidatorStore
-	valProvider    vtypes.ValidatorProvider
-	portalRegistry rtypes.PortalRegistry
-	namer          types.ChainVerNameFunc
-	voter          types.Voter
+	db              ormdb.ModuleDB
+	attTable        AttestationTable
+	sigTable        SignatureTable
+	livenessTable   ValidatorLivenessTable
+	missedTable     MissedVoteTable
+	doubleSignTable DoubleSignVoteTable
+	cdc             codec.BinaryCodec
+	storeService    store.KVStoreService
+	skeeper         types.StakingKeeper
+	valProvider     vtypes.ValidatorProvider
+	portalRegistry  rtypes.PortalRegistry
+	namer           types.ChainVerNameFunc
+	voter           types.Voter
+	slasher         types.Slasher
+	upgrades        types.UpgradeKeeper
 
 	voteWindowUp   uint64 // Vote window upper bound delta
 	voteWindowDown uint64 // Vote window lower bound delta
@@ -54,6 +63,9 @@ type Keeper struct {
 	trimLag        uint64 // Non-consensus chain trim lag
 	cTrimLag       uint64 // Consensus chain trim lag
 
+	livenessWindow   uint64 // Liveness window of expected votes, zero disables liveness tracking
+	livenessMinVoted uint64 // Minimum included votes in the liveness window
+
 	valAddrCache *valAddrCache
 }
 
@@ -61,7 +73,7 @@ type Keeper struct {
 func New(
 	cdc codec.BinaryCodec,
 	storeSvc store.KVStoreService,
-	skeeper baseapp.ValidatorStore,
+	skeeper types.StakingKeeper,
 	namer types.ChainVerNameFunc,
 	voter types.Voter,
 	voteWindowUp uint64,
@@ -69,6 +81,8 @@ func New(
 	voteExtLimit uint64,
 	trimLag uint64,
 	cTrimLag uint64,
+	livenessWindow uint64,
+	livenessMinVoted uint64,
 ) (*Keeper, error) {
 	schema := &ormv1alpha1.ModuleSchemaDescriptor{SchemaFile: []*ormv1alpha1.ModuleSchemaDescriptor_FileEntry{
 		{Id: 1, ProtoFileName: File_halo_attest_keeper_attestation_proto.Path()},
@@ -88,21 +102,33 @@ func New(
 		return nil, errors.New("consensus trim lag must be greater than or equal to trim lag")
 	}
 
+	if livenessMinVoted > livenessWindow {
+		return nil, errors.New("liveness min voted must be less than or equal to liveness window")
+	}
+
 	k := &Keeper{
-		attTable:       attstore.AttestationTable(),
-		sigTable:       attstore.SignatureTable(),
-		cdc:            cdc,
-		storeService:   storeSvc,
-		skeeper:        skeeper,
-		namer:          namer,
-		voter:          voter,
-		voteWindowUp:   voteWindowUp,
-		voteWindowDown: voteWindowDown,
-		voteExtLimit:   voteExtLimit,
-		trimLag:        trimLag,
-		cTrimLag:       cTrimLag,
-		portalRegistry: stubPortalRegistry{},
-		valAddrCache:   new(valAddrCache),
+		db:               modDB,
+		attTable:         attstore.AttestationTable(),
+		sigTable:         attstore.SignatureTable(),
+		livenessTable:    attstore.ValidatorLivenessTable(),
+		missedTable:      attstore.MissedVoteTable(),
+		doubleSignTable:  attstore.DoubleSignVoteTable(),
+		cdc:              cdc,
+		storeService:     storeSvc,
+		skeeper:          skeeper,
+		namer:            namer,
+		voter:            voter,
+		voteWindowUp:     voteWindowUp,
+		voteWindowDown:   voteWindowDown,
+		voteExtLimit:     voteExtLimit,
+		trimLag:          trimLag,
+		cTrimLag:         cTrimLag,
+		livenessWindow:   livenessWindow,
+		livenessMinVoted: livenessMinVoted,
+		portalRegistry:   stubPortalRegistry{},
+		slasher:          stubSlasher{},
+		upgrades:         stubUpgradeKeeper{},
+		valAddrCache:     new(valAddrCache),
 	}
 
 	return k, nil
@@ -118,6 +144,27 @@ func (k *Keeper) SetPortalRegistry(portalRegistry rtypes.PortalRegistry) {
 	k.portalRegistry = portalRegistry
 }
 
+// SetSlasher sets the slasher used to punish double signing validators.
+func (k *Keeper) SetSlasher(slasher types.Slasher) {
+	k.slasher = slasher
+}
+
+// SetUpgradeKeeper sets the upgrade keeper used to gate logic behind network upgrades.
+func (k *Keeper) SetUpgradeKeeper(upgrades types.UpgradeKeeper) {
+	k.upgrades = upgrades
+}
+
+// isMagellan returns true if the 2_magellan network upgrade was executed.
+// It gates attestation double sign slashing and liveness tracking, including their store state.
+func (k *Keeper) isMagellan(ctx context.Context) (bool, error) {
+	height, err := k.upgrades.GetDoneHeight(ctx, magellan.UpgradeName)
+	if err != nil {
+		return false, errors.Wrap(err, "get upgrade done height")
+	}
+
+	return height > 0, nil
+}
+
 // RegisterProposalService registers the proposal service on the provided router.
 // This implements abci.ProcessProposal verification of new proposals.
 func (k *Keeper) RegisterProposalService(server grpc1.Server) {
@@ -245,7 +292,11 @@ func (k *Keeper) addOne(ctx context.Context, agg *types.AggVote, valSetID uint64
 				return err
 			} else if ok {
 				doubleSignCounter.WithLabelValues(sigTup.ValidatorAddress.Hex()).Inc()
-				log.Warn(ctx, "🚨 Ignoring duplicate slashable vote", nil, attrs...)
+				log.Warn(ctx, "🚨 Ignoring and punishing duplicate slashable vote", nil, attrs...)
+
+				if err := k.punishDoubleSign(ctx, agg, sig); err != nil {
+					return errors.Wrap(err, "punish double sign")
+				}
 			} else {
 				// Ignore identical duplicate. See https://github.com/omni-network/omni/issues/2286.
 				log.Debug(ctx, "Ignoring duplicate vote", attrs...)
@@ -354,6 +405,10 @@ func (k *Keeper) Approve(ctx context.Context, valset ValSet) error {
 			return errors.Wrap(err, "save")
 		}
 
+		if err := k.trackApprovedLiveness(ctx, valset, sigs); err != nil {
+			return errors.Wrap(err, "track liveness")
+		}
+
 		setMetrics(att)
 		approvedByChain[chainVer] = att.GetAttestOffset()
 
@@ -1225,3 +1280,42 @@ type stubPortalRegistry struct{}
 func (stubPortalRegistry) ConfLevels(context.Context) (map[uint64][]xchain.ConfLevel, error) {
 	return map[uint64][]xchain.ConfLevel{}, nil
 }
+
+// stubSlasher is a stub implementation of the slasher.
+type stubSlasher struct{}
+
+func (stubSlasher) SlashFractionDoubleSign(context.Context) (math.LegacyDec, error) {
+	return math.LegacyZeroDec(), nil
+}
+
+func (stubSlasher) SlashWithInfractionReason(context.Context, sdk.ConsAddress, math.LegacyDec, int64, int64, stypes.Infraction) error {
+	return nil
+}
+
+func (stubSlasher) Jail(context.Context, sdk.ConsAddress) error {
+	return nil
+}
+
+func (stubSlasher) JailUntil(context.Context, sdk.ConsAddress, time.Time) error {
+	return nil
+}
+
+func (stubSlasher) DowntimeJailDuration(context.Context) (time.Duration, error) {
+	return 0, nil
+}
+
+func (stubSlasher) Tombstone(context.Context, sdk.ConsAddress) error {
+	return nil
+}
+
+func (stubSlasher) IsTombstoned(context.Context, sdk.ConsAddress) bool {
+	return false
+}
+
+// stubUpgradeKeeper is a stub implementation of the upgrade keeper.
+// It reports all upgrades as not executed, so gated logic is disabled.
+type stubUpgradeKeeper struct{}
+
+func (stubUpgradeKeeper) GetDoneHeight(context.Context, string) (int64, error) {
+	return 0, nil
+}
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/omni-network/omni/halo/attest/types"
+	vtypes "github.com/omni-network/omni/halo/valsync/types"
 	"github.com/omni-network/omni/lib/k1util"
 	"github.com/omni-network/omni/lib/netconf"
 	"github.com/omni-network/omni/lib/tutil"
@@ -34,6 +35,17 @@ func (k *Keeper) SetVoteWindowDownForT(voteWindowDown uint64) {
 	k.voteWindowDown = voteWindowDown
 }
 
+// SetLivenessParamsForT sets the liveness window and minimum voted params for testing purposes.
+func (k *Keeper) SetLivenessParamsForT(window uint64, minVoted uint64) {
+	k.livenessWindow = window
+	k.livenessMinVoted = minVoted
+}
+
+// TrackLivenessForT tracks liveness of the validators for testing purposes.
+func (k *Keeper) TrackLivenessForT(ctx context.Context, vals []vtypes.Validator, included map[common.Address]bool) error {
+	return k.trackLiveness(ctx, vals, included)
+}
+
 func TestWindowCompose(t *testing.T) {
 	t.Parallel()
 	const windowUp = 64
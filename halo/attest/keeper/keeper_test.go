@@ -36,6 +36,51 @@ func TestKeeper_Add(t *testing.T) {
 		sigs []*keeper.Signature
 	}
 
+	// doubleSignMsg returns the default agg vote with a different att root but identical block, signed by same vals (double sign).
+	doubleSignMsg := func() *types.MsgAddVotes {
+		return defaultMsg().
+			WithVotes(
+				defaultAggVote().
+					WithMsgRoot(common.BytesToHash([]byte("different root"))).
+					Vote(),
+			).Msg()
+	}
+
+	// addMsgs returns a prerequisite that adds the messages.
+	addMsgs := func(msgs ...*types.MsgAddVotes) prerequisite {
+		return func(t *testing.T, k *keeper.Keeper, ctx sdk.Context) {
+			t.Helper()
+			for _, msg := range msgs {
+				require.NoError(t, k.Add(ctx, msg))
+			}
+		}
+	}
+
+	// doubleSignWant is the expected state after double signing the default agg vote;
+	// only the default agg vote and its signatures are added.
+	doubleSignWant := want{
+		atts: []*keeper.Attestation{
+			expectPendingAtt(1, defaultOffset, 1),
+		},
+		sigs: []*keeper.Signature{
+			expectValSig(1, 1, val1, defaultOffset),
+			expectValSig(2, 1, val2, defaultOffset),
+		},
+	}
+
+	// doubleSignEvidence returns a postrequisite that checks the number of persisted double sign votes of val 1.
+	doubleSignEvidence := func(n int) postrequisite {
+		return func(t *testing.T, k *keeper.Keeper, ctx sdk.Context) {
+			t.Helper()
+			addr, err := val1.EthereumAddress()
+			require.NoError(t, err)
+
+			votes, err := k.DoubleSignVotes(ctx, addr)
+			require.NoError(t, err)
+			require.Len(t, votes, n)
+		}
+	}
+
 	tests := []struct {
 		name           string
 		expectations   []expectation   // These functions set expectations in the various mocked dependencies.
@@ -174,7 +219,8 @@ func TestKeeper_Add(t *testing.T) {
 			},
 		},
 		{
-			name: "skip_mismatching_att_root_same_block_and_vals",
+			name:         "skip_mismatching_att_root_same_block_and_vals",
+			expectations: []expectation{mockDefaultExpectations, magellanDone(true), doubleSignSlashed(false, val1, val2)},
 			args: args{
 				msg: defaultMsg().
 					WithVotes(
@@ -204,6 +250,54 @@ func TestKeeper_Add(t *testing.T) {
 					// Update agg vote's signatures are not added, since they are double signs
 				},
 			},
+			postrequisites: []postrequisite{func(t *testing.T, k *keeper.Keeper, ctx sdk.Context) {
+				t.Helper()
+				addr, err := val1.EthereumAddress()
+				require.NoError(t, err)
+
+				// Both conflicting votes are persisted as evidence
+				votes, err := k.DoubleSignVotes(ctx, addr)
+				require.NoError(t, err)
+				require.Len(t, votes, 2)
+				require.Equal(t, votes[0].AttestHeader.AttestOffset, votes[1].AttestHeader.AttestOffset)
+				require.NotEqual(t, votes[0].MsgRoot, votes[1].MsgRoot)
+				for _, vote := range votes {
+					require.Len(t, vote.Signatures, 1)
+					require.Equal(t, addr.Bytes(), vote.Signatures[0].ValidatorAddress)
+				}
+			}},
+		},
+		{
+			name:           "double_sign_included_twice_slashed_once",
+			expectations:   []expectation{mockDefaultExpectations, magellanDone(true), doubleSignSlashed(false, val1, val2)},
+			args:           args{msg: doubleSignMsg()},
+			prerequisites:  []prerequisite{addMsgs(defaultMsg().Msg(), doubleSignMsg())},
+			want:           doubleSignWant,
+			postrequisites: []postrequisite{doubleSignEvidence(2)},
+		},
+		{
+			name:           "double_sign_already_jailed",
+			expectations:   []expectation{mockDefaultExpectations, magellanDone(true), doubleSignSlashed(true, val1, val2)},
+			args:           args{msg: doubleSignMsg()},
+			prerequisites:  []prerequisite{addMsgs(defaultMsg().Msg())},
+			want:           doubleSignWant,
+			postrequisites: []postrequisite{doubleSignEvidence(2)},
+		},
+		{
+			name:           "double_sign_already_tombstoned",
+			expectations:   []expectation{mockDefaultExpectations, magellanDone(true), doubleSignTombstoned(val1, val2)},
+			args:           args{msg: doubleSignMsg()},
+			prerequisites:  []prerequisite{addMsgs(defaultMsg().Msg())},
+			want:           doubleSignWant,
+			postrequisites: []postrequisite{doubleSignEvidence(2)},
+		},
+		{
+			name:           "double_sign_before_magellan",
+			expectations:   []expectation{mockDefaultExpectations, magellanDone(false)},
+			args:           args{msg: doubleSignMsg()},
+			prerequisites:  []prerequisite{addMsgs(defaultMsg().Msg())},
+			want:           doubleSignWant,
+			postrequisites: []postrequisite{doubleSignEvidence(0)},
 		},
 		{
 			name: "mismatching_att_root_same_block_diff_vals",
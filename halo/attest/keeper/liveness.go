@@ -0,0 +1,235 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/omni-network/omni/halo/attest/types"
+	vtypes "github.com/omni-network/omni/halo/valsync/types"
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/log"
+	"github.com/omni-network/omni/lib/umath"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"cosmossdk.io/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Attestation liveness tracks missed votes per validator over a sliding window of expected votes, similar to
+// x/slashing block liveness. Validators missing too many votes in the window are jailed
+// for the x/slashing DowntimeJailDuration. The window and minimum votes are attest module app config,
+// since the window counts expected attestation votes, not blocks.
+//
+// Votes are tracked when attestations are approved, so late votes (included after approval) count as missed.
+
+// trackApprovedLiveness tracks the liveness of the approving validator set given the signatures of a newly approved attestation.
+func (k *Keeper) trackApprovedLiveness(ctx context.Context, valset ValSet, sigs []*Signature) error {
+	if ok, err := k.livenessEnabled(ctx); err != nil {
+		return err
+	} else if !ok {
+		return nil
+	}
+
+	included := make(map[common.Address]bool)
+	for _, sig := range sigs {
+		addr, err := sig.ValidatorEthAddress()
+		if err != nil {
+			return err
+		}
+		included[addr] = true
+	}
+
+	resp, err := k.valProvider.ValidatorSet(ctx, &vtypes.ValidatorSetRequest{Id: valset.ID})
+	if err != nil {
+		return errors.Wrap(err, "validator set", "id", valset.ID)
+	}
+
+	return k.trackLiveness(ctx, resp.Validators, included)
+}
+
+// livenessEnabled returns true if liveness tracking is enabled, i.e., if the window is non-zero and
+// the 2_magellan network upgrade was executed.
+func (k *Keeper) livenessEnabled(ctx context.Context) (bool, error) {
+	if k.livenessWindow == 0 {
+		return false, nil
+	}
+
+	return k.isMagellan(ctx)
+}
+
+// trackLiveness updates the liveness of the validators expected to vote for an approved attestation
+// and jails validators that exceeded the maximum missed votes in the window.
+func (k *Keeper) trackLiveness(ctx context.Context, vals []vtypes.Validator, included map[common.Address]bool) error {
+	if len(vals) == 0 {
+		return nil
+	}
+
+	if ok, err := k.livenessEnabled(ctx); err != nil {
+		return err
+	} else if !ok {
+		return nil // Liveness tracking (and its store state) only enabled after 2_magellan network upgrade.
+	}
+
+	window := k.livenessWindow
+	maxMissed := umath.SubtractOrZero(window, k.livenessMinVoted)
+
+	var active ValSet // Lazy loaded, only required when jailing.
+	for _, val := range vals {
+		addr, err := val.EthereumAddress()
+		if err != nil {
+			return err
+		}
+
+		liveness, err := k.updateLiveness(ctx, addr, window, !included[addr])
+		if err != nil {
+			return err
+		} else if liveness.GetVoteIndex() < liveness.GetWindow() || liveness.GetMissedVotes() <= maxMissed {
+			continue
+		}
+
+		if active.Vals == nil {
+			if active, err = k.prevBlockValSet(ctx); err != nil {
+				return errors.Wrap(err, "active set")
+			}
+		}
+		if !active.Contains(addr) {
+			continue // Only active validators can be jailed.
+		}
+
+		cmtAddr, err := val.CometAddress()
+		if err != nil {
+			return err
+		}
+
+		attrs := []any{"validator", addr, "missed", liveness.GetMissedVotes(), "window", liveness.GetWindow()}
+
+		sdkCtx := sdk.UnwrapSDKContext(ctx)
+		cacheCtx, write := sdkCtx.CacheContext()
+		if jailed, err := k.jailDowntime(cacheCtx, sdk.ConsAddress(cmtAddr)); err != nil {
+			log.Error(ctx, "Failed jailing attestation downtime validator", err, attrs...)
+			continue
+		} else if jailed {
+			write()
+			livenessJailCounter.WithLabelValues(addr.Hex()).Inc()
+			log.Warn(ctx, "⛓️ Jailed validator for missing attestation votes", nil, attrs...)
+		}
+
+		// Reset the window, so validators are not jailed again immediately after unjailing.
+		if err := k.resetLiveness(ctx, addr); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// jailDowntime jails the validator for the downtime jail duration.
+// It returns false if the validator is already jailed.
+func (k *Keeper) jailDowntime(ctx sdk.Context, consAddr sdk.ConsAddress) (bool, error) {
+	val, err := k.skeeper.ValidatorByConsAddr(ctx, consAddr)
+	if err != nil {
+		return false, errors.Wrap(err, "validator by cons addr")
+	} else if val.IsJailed() {
+		return false, nil
+	}
+
+	duration, err := k.slasher.DowntimeJailDuration(ctx)
+	if err != nil {
+		return false, errors.Wrap(err, "downtime jail duration")
+	}
+
+	if err := k.slasher.Jail(ctx, consAddr); err != nil {
+		return false, errors.Wrap(err, "jail")
+	}
+
+	if err := k.slasher.JailUntil(ctx, consAddr, ctx.BlockTime().Add(duration)); err != nil {
+		return false, errors.Wrap(err, "jail until")
+	}
+
+	return true, nil
+}
+
+// updateLiveness records the next expected vote of the validator in the sliding window and returns the updated liveness.
+func (k *Keeper) updateLiveness(ctx context.Context, addr common.Address, window uint64, missed bool) (*ValidatorLiveness, error) {
+	liveness, err := k.livenessTable.Get(ctx, addr.Bytes())
+	if errors.Is(err, ormerrors.NotFound) {
+		liveness = &ValidatorLiveness{ValidatorAddress: addr.Bytes(), Window: window}
+	} else if err != nil {
+		return nil, errors.Wrap(err, "get liveness")
+	} else if liveness.GetWindow() != window {
+		if err := k.resetLiveness(ctx, addr); err != nil {
+			return nil, err
+		}
+		liveness = &ValidatorLiveness{ValidatorAddress: addr.Bytes(), Window: window}
+	}
+
+	missedVote := &MissedVote{ValidatorAddress: addr.Bytes(), WindowIndex: liveness.GetVoteIndex() % window}
+	prevMissed, err := k.missedTable.Has(ctx, missedVote.GetValidatorAddress(), missedVote.GetWindowIndex())
+	if err != nil {
+		return nil, errors.Wrap(err, "has missed vote")
+	}
+
+	if missed && !prevMissed {
+		if err := k.missedTable.Insert(ctx, missedVote); err != nil {
+			return nil, errors.Wrap(err, "insert missed vote")
+		}
+		liveness.MissedVotes++
+	} else if !missed && prevMissed {
+		if err := k.missedTable.Delete(ctx, missedVote); err != nil {
+			return nil, errors.Wrap(err, "delete missed vote")
+		}
+		liveness.MissedVotes--
+	}
+	liveness.VoteIndex++
+
+	if err := k.livenessTable.Save(ctx, liveness); err != nil {
+		return nil, errors.Wrap(err, "save liveness")
+	}
+
+	return liveness, nil
+}
+
+// resetLiveness deletes the liveness state of the validator.
+func (k *Keeper) resetLiveness(ctx context.Context, addr common.Address) error {
+	if err := k.missedTable.DeleteBy(ctx, MissedVotePrimaryKey{}.WithValidatorAddress(addr.Bytes())); err != nil {
+		return errors.Wrap(err, "delete missed votes")
+	}
+
+	if err := k.livenessTable.DeleteBy(ctx, ValidatorLivenessPrimaryKey{}.WithValidatorAddress(addr.Bytes())); err != nil {
+		return errors.Wrap(err, "delete liveness")
+	}
+
+	return nil
+}
+
+// attestationUptimes returns the attestation uptime of all validators with liveness state.
+func (k *Keeper) attestationUptimes(ctx context.Context) ([]*types.ValidatorUptime, error) {
+	iter, err := k.livenessTable.List(ctx, ValidatorLivenessPrimaryKey{})
+	if err != nil {
+		return nil, errors.Wrap(err, "list liveness")
+	}
+	defer iter.Close()
+
+	var resp []*types.ValidatorUptime
+	for iter.Next() {
+		liveness, err := iter.Value()
+		if err != nil {
+			return nil, errors.Wrap(err, "value liveness")
+		}
+
+		expected := min(liveness.GetVoteIndex(), liveness.GetWindow())
+		uptime := 1.0
+		if expected > 0 {
+			uptime = float64(expected-liveness.GetMissedVotes()) / float64(expected)
+		}
+
+		resp = append(resp, &types.ValidatorUptime{
+			ValidatorAddress: liveness.GetValidatorAddress(),
+			Expected:         expected,
+			Missed:           liveness.GetMissedVotes(),
+			Uptime:           uptime,
+		})
+	}
+
+	return resp, nil
+}
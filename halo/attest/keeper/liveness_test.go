@@ -0,0 +1,145 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/omni-network/omni/halo/attest/keeper"
+	"github.com/omni-network/omni/halo/attest/types"
+	vtypes "github.com/omni-network/omni/halo/valsync/types"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestLiveness(t *testing.T) {
+	t.Parallel()
+
+	const window = 4
+	const minVoted = 2
+
+	addr1, err := val1.EthereumAddress()
+	require.NoError(t, err)
+	addr2, err := val2.EthereumAddress()
+	require.NoError(t, err)
+	cmtAddr1, err := val1.CometAddress()
+	require.NoError(t, err)
+
+	k, ctx := setupKeeper(t, mockDefaultExpectations, magellanDone(true), func(_ sdk.Context, m mocks) {
+		m.skeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.ConsAddress(cmtAddr1)).Return(stypes.Validator{}, nil).Times(1)
+		m.slasher.EXPECT().DowntimeJailDuration(gomock.Any()).Return(time.Hour, nil).Times(1)
+		m.slasher.EXPECT().Jail(gomock.Any(), sdk.ConsAddress(cmtAddr1)).Return(nil).Times(1)
+		m.slasher.EXPECT().JailUntil(gomock.Any(), sdk.ConsAddress(cmtAddr1), gomock.Any()).Return(nil).Times(1)
+	})
+	k.SetLivenessParamsForT(window, minVoted)
+
+	// Val1 misses all votes, val2 includes all votes.
+	vals := []vtypes.Validator{val1, val2}
+	included := map[common.Address]bool{addr2: true}
+
+	for range window - 1 {
+		require.NoError(t, k.TrackLivenessForT(ctx, vals, included))
+	}
+
+	requireUptimes(t, k, ctx,
+		&types.ValidatorUptime{ValidatorAddress: addr1.Bytes(), Expected: window - 1, Missed: window - 1, Uptime: 0},
+		&types.ValidatorUptime{ValidatorAddress: addr2.Bytes(), Expected: window - 1, Missed: 0, Uptime: 1},
+	)
+
+	// Full window of misses jails val1 and resets its window
+	require.NoError(t, k.TrackLivenessForT(ctx, vals, included))
+
+	requireUptimes(t, k, ctx,
+		&types.ValidatorUptime{ValidatorAddress: addr2.Bytes(), Expected: window, Missed: 0, Uptime: 1},
+	)
+
+	// Liveness tracking restarts after jailing
+	include1 := map[common.Address]bool{addr1: true, addr2: true}
+	require.NoError(t, k.TrackLivenessForT(ctx, vals, included))
+	require.NoError(t, k.TrackLivenessForT(ctx, vals, include1))
+
+	requireUptimes(t, k, ctx,
+		&types.ValidatorUptime{ValidatorAddress: addr1.Bytes(), Expected: 2, Missed: 1, Uptime: 0.5},
+		&types.ValidatorUptime{ValidatorAddress: addr2.Bytes(), Expected: window, Missed: 0, Uptime: 1},
+	)
+}
+
+func TestLivenessJailed(t *testing.T) {
+	t.Parallel()
+
+	addr2, err := val2.EthereumAddress()
+	require.NoError(t, err)
+	cmtAddr1, err := val1.CometAddress()
+	require.NoError(t, err)
+
+	// Already jailed validators are not jailed again.
+	k, ctx := setupKeeper(t, mockDefaultExpectations, magellanDone(true), func(_ sdk.Context, m mocks) {
+		m.skeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.ConsAddress(cmtAddr1)).Return(stypes.Validator{Jailed: true}, nil).Times(1)
+	})
+	k.SetLivenessParamsForT(1, 1)
+
+	vals := []vtypes.Validator{val1, val2}
+	require.NoError(t, k.TrackLivenessForT(ctx, vals, map[common.Address]bool{addr2: true}))
+
+	requireUptimes(t, k, ctx,
+		&types.ValidatorUptime{ValidatorAddress: addr2.Bytes(), Expected: 1, Missed: 0, Uptime: 1},
+	)
+}
+
+func TestLivenessBeforeMagellan(t *testing.T) {
+	t.Parallel()
+
+	k, ctx := setupKeeper(t, mockDefaultExpectations, magellanDone(false))
+	k.SetLivenessParamsForT(1, 1)
+
+	// Liveness isn't tracked before the network upgrade.
+	vals := []vtypes.Validator{val1, val2}
+	require.NoError(t, k.TrackLivenessForT(ctx, vals, nil))
+
+	requireUptimes(t, k, ctx)
+}
+
+func TestLivenessApproval(t *testing.T) {
+	t.Parallel()
+
+	valset := newValSet(7, val1, val2, val3)
+	addr1, err := val1.EthereumAddress()
+	require.NoError(t, err)
+	addr2, err := val2.EthereumAddress()
+	require.NoError(t, err)
+	addr3, err := val3.EthereumAddress()
+	require.NoError(t, err)
+
+	k, ctx := setupKeeper(t, mockDefaultExpectations, magellanDone(true), trimBehindCalled(), func(_ sdk.Context, m mocks) {
+		m.valProvider.EXPECT().ValidatorSet(gomock.Any(), &vtypes.ValidatorSetRequest{Id: valset.Id}).Return(valset, nil).Times(1)
+	})
+	k.SetLivenessParamsForT(10, 1)
+
+	// Val1 doesn't vote for the attestation approved by val2 and val3.
+	vote := defaultAggVote().WithSignatures(sigsTuples(val2, val3)...).Vote()
+	require.NoError(t, k.Add(ctx, defaultMsg().Default().WithVotes(vote).Msg()))
+
+	// Pending attestations are not tracked.
+	requireUptimes(t, k, ctx)
+
+	// Liveness is tracked on approval.
+	require.NoError(t, k.Approve(ctx, toValSet(valset)))
+
+	requireUptimes(t, k, ctx,
+		&types.ValidatorUptime{ValidatorAddress: addr1.Bytes(), Expected: 1, Missed: 1, Uptime: 0},
+		&types.ValidatorUptime{ValidatorAddress: addr2.Bytes(), Expected: 1, Missed: 0, Uptime: 1},
+		&types.ValidatorUptime{ValidatorAddress: addr3.Bytes(), Expected: 1, Missed: 0, Uptime: 1},
+	)
+}
+
+func requireUptimes(t *testing.T, k *keeper.Keeper, ctx sdk.Context, expected ...*types.ValidatorUptime) {
+	t.Helper()
+
+	resp, err := k.AttestationUptimes(ctx, &types.AttestationUptimesRequest{})
+	require.NoError(t, err)
+	require.ElementsMatch(t, expected, resp.Uptimes)
+}
@@ -53,6 +53,20 @@ var (
 		Help:      "Total number of double sign votes detected per validator",
 	}, []string{"validator"})
 
+	doubleSignSlashCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "halo",
+		Subsystem: "attest",
+		Name:      "double_sign_slashed_total",
+		Help:      "Total number of times a validator was slashed and jailed for double signing attestations",
+	}, []string{"validator"})
+
+	livenessJailCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "halo",
+		Subsystem: "attest",
+		Name:      "liveness_jailed_total",
+		Help:      "Total number of times a validator was jailed for missing too many attestation votes",
+	}, []string{"validator"})
+
 	approvedVotesCounter = promauto.NewCounterVec(prometheus.CounterOpts{
 		Namespace: "halo",
 		Subsystem: "attest",
@@ -127,6 +127,19 @@ func (k *Keeper) WindowCompare(ctx context.Context, req *types.WindowCompareRequ
 	return &types.WindowCompareResponse{Cmp: cmpInt32}, nil
 }
 
+func (k *Keeper) AttestationUptimes(ctx context.Context, req *types.AttestationUptimesRequest) (*types.AttestationUptimesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	uptimes, err := k.attestationUptimes(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.AttestationUptimesResponse{Uptimes: uptimes}, nil
+}
+
 func getConsensusChainID(ctx context.Context) (uint64, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	return netconf.ConsensusChainIDStr2Uint64(sdkCtx.ChainID())
@@ -21,6 +21,12 @@ func registerMigrations(cfg module.Configurator) {
 			FromVersion: 1,
 			Handler:     noopMigration,
 		},
+		{
+			// 2_magellan doesn't include any store migrations.
+			// It only adds new liveness and double sign evidence tables.
+			FromVersion: 2,
+			Handler:     noopMigration,
+		},
 	}
 
 	for _, m := range migrations {
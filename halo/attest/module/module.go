@@ -2,9 +2,12 @@ package module
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/omni-network/omni/halo/attest/keeper"
 	"github.com/omni-network/omni/halo/attest/types"
+	"github.com/omni-network/omni/halo/genutil/ormgenesis"
+	"github.com/omni-network/omni/lib/errors"
 
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/store"
@@ -13,15 +16,17 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	skeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 )
 
-const ConsensusVersion = 2
+const ConsensusVersion = 3
 
 var (
 	_ module.AppModuleBasic     = (*AppModule)(nil)
+	_ module.HasGenesis         = (*AppModule)(nil)
 	_ appmodule.AppModule       = (*AppModule)(nil)
 	_ appmodule.HasBeginBlocker = (*AppModule)(nil)
 	_ appmodule.HasEndBlocker   = (*AppModule)(nil)
@@ -98,6 +103,31 @@ func (m AppModule) RegisterServices(cfg module.Configurator) {
 	registerMigrations(cfg)
 }
 
+func (m AppModule) InitGenesis(ctx sdk.Context, _ codec.JSONCodec, raw json.RawMessage) {
+	if err := m.keeper.InitGenesis(ctx, raw); err != nil {
+		panic(errors.Wrap(err, "init attest genesis"))
+	}
+}
+
+func (m AppModule) ExportGenesis(ctx sdk.Context, _ codec.JSONCodec) json.RawMessage {
+	resp, err := m.keeper.ExportGenesis(ctx)
+	if err != nil {
+		panic(errors.Wrap(err, "export attest genesis"))
+	}
+
+	return resp
+}
+
+// DefaultGenesis returns the default (empty) genesis state of the module.
+func (AppModuleBasic) DefaultGenesis(codec.JSONCodec) json.RawMessage {
+	return json.RawMessage("{}")
+}
+
+// ValidateGenesis performs genesis state validation for the module.
+func (AppModuleBasic) ValidateGenesis(_ codec.JSONCodec, _ client.TxEncodingConfig, bz json.RawMessage) error {
+	return ormgenesis.Validate(bz)
+}
+
 // IsOnePerModuleType implements the depinject.OnePerModuleType interface.
 func (AppModule) IsOnePerModuleType() {}
 
@@ -150,6 +180,8 @@ func ProvideModule(in ModuleInputs) (ModuleOutputs, error) {
 		in.Config.GetVoteExtensionLimit(),
 		in.Config.GetTrimLag(),
 		in.Config.GetConsensusTrimLag(),
+		in.Config.GetLivenessWindow(),
+		in.Config.GetLivenessMinVoted(),
 	)
 	if err != nil {
 		return ModuleOutputs{}, err
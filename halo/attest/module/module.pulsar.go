@@ -21,6 +21,8 @@ var (
 	fd_Module_vote_extension_limit protoreflect.FieldDescriptor
 	fd_Module_trim_lag             protoreflect.FieldDescriptor
 	fd_Module_consensus_trim_lag   protoreflect.FieldDescriptor
+	fd_Module_liveness_window      protoreflect.FieldDescriptor
+	fd_Module_liveness_min_voted   protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -32,6 +34,8 @@ func init() {
 	fd_Module_vote_extension_limit = md_Module.Fields().ByName("vote_extension_limit")
 	fd_Module_trim_lag = md_Module.Fields().ByName("trim_lag")
 	fd_Module_consensus_trim_lag = md_Module.Fields().ByName("consensus_trim_lag")
+	fd_Module_liveness_window = md_Module.Fields().ByName("liveness_window")
+	fd_Module_liveness_min_voted = md_Module.Fields().ByName("liveness_min_voted")
 }
 
 var _ protoreflect.Message = (*fastReflection_Module)(nil)
@@ -135,6 +139,18 @@ func (x *fastReflection_Module) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.LivenessWindow != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LivenessWindow)
+		if !f(fd_Module_liveness_window, value) {
+			return
+		}
+	}
+	if x.LivenessMinVoted != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LivenessMinVoted)
+		if !f(fd_Module_liveness_min_voted, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -162,6 +178,10 @@ func (x *fastReflection_Module) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.TrimLag != uint64(0)
 	case "halo.attest.module.Module.consensus_trim_lag":
 		return x.ConsensusTrimLag != uint64(0)
+	case "halo.attest.module.Module.liveness_window":
+		return x.LivenessWindow != uint64(0)
+	case "halo.attest.module.Module.liveness_min_voted":
+		return x.LivenessMinVoted != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: halo.attest.module.Module"))
@@ -190,6 +210,10 @@ func (x *fastReflection_Module) Clear(fd protoreflect.FieldDescriptor) {
 		x.TrimLag = uint64(0)
 	case "halo.attest.module.Module.consensus_trim_lag":
 		x.ConsensusTrimLag = uint64(0)
+	case "halo.attest.module.Module.liveness_window":
+		x.LivenessWindow = uint64(0)
+	case "halo.attest.module.Module.liveness_min_voted":
+		x.LivenessMinVoted = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: halo.attest.module.Module"))
@@ -224,6 +248,12 @@ func (x *fastReflection_Module) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "halo.attest.module.Module.consensus_trim_lag":
 		value := x.ConsensusTrimLag
 		return protoreflect.ValueOfUint64(value)
+	case "halo.attest.module.Module.liveness_window":
+		value := x.LivenessWindow
+		return protoreflect.ValueOfUint64(value)
+	case "halo.attest.module.Module.liveness_min_voted":
+		value := x.LivenessMinVoted
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: halo.attest.module.Module"))
@@ -256,6 +286,10 @@ func (x *fastReflection_Module) Set(fd protoreflect.FieldDescriptor, value proto
 		x.TrimLag = value.Uint()
 	case "halo.attest.module.Module.consensus_trim_lag":
 		x.ConsensusTrimLag = value.Uint()
+	case "halo.attest.module.Module.liveness_window":
+		x.LivenessWindow = value.Uint()
+	case "halo.attest.module.Module.liveness_min_voted":
+		x.LivenessMinVoted = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: halo.attest.module.Module"))
@@ -288,6 +322,10 @@ func (x *fastReflection_Module) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field trim_lag of message halo.attest.module.Module is not mutable"))
 	case "halo.attest.module.Module.consensus_trim_lag":
 		panic(fmt.Errorf("field consensus_trim_lag of message halo.attest.module.Module is not mutable"))
+	case "halo.attest.module.Module.liveness_window":
+		panic(fmt.Errorf("field liveness_window of message halo.attest.module.Module is not mutable"))
+	case "halo.attest.module.Module.liveness_min_voted":
+		panic(fmt.Errorf("field liveness_min_voted of message halo.attest.module.Module is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: halo.attest.module.Module"))
@@ -313,6 +351,10 @@ func (x *fastReflection_Module) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfUint64(uint64(0))
 	case "halo.attest.module.Module.consensus_trim_lag":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "halo.attest.module.Module.liveness_window":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "halo.attest.module.Module.liveness_min_voted":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: halo.attest.module.Module"))
@@ -401,6 +443,12 @@ func (x *fastReflection_Module) ProtoMethods() *protoiface.Methods {
 		if x.ConsensusTrimLag != 0 {
 			n += 1 + runtime.Sov(uint64(x.ConsensusTrimLag))
 		}
+		if x.LivenessWindow != 0 {
+			n += 1 + runtime.Sov(uint64(x.LivenessWindow))
+		}
+		if x.LivenessMinVoted != 0 {
+			n += 1 + runtime.Sov(uint64(x.LivenessMinVoted))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -430,6 +478,16 @@ func (x *fastReflection_Module) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.LivenessMinVoted != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LivenessMinVoted))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.LivenessWindow != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LivenessWindow))
+			i--
+			dAtA[i] = 0x38
+		}
 		if x.ConsensusTrimLag != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.ConsensusTrimLag))
 			i--
@@ -638,6 +696,44 @@ func (x *fastReflection_Module) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LivenessWindow", wireType)
+				}
+				x.LivenessWindow = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LivenessWindow |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LivenessMinVoted", wireType)
+				}
+				x.LivenessMinVoted = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LivenessMinVoted |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -706,6 +802,11 @@ type Module struct {
 	TrimLag uint64 `protobuf:"varint,5,opt,name=trim_lag,json=trimLag,proto3" json:"trim_lag,omitempty"`
 	// consensus_trim_lag defines the number of blocks after which consensus-chain attestations are deleted from the module state.
 	ConsensusTrimLag uint64 `protobuf:"varint,6,opt,name=consensus_trim_lag,json=consensusTrimLag,proto3" json:"consensus_trim_lag,omitempty"`
+	// liveness_window defines the number of expected votes of approved attestations over which validator liveness is tracked.
+	// Validators missing more than liveness_window - liveness_min_voted votes in the window are jailed. Zero disables liveness.
+	LivenessWindow uint64 `protobuf:"varint,7,opt,name=liveness_window,json=livenessWindow,proto3" json:"liveness_window,omitempty"`
+	// liveness_min_voted defines the minimum number of included votes in the liveness window.
+	LivenessMinVoted uint64 `protobuf:"varint,8,opt,name=liveness_min_voted,json=livenessMinVoted,proto3" json:"liveness_min_voted,omitempty"`
 }
 
 func (x *Module) Reset() {
@@ -770,6 +871,20 @@ func (x *Module) GetConsensusTrimLag() uint64 {
 	return 0
 }
 
+func (x *Module) GetLivenessWindow() uint64 {
+	if x != nil {
+		return x.LivenessWindow
+	}
+	return 0
+}
+
+func (x *Module) GetLivenessMinVoted() uint64 {
+	if x != nil {
+		return x.LivenessMinVoted
+	}
+	return 0
+}
+
 var File_halo_attest_module_module_proto protoreflect.FileDescriptor
 
 var file_halo_attest_module_module_proto_rawDesc = []byte{
@@ -778,7 +893,7 @@ var file_halo_attest_module_module_proto_rawDesc = []byte{
 	0x6f, 0x12, 0x12, 0x68, 0x61, 0x6c, 0x6f, 0x2e, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x6d,
 	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x1a, 0x20, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x61, 0x70,
 	0x70, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa3, 0x02, 0x0a, 0x06, 0x4d, 0x6f, 0x64, 0x75,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xfa, 0x02, 0x0a, 0x06, 0x4d, 0x6f, 0x64, 0x75,
 	0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79,
 	0x12, 0x24, 0x0a, 0x0e, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f,
@@ -793,22 +908,28 @@ var file_halo_attest_module_module_proto_rawDesc = []byte{
 	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x72, 0x69, 0x6d, 0x4c, 0x61, 0x67, 0x12, 0x2c, 0x0a,
 	0x12, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x5f, 0x74, 0x72, 0x69, 0x6d, 0x5f,
 	0x6c, 0x61, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x73, 0x65,
-	0x6e, 0x73, 0x75, 0x73, 0x54, 0x72, 0x69, 0x6d, 0x4c, 0x61, 0x67, 0x3a, 0x30, 0xba, 0xc0, 0x96,
-	0xda, 0x01, 0x2a, 0x0a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x6f, 0x6d, 0x6e, 0x69, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x6f, 0x6d, 0x6e,
-	0x69, 0x2f, 0x68, 0x61, 0x6c, 0x6f, 0x2f, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x42, 0xb4, 0x01,
-	0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e, 0x68, 0x61, 0x6c, 0x6f, 0x2e, 0x61, 0x74, 0x74, 0x65, 0x73,
-	0x74, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x42, 0x0b, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x23, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
-	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x68, 0x61, 0x6c, 0x6f, 0x2f, 0x61,
-	0x74, 0x74, 0x65, 0x73, 0x74, 0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0xa2, 0x02, 0x03, 0x48,
-	0x41, 0x4d, 0xaa, 0x02, 0x12, 0x48, 0x61, 0x6c, 0x6f, 0x2e, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74,
-	0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0xca, 0x02, 0x12, 0x48, 0x61, 0x6c, 0x6f, 0x5c, 0x41,
-	0x74, 0x74, 0x65, 0x73, 0x74, 0x5c, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0xe2, 0x02, 0x1e, 0x48,
-	0x61, 0x6c, 0x6f, 0x5c, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x5c, 0x4d, 0x6f, 0x64, 0x75, 0x6c,
-	0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x14,
-	0x48, 0x61, 0x6c, 0x6f, 0x3a, 0x3a, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x3a, 0x3a, 0x4d, 0x6f,
-	0x64, 0x75, 0x6c, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6e, 0x73, 0x75, 0x73, 0x54, 0x72, 0x69, 0x6d, 0x4c, 0x61, 0x67, 0x12, 0x27, 0x0a, 0x0f, 0x6c,
+	0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x57, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x12, 0x2c, 0x0a, 0x12, 0x6c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73,
+	0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x10, 0x6c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x4d, 0x69, 0x6e, 0x56, 0x6f, 0x74,
+	0x65, 0x64, 0x3a, 0x30, 0xba, 0xc0, 0x96, 0xda, 0x01, 0x2a, 0x0a, 0x28, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x6d, 0x6e, 0x69, 0x2d, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x2f, 0x6f, 0x6d, 0x6e, 0x69, 0x2f, 0x68, 0x61, 0x6c, 0x6f, 0x2f, 0x61, 0x74,
+	0x74, 0x65, 0x73, 0x74, 0x42, 0xb4, 0x01, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e, 0x68, 0x61, 0x6c,
+	0x6f, 0x2e, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x42,
+	0x0b, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x23,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x68, 0x61, 0x6c, 0x6f, 0x2f, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2f, 0x6d, 0x6f, 0x64,
+	0x75, 0x6c, 0x65, 0xa2, 0x02, 0x03, 0x48, 0x41, 0x4d, 0xaa, 0x02, 0x12, 0x48, 0x61, 0x6c, 0x6f,
+	0x2e, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0xca, 0x02,
+	0x12, 0x48, 0x61, 0x6c, 0x6f, 0x5c, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x5c, 0x4d, 0x6f, 0x64,
+	0x75, 0x6c, 0x65, 0xe2, 0x02, 0x1e, 0x48, 0x61, 0x6c, 0x6f, 0x5c, 0x41, 0x74, 0x74, 0x65, 0x73,
+	0x74, 0x5c, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x14, 0x48, 0x61, 0x6c, 0x6f, 0x3a, 0x3a, 0x41, 0x74, 0x74,
+	0x65, 0x73, 0x74, 0x3a, 0x3a, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
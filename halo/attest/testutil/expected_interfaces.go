@@ -6,18 +6,20 @@ import (
 	rtypes "github.com/omni-network/omni/halo/registry/types"
 	vtypes "github.com/omni-network/omni/halo/valsync/types"
 	"github.com/omni-network/omni/lib/xchain"
-
-	"github.com/cosmos/cosmos-sdk/baseapp"
 )
 
 type StakingKeeper interface {
-	baseapp.ValidatorStore
+	types.StakingKeeper
 }
 
 type Voter interface {
 	types.Voter
 }
 
+type Slasher interface {
+	types.Slasher
+}
+
 type ValProvider interface {
 	vtypes.ValidatorProvider
 }
@@ -29,3 +31,7 @@ type ChainNamer interface {
 type Registry interface {
 	rtypes.PortalRegistry
 }
+
+type UpgradeKeeper interface {
+	types.UpgradeKeeper
+}
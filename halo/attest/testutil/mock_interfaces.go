@@ -12,9 +12,12 @@ package testutil
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
+	math "cosmossdk.io/math"
 	crypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 	types "github.com/cosmos/cosmos-sdk/types"
+	types2 "github.com/cosmos/cosmos-sdk/x/staking/types"
 	common "github.com/ethereum/go-ethereum/common"
 	types0 "github.com/omni-network/omni/halo/attest/types"
 	types1 "github.com/omni-network/omni/halo/valsync/types"
@@ -61,6 +64,21 @@ func (mr *MockStakingKeeperMockRecorder) GetPubKeyByConsAddr(arg0, arg1 any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPubKeyByConsAddr", reflect.TypeOf((*MockStakingKeeper)(nil).GetPubKeyByConsAddr), arg0, arg1)
 }
 
+// ValidatorByConsAddr mocks base method.
+func (m *MockStakingKeeper) ValidatorByConsAddr(ctx context.Context, consAddr types.ConsAddress) (types2.ValidatorI, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidatorByConsAddr", ctx, consAddr)
+	ret0, _ := ret[0].(types2.ValidatorI)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidatorByConsAddr indicates an expected call of ValidatorByConsAddr.
+func (mr *MockStakingKeeperMockRecorder) ValidatorByConsAddr(ctx, consAddr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatorByConsAddr", reflect.TypeOf((*MockStakingKeeper)(nil).ValidatorByConsAddr), ctx, consAddr)
+}
+
 // MockVoter is a mock of Voter interface.
 type MockVoter struct {
 	ctrl     *gomock.Controller
@@ -169,6 +187,130 @@ func (mr *MockVoterMockRecorder) UpdateValidatorSet(set any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateValidatorSet", reflect.TypeOf((*MockVoter)(nil).UpdateValidatorSet), set)
 }
 
+// MockSlasher is a mock of Slasher interface.
+type MockSlasher struct {
+	ctrl     *gomock.Controller
+	recorder *MockSlasherMockRecorder
+	isgomock struct{}
+}
+
+// MockSlasherMockRecorder is the mock recorder for MockSlasher.
+type MockSlasherMockRecorder struct {
+	mock *MockSlasher
+}
+
+// NewMockSlasher creates a new mock instance.
+func NewMockSlasher(ctrl *gomock.Controller) *MockSlasher {
+	mock := &MockSlasher{ctrl: ctrl}
+	mock.recorder = &MockSlasherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSlasher) EXPECT() *MockSlasherMockRecorder {
+	return m.recorder
+}
+
+// DowntimeJailDuration mocks base method.
+func (m *MockSlasher) DowntimeJailDuration(ctx context.Context) (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DowntimeJailDuration", ctx)
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DowntimeJailDuration indicates an expected call of DowntimeJailDuration.
+func (mr *MockSlasherMockRecorder) DowntimeJailDuration(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DowntimeJailDuration", reflect.TypeOf((*MockSlasher)(nil).DowntimeJailDuration), ctx)
+}
+
+// IsTombstoned mocks base method.
+func (m *MockSlasher) IsTombstoned(ctx context.Context, consAddr types.ConsAddress) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsTombstoned", ctx, consAddr)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsTombstoned indicates an expected call of IsTombstoned.
+func (mr *MockSlasherMockRecorder) IsTombstoned(ctx, consAddr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsTombstoned", reflect.TypeOf((*MockSlasher)(nil).IsTombstoned), ctx, consAddr)
+}
+
+// Jail mocks base method.
+func (m *MockSlasher) Jail(ctx context.Context, consAddr types.ConsAddress) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Jail", ctx, consAddr)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Jail indicates an expected call of Jail.
+func (mr *MockSlasherMockRecorder) Jail(ctx, consAddr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Jail", reflect.TypeOf((*MockSlasher)(nil).Jail), ctx, consAddr)
+}
+
+// JailUntil mocks base method.
+func (m *MockSlasher) JailUntil(ctx context.Context, consAddr types.ConsAddress, jailTime time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JailUntil", ctx, consAddr, jailTime)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// JailUntil indicates an expected call of JailUntil.
+func (mr *MockSlasherMockRecorder) JailUntil(ctx, consAddr, jailTime any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JailUntil", reflect.TypeOf((*MockSlasher)(nil).JailUntil), ctx, consAddr, jailTime)
+}
+
+// SlashFractionDoubleSign mocks base method.
+func (m *MockSlasher) SlashFractionDoubleSign(ctx context.Context) (math.LegacyDec, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SlashFractionDoubleSign", ctx)
+	ret0, _ := ret[0].(math.LegacyDec)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SlashFractionDoubleSign indicates an expected call of SlashFractionDoubleSign.
+func (mr *MockSlasherMockRecorder) SlashFractionDoubleSign(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SlashFractionDoubleSign", reflect.TypeOf((*MockSlasher)(nil).SlashFractionDoubleSign), ctx)
+}
+
+// SlashWithInfractionReason mocks base method.
+func (m *MockSlasher) SlashWithInfractionReason(ctx context.Context, consAddr types.ConsAddress, fraction math.LegacyDec, power, distributionHeight int64, infraction types2.Infraction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SlashWithInfractionReason", ctx, consAddr, fraction, power, distributionHeight, infraction)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SlashWithInfractionReason indicates an expected call of SlashWithInfractionReason.
+func (mr *MockSlasherMockRecorder) SlashWithInfractionReason(ctx, consAddr, fraction, power, distributionHeight, infraction any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SlashWithInfractionReason", reflect.TypeOf((*MockSlasher)(nil).SlashWithInfractionReason), ctx, consAddr, fraction, power, distributionHeight, infraction)
+}
+
+// Tombstone mocks base method.
+func (m *MockSlasher) Tombstone(ctx context.Context, consAddr types.ConsAddress) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Tombstone", ctx, consAddr)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Tombstone indicates an expected call of Tombstone.
+func (mr *MockSlasherMockRecorder) Tombstone(ctx, consAddr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Tombstone", reflect.TypeOf((*MockSlasher)(nil).Tombstone), ctx, consAddr)
+}
+
 // MockValProvider is a mock of ValProvider interface.
 type MockValProvider struct {
 	ctrl     *gomock.Controller
@@ -299,3 +441,42 @@ func (mr *MockRegistryMockRecorder) ConfLevels(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfLevels", reflect.TypeOf((*MockRegistry)(nil).ConfLevels), ctx)
 }
+
+// MockUpgradeKeeper is a mock of UpgradeKeeper interface.
+type MockUpgradeKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockUpgradeKeeperMockRecorder
+	isgomock struct{}
+}
+
+// MockUpgradeKeeperMockRecorder is the mock recorder for MockUpgradeKeeper.
+type MockUpgradeKeeperMockRecorder struct {
+	mock *MockUpgradeKeeper
+}
+
+// NewMockUpgradeKeeper creates a new mock instance.
+func NewMockUpgradeKeeper(ctrl *gomock.Controller) *MockUpgradeKeeper {
+	mock := &MockUpgradeKeeper{ctrl: ctrl}
+	mock.recorder = &MockUpgradeKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUpgradeKeeper) EXPECT() *MockUpgradeKeeperMockRecorder {
+	return m.recorder
+}
+
+// GetDoneHeight mocks base method.
+func (m *MockUpgradeKeeper) GetDoneHeight(ctx context.Context, name string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDoneHeight", ctx, name)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDoneHeight indicates an expected call of GetDoneHeight.
+func (mr *MockUpgradeKeeperMockRecorder) GetDoneHeight(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDoneHeight", reflect.TypeOf((*MockUpgradeKeeper)(nil).GetDoneHeight), ctx, name)
+}
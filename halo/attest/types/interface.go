@@ -2,11 +2,17 @@ package types
 
 import (
 	"context"
+	"time"
 
 	vtypes "github.com/omni-network/omni/halo/valsync/types"
 	"github.com/omni-network/omni/lib/xchain"
 
 	"github.com/ethereum/go-ethereum/common"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // Voter abstracts the validator duty of v∂oting for all
@@ -52,6 +58,46 @@ type VoterDeps interface {
 	LatestAttestation(ctx context.Context, chainVer xchain.ChainVersion) (xchain.Attestation, bool, error)
 }
 
+// Slasher abstracts the x/slashing keeper methods used to punish validators for attestation double signing
+// and attestation liveness (missed votes).
+type Slasher interface {
+	// SlashFractionDoubleSign returns the configured double sign slash fraction (x/slashing param).
+	SlashFractionDoubleSign(ctx context.Context) (math.LegacyDec, error)
+
+	// SlashWithInfractionReason slashes the validator's stake by the fraction.
+	SlashWithInfractionReason(ctx context.Context, consAddr sdk.ConsAddress, fraction math.LegacyDec, power, distributionHeight int64, infraction stypes.Infraction) error
+
+	// Jail jails the validator. Note it returns an error if already jailed,
+	// so check StakingKeeper ValidatorByConsAddr IsJailed first.
+	Jail(ctx context.Context, consAddr sdk.ConsAddress) error
+
+	// JailUntil sets the time until the jailed validator can unjail itself.
+	JailUntil(ctx context.Context, consAddr sdk.ConsAddress, jailTime time.Time) error
+
+	// DowntimeJailDuration returns the liveness jail duration (x/slashing param).
+	DowntimeJailDuration(ctx context.Context) (time.Duration, error)
+
+	// Tombstone tombstones the validator, so it can never unjail itself.
+	Tombstone(ctx context.Context, consAddr sdk.ConsAddress) error
+
+	// IsTombstoned returns true if the validator is tombstoned.
+	IsTombstoned(ctx context.Context, consAddr sdk.ConsAddress) bool
+}
+
+// StakingKeeper abstracts the x/staking keeper methods used by the attest keeper.
+type StakingKeeper interface {
+	baseapp.ValidatorStore
+
+	// ValidatorByConsAddr returns the validator by consensus address.
+	ValidatorByConsAddr(ctx context.Context, consAddr sdk.ConsAddress) (stypes.ValidatorI, error)
+}
+
+// UpgradeKeeper abstracts the x/upgrade keeper methods used to gate logic behind network upgrades.
+type UpgradeKeeper interface {
+	// GetDoneHeight returns the height at which the named upgrade was executed, or zero if not executed yet.
+	GetDoneHeight(ctx context.Context, name string) (int64, error)
+}
+
 // ChainVerNameFunc is a function that returns the name of a chain version.
 type ChainVerNameFunc func(xchain.ChainVersion) string
 
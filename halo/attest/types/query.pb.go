@@ -5,6 +5,7 @@ package types
 
 import (
 	context "context"
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -542,6 +543,154 @@ func (m *WindowCompareResponse) GetCmp() int32 {
 	return 0
 }
 
+type AttestationUptimesRequest struct {
+}
+
+func (m *AttestationUptimesRequest) Reset()         { *m = AttestationUptimesRequest{} }
+func (m *AttestationUptimesRequest) String() string { return proto.CompactTextString(m) }
+func (*AttestationUptimesRequest) ProtoMessage()    {}
+func (*AttestationUptimesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93d3f1745081aabb, []int{10}
+}
+func (m *AttestationUptimesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttestationUptimesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttestationUptimesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttestationUptimesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestationUptimesRequest.Merge(m, src)
+}
+func (m *AttestationUptimesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttestationUptimesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestationUptimesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttestationUptimesRequest proto.InternalMessageInfo
+
+type AttestationUptimesResponse struct {
+	Uptimes []*ValidatorUptime `protobuf:"bytes,1,rep,name=uptimes,proto3" json:"uptimes,omitempty"`
+}
+
+func (m *AttestationUptimesResponse) Reset()         { *m = AttestationUptimesResponse{} }
+func (m *AttestationUptimesResponse) String() string { return proto.CompactTextString(m) }
+func (*AttestationUptimesResponse) ProtoMessage()    {}
+func (*AttestationUptimesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93d3f1745081aabb, []int{11}
+}
+func (m *AttestationUptimesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttestationUptimesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttestationUptimesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttestationUptimesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestationUptimesResponse.Merge(m, src)
+}
+func (m *AttestationUptimesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttestationUptimesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestationUptimesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttestationUptimesResponse proto.InternalMessageInfo
+
+func (m *AttestationUptimesResponse) GetUptimes() []*ValidatorUptime {
+	if m != nil {
+		return m.Uptimes
+	}
+	return nil
+}
+
+type ValidatorUptime struct {
+	ValidatorAddress []byte  `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Expected         uint64  `protobuf:"varint,2,opt,name=expected,proto3" json:"expected,omitempty"`
+	Missed           uint64  `protobuf:"varint,3,opt,name=missed,proto3" json:"missed,omitempty"`
+	Uptime           float64 `protobuf:"fixed64,4,opt,name=uptime,proto3" json:"uptime,omitempty"`
+}
+
+func (m *ValidatorUptime) Reset()         { *m = ValidatorUptime{} }
+func (m *ValidatorUptime) String() string { return proto.CompactTextString(m) }
+func (*ValidatorUptime) ProtoMessage()    {}
+func (*ValidatorUptime) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93d3f1745081aabb, []int{12}
+}
+func (m *ValidatorUptime) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorUptime) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorUptime.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorUptime) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorUptime.Merge(m, src)
+}
+func (m *ValidatorUptime) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorUptime) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorUptime.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorUptime proto.InternalMessageInfo
+
+func (m *ValidatorUptime) GetValidatorAddress() []byte {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return nil
+}
+
+func (m *ValidatorUptime) GetExpected() uint64 {
+	if m != nil {
+		return m.Expected
+	}
+	return 0
+}
+
+func (m *ValidatorUptime) GetMissed() uint64 {
+	if m != nil {
+		return m.Missed
+	}
+	return 0
+}
+
+func (m *ValidatorUptime) GetUptime() float64 {
+	if m != nil {
+		return m.Uptime
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*AttestationsFromRequest)(nil), "halo.attest.types.AttestationsFromRequest")
 	proto.RegisterType((*AttestationsFromResponse)(nil), "halo.attest.types.AttestationsFromResponse")
@@ -553,43 +702,53 @@ func init() {
 	proto.RegisterType((*ListAllAttestationsResponse)(nil), "halo.attest.types.ListAllAttestationsResponse")
 	proto.RegisterType((*WindowCompareRequest)(nil), "halo.attest.types.WindowCompareRequest")
 	proto.RegisterType((*WindowCompareResponse)(nil), "halo.attest.types.WindowCompareResponse")
+	proto.RegisterType((*AttestationUptimesRequest)(nil), "halo.attest.types.AttestationUptimesRequest")
+	proto.RegisterType((*AttestationUptimesResponse)(nil), "halo.attest.types.AttestationUptimesResponse")
+	proto.RegisterType((*ValidatorUptime)(nil), "halo.attest.types.ValidatorUptime")
 }
 
 func init() { proto.RegisterFile("halo/attest/types/query.proto", fileDescriptor_93d3f1745081aabb) }
 
 var fileDescriptor_93d3f1745081aabb = []byte{
-	// 488 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xdd, 0x6e, 0xd3, 0x30,
-	0x14, 0x8e, 0x69, 0x37, 0xe0, 0x94, 0x4a, 0xab, 0xf9, 0xcb, 0x3c, 0x2d, 0x54, 0xe1, 0x82, 0xc2,
-	0x20, 0x95, 0xc6, 0x0b, 0xb0, 0x21, 0x90, 0x90, 0x2a, 0x21, 0x22, 0x04, 0x12, 0x12, 0x54, 0xa6,
-	0x75, 0xb5, 0x48, 0x49, 0x9c, 0xc5, 0xee, 0x60, 0x4f, 0x01, 0x4f, 0xc4, 0x35, 0x97, 0xbb, 0xe4,
-	0x12, 0xb5, 0x2f, 0x82, 0xec, 0x84, 0xc9, 0x6d, 0xdc, 0xb5, 0x52, 0x7b, 0x17, 0x9f, 0x9f, 0xef,
-	0xfb, 0x8e, 0xf2, 0x1d, 0x1b, 0xf6, 0x4f, 0x68, 0xcc, 0xbb, 0x54, 0x4a, 0x26, 0x64, 0x57, 0x9e,
-	0x67, 0x4c, 0x74, 0x4f, 0xc7, 0x2c, 0x3f, 0x0f, 0xb2, 0x9c, 0x4b, 0x8e, 0x5b, 0x2a, 0x1d, 0x14,
-	0xe9, 0x40, 0xa7, 0x09, 0xa9, 0x76, 0xc8, 0xef, 0x45, 0xb9, 0x2f, 0xe1, 0xfe, 0x91, 0x4e, 0x50,
-	0x19, 0xf1, 0x54, 0xbc, 0xce, 0x79, 0x12, 0xb2, 0xd3, 0x31, 0x13, 0x12, 0xef, 0xc2, 0x8d, 0xc1,
-	0x09, 0x8d, 0xd2, 0x7e, 0x34, 0x74, 0x51, 0x1b, 0x75, 0xea, 0xe1, 0x75, 0x7d, 0x7e, 0x33, 0xc4,
-	0xfb, 0x00, 0x03, 0x9e, 0x8e, 0xfa, 0x31, 0x3b, 0x63, 0xb1, 0x7b, 0xad, 0x8d, 0x3a, 0xcd, 0xf0,
-	0xa6, 0x8a, 0xf4, 0x54, 0x00, 0x3f, 0x80, 0xc6, 0x28, 0xe7, 0x49, 0x9f, 0x8f, 0x46, 0x82, 0x49,
-	0xb7, 0xa6, 0x9b, 0x41, 0x85, 0xde, 0xea, 0x88, 0xff, 0x05, 0xdc, 0x2a, 0xab, 0xc8, 0x78, 0x2a,
-	0x18, 0x3e, 0x86, 0x5b, 0xd4, 0xc8, 0xb9, 0xa8, 0x5d, 0xeb, 0x34, 0x0e, 0xbd, 0xa0, 0x32, 0x57,
-	0x60, 0x40, 0x84, 0x33, 0x3d, 0xfe, 0x7b, 0x70, 0x7b, 0x54, 0x9d, 0xcd, 0x92, 0x75, 0xc7, 0xf2,
-	0x3f, 0xc3, 0xae, 0x05, 0xb5, 0x94, 0xfd, 0x02, 0x1a, 0x86, 0x04, 0x8d, 0xbc, 0x5c, 0xb5, 0xd9,
-	0xe2, 0x7f, 0x00, 0xf2, 0x8a, 0xe6, 0x71, 0xb4, 0x69, 0xd9, 0x7d, 0xd8, 0xb3, 0xe2, 0x6e, 0x4c,
-	0xf8, 0x0f, 0x04, 0xa4, 0x17, 0x09, 0x79, 0x14, 0xc7, 0xe6, 0x5f, 0x5d, 0xdf, 0x47, 0xf7, 0x60,
-	0x5b, 0x81, 0x8d, 0x85, 0xb6, 0x50, 0x33, 0x2c, 0x4f, 0xf3, 0xfe, 0xaa, 0x57, 0xfc, 0x45, 0x61,
-	0xcf, 0x2a, 0x68, 0x83, 0x16, 0x1b, 0xc3, 0x9d, 0x8f, 0x51, 0x3a, 0xe4, 0xdf, 0x5e, 0xf2, 0x24,
-	0xa3, 0x39, 0x5b, 0x7f, 0xda, 0x87, 0xd0, 0x2c, 0x18, 0x66, 0xf7, 0xa6, 0xa4, 0x2d, 0x27, 0x7b,
-	0x0c, 0x77, 0xe7, 0x68, 0xcb, 0x99, 0x76, 0xa0, 0x36, 0x48, 0x32, 0x4d, 0xb9, 0x15, 0xaa, 0xcf,
-	0xc3, 0x5f, 0x75, 0xd8, 0x7a, 0xa7, 0x6e, 0x06, 0x9c, 0xc0, 0xce, 0xfc, 0xba, 0xe1, 0x27, 0x57,
-	0x4f, 0x6b, 0xde, 0x04, 0xe4, 0x60, 0xa5, 0xda, 0x42, 0x88, 0xef, 0xe0, 0x0c, 0x5a, 0x95, 0x3d,
-	0xc1, 0x36, 0x8c, 0x45, 0x3b, 0x4a, 0x9e, 0xae, 0x56, 0x7c, 0xc9, 0x78, 0x06, 0xb7, 0x2d, 0x16,
-	0xc7, 0xcf, 0x2c, 0x30, 0x8b, 0x57, 0x8c, 0x04, 0xab, 0x96, 0x9b, 0xbc, 0x16, 0x9f, 0x59, 0x79,
-	0x17, 0x2f, 0x88, 0x95, 0xf7, 0x0a, 0xfb, 0xfa, 0x0e, 0x1e, 0x42, 0x73, 0xc6, 0x05, 0xf8, 0x91,
-	0x05, 0xc2, 0x66, 0x4f, 0xd2, 0x59, 0x5e, 0xf8, 0x9f, 0xe5, 0xf8, 0xe0, 0xf7, 0xc4, 0x43, 0x17,
-	0x13, 0x0f, 0xfd, 0x9d, 0x78, 0xe8, 0xe7, 0xd4, 0x73, 0x2e, 0xa6, 0x9e, 0xf3, 0x67, 0xea, 0x39,
-	0x9f, 0x5a, 0x95, 0x17, 0xe5, 0xeb, 0xb6, 0x7e, 0x4f, 0x9e, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff,
-	0x0a, 0xd8, 0xf1, 0x12, 0x9f, 0x06, 0x00, 0x00,
+	// 606 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcd, 0x6e, 0xda, 0x4e,
+	0x10, 0xc7, 0x7f, 0x20, 0xc9, 0x7f, 0x08, 0x2a, 0x6c, 0xbf, 0xcc, 0xa2, 0xb8, 0xc8, 0x3d, 0x94,
+	0x96, 0xc4, 0x48, 0xe9, 0xb5, 0x87, 0x92, 0xaa, 0x95, 0x2a, 0x21, 0x55, 0xb5, 0xda, 0x54, 0x8a,
+	0xd4, 0xa2, 0x2d, 0x5e, 0x14, 0x4b, 0x36, 0xeb, 0x78, 0x17, 0x9a, 0xbc, 0x42, 0x2f, 0xed, 0xbb,
+	0xf4, 0x25, 0x7a, 0xcc, 0xb1, 0xc7, 0x0a, 0x5e, 0xa4, 0xf2, 0xda, 0x20, 0x83, 0x97, 0x04, 0x09,
+	0x6e, 0x9e, 0xaf, 0xdf, 0x6f, 0x66, 0x76, 0x66, 0x0c, 0x07, 0xe7, 0xc4, 0x63, 0x6d, 0x22, 0x04,
+	0xe5, 0xa2, 0x2d, 0xae, 0x02, 0xca, 0xdb, 0x17, 0x23, 0x1a, 0x5e, 0x59, 0x41, 0xc8, 0x04, 0x43,
+	0xd5, 0xc8, 0x6c, 0xc5, 0x66, 0x4b, 0x9a, 0x31, 0xce, 0x46, 0x88, 0xcb, 0xd8, 0xdd, 0x14, 0xf0,
+	0xb0, 0x23, 0x0d, 0x44, 0xb8, 0x6c, 0xc8, 0xdf, 0x84, 0xcc, 0xb7, 0xe9, 0xc5, 0x88, 0x72, 0x81,
+	0x6a, 0xb0, 0xd7, 0x3f, 0x27, 0xee, 0xb0, 0xe7, 0x3a, 0xba, 0xd6, 0xd0, 0x9a, 0x05, 0x7b, 0x57,
+	0xca, 0x6f, 0x1d, 0x74, 0x00, 0xd0, 0x67, 0xc3, 0x41, 0xcf, 0xa3, 0x63, 0xea, 0xe9, 0xff, 0x35,
+	0xb4, 0x66, 0xd9, 0xfe, 0x3f, 0xd2, 0x74, 0x23, 0x05, 0x7a, 0x04, 0xa5, 0x41, 0xc8, 0xfc, 0x1e,
+	0x1b, 0x0c, 0x38, 0x15, 0x7a, 0x5e, 0x06, 0x43, 0xa4, 0x7a, 0x27, 0x35, 0xe6, 0x17, 0xd0, 0xb3,
+	0xac, 0x3c, 0x60, 0x43, 0x4e, 0xd1, 0x09, 0xec, 0x93, 0x94, 0x4d, 0xd7, 0x1a, 0xf9, 0x66, 0xe9,
+	0xd8, 0xb0, 0x32, 0x75, 0x59, 0x29, 0x08, 0x7b, 0x21, 0xc6, 0xfc, 0x00, 0x7a, 0x97, 0x44, 0x72,
+	0xda, 0x65, 0xd3, 0xb2, 0xcc, 0xcf, 0x50, 0x53, 0xa0, 0x26, 0x69, 0xbf, 0x84, 0x52, 0x2a, 0x05,
+	0x89, 0x7c, 0x7b, 0xd6, 0xe9, 0x10, 0xf3, 0x14, 0xf0, 0x6b, 0x12, 0x7a, 0xee, 0xb6, 0xd3, 0xee,
+	0x41, 0x5d, 0x89, 0xbb, 0xb5, 0xc4, 0x7f, 0x68, 0x80, 0xbb, 0x2e, 0x17, 0x1d, 0xcf, 0x4b, 0xbf,
+	0xea, 0xe6, 0x73, 0xf4, 0x00, 0x76, 0x22, 0xb0, 0x11, 0x97, 0x23, 0x54, 0xb6, 0x13, 0x69, 0x79,
+	0xbe, 0x0a, 0x99, 0xf9, 0x22, 0x50, 0x57, 0x26, 0xb4, 0xc5, 0x11, 0x1b, 0xc1, 0xbd, 0x4f, 0xee,
+	0xd0, 0x61, 0xdf, 0x5e, 0x31, 0x3f, 0x20, 0x21, 0xdd, 0xbc, 0xda, 0xc7, 0x50, 0x8e, 0x19, 0x16,
+	0xf7, 0x26, 0xa1, 0x4d, 0x2a, 0x7b, 0x0a, 0xf7, 0x97, 0x68, 0x93, 0x9a, 0x2a, 0x90, 0xef, 0xfb,
+	0x81, 0xa4, 0x2c, 0xda, 0xd1, 0xa7, 0x59, 0x87, 0x5a, 0x2a, 0xfd, 0x8f, 0x81, 0x70, 0x7d, 0x3a,
+	0x7b, 0x14, 0xf3, 0x0c, 0xb0, 0xca, 0x98, 0x80, 0xbd, 0x80, 0xdd, 0x51, 0xac, 0x4a, 0x7a, 0x63,
+	0x2a, 0x7a, 0x73, 0x4a, 0x3c, 0xd7, 0x21, 0x82, 0x85, 0x71, 0xb4, 0x3d, 0x0b, 0x31, 0xbf, 0x6b,
+	0x70, 0x67, 0xc9, 0x88, 0x5a, 0x50, 0x1d, 0xcf, 0x54, 0x3d, 0xe2, 0x38, 0x21, 0xe5, 0x5c, 0x26,
+	0xbb, 0x6f, 0x57, 0xe6, 0x86, 0x4e, 0xac, 0x47, 0x18, 0xf6, 0xe8, 0x65, 0x40, 0xfb, 0x82, 0x3a,
+	0xb2, 0x4d, 0x05, 0x7b, 0x2e, 0x47, 0x33, 0xe1, 0xbb, 0x9c, 0x53, 0x27, 0x69, 0x4f, 0x22, 0x45,
+	0xfa, 0x98, 0x5f, 0x8e, 0x83, 0x66, 0x27, 0xd2, 0xf1, 0xaf, 0x22, 0x14, 0xdf, 0x47, 0xf7, 0x11,
+	0xf9, 0x50, 0x59, 0x3e, 0x3a, 0xe8, 0xd9, 0xcd, 0x6f, 0x9e, 0xbe, 0x87, 0xb8, 0xb5, 0x96, 0x6f,
+	0xdc, 0x41, 0x33, 0x87, 0x02, 0xa8, 0x66, 0xae, 0x05, 0x52, 0x61, 0xac, 0xba, 0x54, 0xf8, 0x70,
+	0x3d, 0xe7, 0x39, 0xe3, 0x18, 0xee, 0x2a, 0x16, 0x1d, 0x1d, 0x29, 0x60, 0x56, 0x1f, 0x1a, 0x6c,
+	0xad, 0xeb, 0x9e, 0xe6, 0x55, 0x6c, 0x9b, 0x92, 0x77, 0xf5, 0x99, 0xc0, 0xd6, 0xba, 0xee, 0x73,
+	0x5e, 0x07, 0xca, 0x0b, 0xbb, 0x80, 0x9e, 0x28, 0x20, 0x54, 0x4b, 0x8a, 0x9b, 0xb7, 0x3b, 0xce,
+	0x59, 0x38, 0xa0, 0xec, 0xa6, 0xa0, 0xc3, 0x9b, 0x87, 0x61, 0x71, 0xdb, 0xf0, 0xd1, 0x9a, 0xde,
+	0x33, 0xd2, 0x93, 0xd6, 0xef, 0x89, 0xa1, 0x5d, 0x4f, 0x0c, 0xed, 0xef, 0xc4, 0xd0, 0x7e, 0x4e,
+	0x8d, 0xdc, 0xf5, 0xd4, 0xc8, 0xfd, 0x99, 0x1a, 0xb9, 0xb3, 0x6a, 0xe6, 0x67, 0xfe, 0x75, 0x47,
+	0xfe, 0xca, 0x9f, 0xff, 0x1b, 0x00, 0x00, 0xbb, 0x81, 0x02, 0x1a, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -618,6 +777,9 @@ type QueryClient interface {
 	// It returns whether the request is behind (-1), or in (0), or after (1) the vote window.
 	// The vote window is a configured number of blocks around the latest approved attestation.
 	WindowCompare(ctx context.Context, in *WindowCompareRequest, opts ...grpc.CallOption) (*WindowCompareResponse, error)
+	// AttestationUptimes queries halo for the attestation liveness of all validators,
+	// i.e., their included votes over the liveness window of expected votes.
+	AttestationUptimes(ctx context.Context, in *AttestationUptimesRequest, opts ...grpc.CallOption) (*AttestationUptimesResponse, error)
 }
 
 type queryClient struct {
@@ -673,6 +835,15 @@ func (c *queryClient) WindowCompare(ctx context.Context, in *WindowCompareReques
 	return out, nil
 }
 
+func (c *queryClient) AttestationUptimes(ctx context.Context, in *AttestationUptimesRequest, opts ...grpc.CallOption) (*AttestationUptimesResponse, error) {
+	out := new(AttestationUptimesResponse)
+	err := c.cc.Invoke(ctx, "/halo.attest.types.Query/AttestationUptimes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// AttestationsFrom queries halo for approved attestations for the given chain_id
@@ -689,6 +860,9 @@ type QueryServer interface {
 	// It returns whether the request is behind (-1), or in (0), or after (1) the vote window.
 	// The vote window is a configured number of blocks around the latest approved attestation.
 	WindowCompare(context.Context, *WindowCompareRequest) (*WindowCompareResponse, error)
+	// AttestationUptimes queries halo for the attestation liveness of all validators,
+	// i.e., their included votes over the liveness window of expected votes.
+	AttestationUptimes(context.Context, *AttestationUptimesRequest) (*AttestationUptimesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -710,6 +884,9 @@ func (*UnimplementedQueryServer) ListAllAttestations(ctx context.Context, req *L
 func (*UnimplementedQueryServer) WindowCompare(ctx context.Context, req *WindowCompareRequest) (*WindowCompareResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WindowCompare not implemented")
 }
+func (*UnimplementedQueryServer) AttestationUptimes(ctx context.Context, req *AttestationUptimesRequest) (*AttestationUptimesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttestationUptimes not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -805,6 +982,24 @@ func _Query_WindowCompare_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AttestationUptimes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttestationUptimesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AttestationUptimes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/halo.attest.types.Query/AttestationUptimes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AttestationUptimes(ctx, req.(*AttestationUptimesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "halo.attest.types.Query",
@@ -830,6 +1025,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "WindowCompare",
 			Handler:    _Query_WindowCompare_Handler,
 		},
+		{
+			MethodName: "AttestationUptimes",
+			Handler:    _Query_AttestationUptimes_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "halo/attest/types/query.proto",
@@ -1192,6 +1391,112 @@ func (m *WindowCompareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AttestationUptimesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestationUptimesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttestationUptimesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *AttestationUptimesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestationUptimesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttestationUptimesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Uptimes) > 0 {
+		for iNdEx := len(m.Uptimes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Uptimes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorUptime) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorUptime) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorUptime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Uptime != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Uptime))))
+		i--
+		dAtA[i] = 0x21
+	}
+	if m.Missed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Missed))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Expected != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Expected))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1358,6 +1663,52 @@ func (m *WindowCompareResponse) Size() (n int) {
 	return n
 }
 
+func (m *AttestationUptimesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *AttestationUptimesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Uptimes) > 0 {
+		for _, e := range m.Uptimes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorUptime) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Expected != 0 {
+		n += 1 + sovQuery(uint64(m.Expected))
+	}
+	if m.Missed != 0 {
+		n += 1 + sovQuery(uint64(m.Missed))
+	}
+	if m.Uptime != 0 {
+		n += 9
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2289,6 +2640,273 @@ func (m *WindowCompareResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AttestationUptimesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestationUptimesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestationUptimesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttestationUptimesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestationUptimesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestationUptimesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uptimes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uptimes = append(m.Uptimes, &ValidatorUptime{})
+			if err := m.Uptimes[len(m.Uptimes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorUptime) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorUptime: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorUptime: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = append(m.ValidatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorAddress == nil {
+				m.ValidatorAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expected", wireType)
+			}
+			m.Expected = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expected |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Missed", wireType)
+			}
+			m.Missed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Missed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uptime", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Uptime = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -106,3 +106,31 @@ func AttestHeaderFromProto(header *AttestHeader) xchain.AttestHeader {
 		AttestOffset: header.GetAttestOffset(),
 	}
 }
+
+// AttestationToProto converts a xchain.Attestation to a protobuf Attestation.
+func AttestationToProto(att xchain.Attestation) *Attestation {
+	sigs := make([]*SigTuple, 0, len(att.Signatures))
+	for _, sig := range att.Signatures {
+		sigs = append(sigs, &SigTuple{
+			ValidatorAddress: sig.ValidatorAddress.Bytes(),
+			Signature:        sig.Signature[:],
+		})
+	}
+
+	return &Attestation{
+		AttestHeader: &AttestHeader{
+			ConsensusChainId: att.ConsensusChainID,
+			SourceChainId:    att.ChainVersion.ID,
+			ConfLevel:        uint32(att.ChainVersion.ConfLevel),
+			AttestOffset:     att.AttestOffset,
+		},
+		BlockHeader: &BlockHeader{
+			ChainId:     att.BlockHeader.ChainID,
+			BlockHeight: att.BlockHeight,
+			BlockHash:   att.BlockHash[:],
+		},
+		MsgRoot:        att.MsgRoot[:],
+		Signatures:     sigs,
+		ValidatorSetId: att.ValidatorSetID,
+	}
+}
@@ -25,6 +25,7 @@ func New() *cobra.Command {
 		newRunCmd("run", app.Run),
 		newInitCmd(),
 		newRollbackCmd(),
+		newExportCmd(),
 		buildinfo.NewVersionCmd(),
 		newConsKeyCmd(),
 		newStatusCmd(),
@@ -114,6 +115,53 @@ different block N cannot be re-built/re-proposed since that would result in vali
 	return cmd
 }
 
+func newExportCmd() *cobra.Command {
+	logCfg := log.DefaultConfig()
+	haloCfg := halocfg.DefaultConfig()
+	var exportCfg app.ExportConfig
+
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export consensus state as a genesis file",
+		Long: `
+Export the application state of all modules and the bonded validators as a genesis file.
+This supports coordinated chain restarts, fork tests and bootstrapping new networks from existing state.
+Halo nodes started from the exported genesis continue at the height after the exported height.
+
+By default, the exported genesis continues building on top of the exported execution head (hash, height and time),
+so the execution chain must be retained. Set --execution-genesis-file to start from a new execution genesis block instead.
+The halo node must be stopped while exporting.
+`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx, err := log.Init(cmd.Context(), logCfg)
+			if err != nil {
+				return err
+			}
+			if err := libcmd.LogFlags(ctx, cmd.Flags()); err != nil {
+				return err
+			}
+
+			cmtCfg, err := parseCometConfig(ctx, haloCfg.HomeDir)
+			if err != nil {
+				return err
+			}
+
+			appCfg := app.Config{
+				Config: haloCfg,
+				Comet:  cmtCfg,
+			}
+
+			return app.Export(ctx, appCfg, exportCfg)
+		},
+	}
+
+	bindRunFlags(cmd, &haloCfg)
+	bindExportFlags(cmd, &exportCfg)
+	log.BindFlags(cmd.Flags(), &logCfg)
+
+	return cmd
+}
+
 func newConsKeyCmd() *cobra.Command {
 	home := halocfg.DefaultConfig().HomeDir
 
@@ -80,6 +80,7 @@ func TestCLIReference(t *testing.T) {
 		{"run"},
 		{"init"},
 		{"rollback"},
+		{"export"},
 	}
 
 	for _, test := range tests {
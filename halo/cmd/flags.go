@@ -36,6 +36,7 @@ func bindRunFlags(cmd *cobra.Command, cfg *halocfg.Config) {
 	flags.StringVar(&cfg.PruningOption, "pruning", cfg.PruningOption, "Pruning strategy (default|nothing|everything)")
 	flags.DurationVar(&cfg.EVMBuildDelay, "evm-build-delay", cfg.EVMBuildDelay, "Minimum delay between triggering and fetching a EVM payload build")
 	flags.BoolVar(&cfg.EVMBuildOptimistic, "evm-build-optimistic", cfg.EVMBuildOptimistic, "Enables optimistic building of EVM payloads on previous block finalize")
+	flags.IntVar(&cfg.RPCQuorum, "xchain-evm-rpc-quorum", cfg.RPCQuorum, "Number of cross-chain EVM RPC endpoints per chain that must agree on block hashes before voting; zero or one disables cross-checking")
 	flags.IntSliceVar(&cfg.UnsafeSkipUpgrades, sdkserver.FlagUnsafeSkipUpgrades, cfg.UnsafeSkipUpgrades, "Skip a set of upgrade heights to continue the old binary")
 }
 
@@ -43,6 +44,16 @@ func bindRollbackFlags(flags *pflag.FlagSet, cfg *app.RollbackConfig) {
 	flags.BoolVar(&cfg.RemoveCometBlock, "hard", cfg.RemoveCometBlock, "Remove last block as well as state")
 }
 
+func bindExportFlags(cmd *cobra.Command, cfg *app.ExportConfig) {
+	const flagOutputFile = "output-file"
+	flags := cmd.Flags()
+	flags.Int64Var(&cfg.Height, "height", cfg.Height, "Height to export, defaults to the latest height")
+	flags.StringVar(&cfg.OutputFile, flagOutputFile, cfg.OutputFile, "Path of the exported genesis file")
+	flags.StringVar(&cfg.ExecutionGenesisFile, "execution-genesis-file", cfg.ExecutionGenesisFile, "Optional path of an execution genesis file to start the exported genesis from, instead of the exported execution head")
+
+	_ = cmd.MarkFlagRequired(flagOutputFile)
+}
+
 func bindInitFlags(flags *pflag.FlagSet, cfg *InitConfig) {
 	libcmd.BindHomeFlag(flags, &cfg.HomeDir)
 	netconf.BindFlag(flags, &cfg.Network)
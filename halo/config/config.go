@@ -77,6 +77,7 @@ type Config struct {
 	EngineJWTFile      string
 	EngineEndpoint     string
 	RPCEndpoints       xchain.RPCEndpoints
+	RPCQuorum          int
 	SnapshotInterval   uint64 // See cosmossdk.io/store/snapshots/types/options.go
 	SnapshotKeepRecent uint32 // See cosmossdk.io/store/snapshots/types/options.go
 	BackendType        string // See cosmos-db/db.go
@@ -3,7 +3,6 @@ package evmstaking
 import (
 	"github.com/omni-network/omni/lib/errors"
 	"github.com/omni-network/omni/lib/ethclient"
-	evmenginetypes "github.com/omni-network/omni/octane/evmengine/types"
 
 	"cosmossdk.io/depinject"
 	accountkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
@@ -19,10 +18,11 @@ type DIInputs struct {
 	AccountKeeper accountkeeper.AccountKeeper
 }
 
+// DIOutputs only provides the event processor, since it isn't injected into the evmengine directly.
+// The evmstaking2 module defers to it before the 2_magellan network upgrade.
 type DIOutputs struct {
 	depinject.Out
-	EventProc         EventProcessor
-	InjectedEventProc evmenginetypes.InjectedEventProc
+	EventProc EventProcessor
 }
 
 func DIProvide(input DIInputs) (DIOutputs, error) {
@@ -37,7 +37,6 @@ func DIProvide(input DIInputs) (DIOutputs, error) {
 	}
 
 	return DIOutputs{
-		EventProc:         proc,
-		InjectedEventProc: evmenginetypes.InjectEventProc(proc),
+		EventProc: proc,
 	}, nil
 }
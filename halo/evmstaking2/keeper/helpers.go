@@ -10,6 +10,7 @@ import (
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // mustGetABI returns the metadata's ABI as an abi.ABI type.
@@ -41,6 +42,16 @@ func omniToBondCoin(amount *big.Int) (sdk.Coin, sdk.Coins) {
 	return coin, sdk.NewCoins(coin)
 }
 
+// descOrNoModify returns the validator description field, or the
+// do-not-modify placeholder if empty, which leaves the existing field unchanged.
+func descOrNoModify(field string) string {
+	if field == "" {
+		return stypes.DoNotModifyDesc
+	}
+
+	return field
+}
+
 // catch executes the function, returning an error if it panics.
 func catch(fn func() error) (err error) {
 	defer func() {
@@ -2,8 +2,10 @@ package keeper
 
 import (
 	"context"
+	"math/big"
 
 	"github.com/omni-network/omni/contracts/bindings"
+	"github.com/omni-network/omni/halo/app/upgrades/magellan"
 	"github.com/omni-network/omni/halo/evmstaking2/types"
 	"github.com/omni-network/omni/halo/genutil/evm/predeploys"
 	"github.com/omni-network/omni/lib/errors"
@@ -24,13 +26,18 @@ import (
 )
 
 var (
-	stakingABI           = mustGetABI(bindings.StakingMetaData)
-	createValidatorEvent = mustGetEvent(stakingABI, "CreateValidator")
-	delegateEvent        = mustGetEvent(stakingABI, "Delegate")
+	stakingABI                         = mustGetABI(bindings.StakingMetaData)
+	createValidatorEvent               = mustGetEvent(stakingABI, "CreateValidator")
+	createValidatorWithCommissionEvent = mustGetEvent(stakingABI, "CreateValidatorWithCommission")
+	delegateEvent                      = mustGetEvent(stakingABI, "Delegate")
+	undelegateEvent                    = mustGetEvent(stakingABI, "Undelegate")
+	editValidatorEvent                 = mustGetEvent(stakingABI, "EditValidator")
 )
 
 // Keeper also implements the evmenginetypes.EvmEventProcessor interface.
+// Before the 2_magellan network upgrade, it defers to the legacy event processor.
 type Keeper struct {
+	storeService    store.KVStoreService
 	eventsTable     EVMEventTable
 	ethCl           ethclient.Client
 	address         common.Address
@@ -39,6 +46,8 @@ type Keeper struct {
 	bKeeper         types.BankKeeper
 	sKeeper         types.StakingKeeper
 	sServer         types.StakingMsgServer
+	legacyProc      evmenginetypes.EvmEventProcessor
+	upgrades        types.UpgradeKeeper
 	deliverInterval int64
 }
 
@@ -49,6 +58,7 @@ func NewKeeper(
 	bKeeper types.BankKeeper,
 	sKeeper types.StakingKeeper,
 	sServer types.StakingMsgServer,
+	legacyProc evmenginetypes.EvmEventProcessor,
 	deliverInterval int64,
 ) (*Keeper, error) {
 	schema := &ormv1alpha1.ModuleSchemaDescriptor{SchemaFile: []*ormv1alpha1.ModuleSchemaDescriptor_FileEntry{
@@ -72,6 +82,7 @@ func NewKeeper(
 	}
 
 	return &Keeper{
+		storeService:    storeService,
 		eventsTable:     evmstakingStore.EVMEventTable(),
 		ethCl:           ethCl,
 		aKeeper:         aKeeper,
@@ -80,12 +91,39 @@ func NewKeeper(
 		sServer:         sServer,
 		address:         address,
 		contract:        contract,
+		legacyProc:      legacyProc,
+		upgrades:        stubUpgradeKeeper{},
 		deliverInterval: deliverInterval,
 	}, nil
 }
 
+// SetUpgradeKeeper sets the upgrade keeper used to gate logic behind network upgrades.
+func (k *Keeper) SetUpgradeKeeper(upgrades types.UpgradeKeeper) {
+	k.upgrades = upgrades
+}
+
+// isMagellan returns true if the 2_magellan network upgrade was executed.
+// It gates buffered delivery of all staking events, including undelegations and validator edits, and withdrawals.
+// Before that, events are delivered immediately by the legacy event processor.
+func (k Keeper) isMagellan(ctx context.Context) (bool, error) {
+	height, err := k.upgrades.GetDoneHeight(ctx, magellan.UpgradeName)
+	if err != nil {
+		return false, errors.Wrap(err, "get upgrade done height")
+	}
+
+	return height > 0, nil
+}
+
 // EndBlock delivers all pending EVM events on every `k.deliverInterval`'th block.
+// It then queues withdrawals of unbonded stake and rewards of undelegated accounts.
+// It is a noop before the 2_magellan network upgrade.
 func (k *Keeper) EndBlock(ctx context.Context) error {
+	if ok, err := k.isMagellan(ctx); err != nil {
+		return err
+	} else if !ok {
+		return nil
+	}
+
 	blockHeight := sdk.UnwrapSDKContext(ctx).BlockHeight()
 
 	if blockHeight%k.deliverInterval != 0 {
@@ -110,15 +148,26 @@ func (k *Keeper) EndBlock(ctx context.Context) error {
 		}
 	}
 
+	if err := k.queueWithdrawals(ctx); err != nil {
+		return errors.Wrap(err, "queue withdrawals")
+	}
+
 	return nil
 }
 
 // Prepare returns all omni stake contract EVM event logs from the provided block hash.
+// Before the 2_magellan network upgrade, only the legacy event logs are returned.
 func (k Keeper) Prepare(ctx context.Context, blockHash common.Hash) ([]evmenginetypes.EVMEvent, error) {
+	if ok, err := k.isMagellan(ctx); err != nil {
+		return nil, err
+	} else if !ok {
+		return k.legacyProc.Prepare(ctx, blockHash)
+	}
+
 	logs, err := k.ethCl.FilterLogs(ctx, ethereum.FilterQuery{
 		BlockHash: &blockHash,
 		Addresses: k.Addresses(),
-		Topics:    [][]common.Hash{{createValidatorEvent.ID, delegateEvent.ID}},
+		Topics:    [][]common.Hash{{createValidatorEvent.ID, createValidatorWithCommissionEvent.ID, delegateEvent.ID, undelegateEvent.ID, editValidatorEvent.ID}},
 	})
 	if err != nil {
 		return nil, errors.Wrap(err, "filter logs")
@@ -150,11 +199,21 @@ func (k Keeper) Addresses() []common.Address {
 
 // Deliver processes a omni deposit log event, which must be one of:
 // - CreateValidator
-// - Delegate.
+// - CreateValidatorWithCommission
+// - Delegate
+// - Undelegate
+// - EditValidator.
 // Note that the event delivery is not immediate. Instead, every event is
 // first stored in keeper's state. Then all stored events are periodically delivered
 // from `EndBlock` at once.
-func (k Keeper) Deliver(ctx context.Context, _ common.Hash, elog evmenginetypes.EVMEvent) error {
+// Before the 2_magellan network upgrade, events are delivered immediately by the legacy event processor.
+func (k Keeper) Deliver(ctx context.Context, blockHash common.Hash, elog evmenginetypes.EVMEvent) error {
+	if ok, err := k.isMagellan(ctx); err != nil {
+		return err
+	} else if !ok {
+		return k.legacyProc.Deliver(ctx, blockHash, elog)
+	}
+
 	err := k.eventsTable.Insert(ctx, &EVMEvent{
 		Event: &elog,
 	})
@@ -206,6 +265,15 @@ func (k Keeper) parseAndDeliver(ctx context.Context, elog *evmenginetypes.EVMEve
 		if err := k.deliverCreateValidator(ctx, delegate); err != nil {
 			return errors.Wrap(err, "create validator")
 		}
+	case createValidatorWithCommissionEvent.ID:
+		create, err := k.contract.ParseCreateValidatorWithCommission(ethlog)
+		if err != nil {
+			return errors.Wrap(err, "parse create validator with commission")
+		}
+
+		if err := k.deliverCreateValidatorWithCommission(ctx, create); err != nil {
+			return errors.Wrap(err, "create validator with commission")
+		}
 	case delegateEvent.ID:
 		delegate, err := k.contract.ParseDelegate(ethlog)
 		if err != nil {
@@ -215,6 +283,24 @@ func (k Keeper) parseAndDeliver(ctx context.Context, elog *evmenginetypes.EVMEve
 		if err := k.deliverDelegate(ctx, delegate); err != nil {
 			return errors.Wrap(err, "delegate")
 		}
+	case undelegateEvent.ID:
+		undelegate, err := k.contract.ParseUndelegate(ethlog)
+		if err != nil {
+			return errors.Wrap(err, "parse undelegate")
+		}
+
+		if err := k.deliverUndelegate(ctx, undelegate); err != nil {
+			return errors.Wrap(err, "undelegate")
+		}
+	case editValidatorEvent.ID:
+		edit, err := k.contract.ParseEditValidator(ethlog)
+		if err != nil {
+			return errors.Wrap(err, "parse edit validator")
+		}
+
+		if err := k.deliverEditValidator(ctx, edit); err != nil {
+			return errors.Wrap(err, "edit validator")
+		}
 	default:
 		return errors.New("unknown event")
 	}
@@ -223,6 +309,7 @@ func (k Keeper) parseAndDeliver(ctx context.Context, elog *evmenginetypes.EVMEve
 }
 
 // deliverDelegate processes a Delegate event, and delegates to an existing validator.
+// The delegator is either the validator itself (self delegation) or any third-party EVM address.
 // - Mint the corresponding amount of $STAKE coins.
 // - Send the minted coins to the delegator's account.
 // - Delegate the minted coins to the validator.
@@ -257,7 +344,7 @@ func (k Keeper) deliverDelegate(ctx context.Context, ev *bindings.StakingDelegat
 		"validator", ev.Validator.Hex(),
 		"amount", ev.Amount.String())
 
-	// Validator already exists, add deposit to self delegation
+	// Validator already exists, add deposit to the delegator's delegation
 	msg := stypes.NewMsgDelegate(delAddr.String(), valAddr.String(), amountCoin)
 	_, err := k.sServer.Delegate(ctx, msg)
 	if err != nil {
@@ -267,6 +354,75 @@ func (k Keeper) deliverDelegate(ctx context.Context, ev *bindings.StakingDelegat
 	return nil
 }
 
+// deliverUndelegate processes an Undelegate event, and undelegates from an existing validator.
+// - Undelegate the amount, which starts unbonding it and withdraws accrued rewards (via distribution hooks).
+// - Track the delegator's account, so its unbonded stake and rewards are withdrawn to its EVM address.
+//
+// See queueWithdrawals for how withdrawals are queued once unbonding completes.
+func (k Keeper) deliverUndelegate(ctx context.Context, ev *bindings.StakingUndelegate) error {
+	if ev.Amount == nil {
+		return errors.New("undelegate amount missing")
+	}
+
+	delAddr := sdk.AccAddress(ev.Delegator.Bytes())
+	valAddr := sdk.ValAddress(ev.Validator.Bytes())
+
+	if _, err := k.sKeeper.GetValidator(ctx, valAddr); err != nil {
+		return errors.New("validator does not exist", "validator", valAddr.String())
+	}
+
+	amountCoin, _ := omniToBondCoin(ev.Amount)
+
+	log.Info(ctx, "EVM staking undelegation detected, undelegating",
+		"delegator", ev.Delegator.Hex(),
+		"validator", ev.Validator.Hex(),
+		"amount", ev.Amount.String())
+
+	msg := stypes.NewMsgUndelegate(delAddr.String(), valAddr.String(), amountCoin)
+	if _, err := k.sServer.Undelegate(ctx, msg); err != nil {
+		return errors.Wrap(err, "undelegate")
+	}
+
+	return k.trackWithdrawals(ctx, delAddr)
+}
+
+// deliverEditValidator processes an EditValidator event, and edits an existing validator's
+// description and commission rate. Empty description fields and negative commission rates are not modified.
+func (k Keeper) deliverEditValidator(ctx context.Context, ev *bindings.StakingEditValidator) error {
+	valAddr := sdk.ValAddress(ev.Validator.Bytes())
+
+	if _, err := k.sKeeper.GetValidator(ctx, valAddr); err != nil {
+		return errors.New("validator does not exist", "validator", valAddr.String())
+	}
+
+	var commissionRate *math.LegacyDec
+	if ev.CommissionRatePercentage > 100 {
+		return errors.New("invalid commission rate", "percentage", ev.CommissionRatePercentage)
+	} else if ev.CommissionRatePercentage >= 0 {
+		rate := math.LegacyNewDecWithPrec(int64(ev.CommissionRatePercentage), 2)
+		commissionRate = &rate
+	}
+
+	description := stypes.Description{
+		Moniker:         descOrNoModify(ev.Moniker),
+		Identity:        descOrNoModify(ev.Identity),
+		Website:         descOrNoModify(ev.Website),
+		SecurityContact: descOrNoModify(ev.SecurityContact),
+		Details:         descOrNoModify(ev.Details),
+	}
+
+	log.Info(ctx, "EVM staking validator edit detected, editing validator",
+		"validator", ev.Validator.Hex(),
+		"commission_percentage", ev.CommissionRatePercentage)
+
+	msg := stypes.NewMsgEditValidator(valAddr.String(), description, commissionRate, nil)
+	if _, err := k.sServer.EditValidator(ctx, msg); err != nil {
+		return errors.Wrap(err, "edit validator")
+	}
+
+	return nil
+}
+
 func (k Keeper) createAccIfNone(ctx context.Context, addr sdk.AccAddress) {
 	if !k.aKeeper.HasAccount(ctx, addr) {
 		acc := k.aKeeper.NewAccountWithAddress(ctx, addr)
@@ -274,22 +430,43 @@ func (k Keeper) createAccIfNone(ctx context.Context, addr sdk.AccAddress) {
 	}
 }
 
-// deliverCreateValidator processes a CreateValidator event, and creates a new validator.
+// deliverCreateValidator processes a CreateValidator event, and creates a new validator without commission.
+func (k Keeper) deliverCreateValidator(ctx context.Context, ev *bindings.StakingCreateValidator) error {
+	return k.createValidator(ctx, ev.Validator, ev.Pubkey, ev.Deposit, math.LegacyZeroDec())
+}
+
+// deliverCreateValidatorWithCommission processes a CreateValidatorWithCommission event,
+// and creates a new validator charging the commission rate on delegator rewards.
+func (k Keeper) deliverCreateValidatorWithCommission(ctx context.Context, ev *bindings.StakingCreateValidatorWithCommission) error {
+	if ev.CommissionRatePercentage > 100 {
+		return errors.New("invalid commission rate", "percentage", ev.CommissionRatePercentage)
+	}
+
+	rate := math.LegacyNewDecWithPrec(int64(ev.CommissionRatePercentage), 2)
+
+	return k.createValidator(ctx, ev.Validator, ev.Pubkey, ev.Deposit, rate)
+}
+
+// createValidator creates a new validator with the initial commission rate.
 // - Mint the corresponding amount of $STAKE coins.
 // - Send the minted coins to the depositor's account.
 // - Create a new validator with the depositor's account.
 //
 // NOTE: if we error, the deposit is lost (on EVM). consider recovery methods.
-func (k Keeper) deliverCreateValidator(ctx context.Context, createValidator *bindings.StakingCreateValidator) error {
-	pubkey, err := k1util.PubKeyBytesToCosmos(createValidator.Pubkey)
+func (k Keeper) createValidator(ctx context.Context, validator common.Address, pubkeyBytes []byte, deposit *big.Int, rate math.LegacyDec) error {
+	pubkey, err := k1util.PubKeyBytesToCosmos(pubkeyBytes)
 	if err != nil {
 		return errors.Wrap(err, "pubkey to cosmos")
 	}
 
-	accAddr := sdk.AccAddress(createValidator.Validator.Bytes())
-	valAddr := sdk.ValAddress(createValidator.Validator.Bytes())
+	if deposit == nil {
+		return errors.New("deposit amount missing")
+	}
+
+	accAddr := sdk.AccAddress(validator.Bytes())
+	valAddr := sdk.ValAddress(validator.Bytes())
 
-	amountCoin, amountCoins := omniToBondCoin(createValidator.Deposit)
+	amountCoin, amountCoins := omniToBondCoin(deposit)
 
 	if _, err := k.sKeeper.GetValidator(ctx, valAddr); err == nil {
 		return errors.New("validator already exists")
@@ -306,15 +483,17 @@ func (k Keeper) deliverCreateValidator(ctx context.Context, createValidator *bin
 	}
 
 	log.Info(ctx, "EVM staking deposit detected, adding new validator",
-		"depositor", createValidator.Validator.Hex(),
-		"amount", createValidator.Deposit.String())
+		"depositor", validator.Hex(),
+		"amount", deposit.String(),
+		"commission_rate", rate.String())
 
 	msg, err := stypes.NewMsgCreateValidator(
 		valAddr.String(),
 		pubkey,
 		amountCoin,
-		stypes.Description{Moniker: createValidator.Validator.Hex()},
-		stypes.NewCommissionRates(math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyZeroDec()),
+		stypes.Description{Moniker: validator.Hex()},
+		// Allow editing the commission rate via EditValidator, by at most 1% per day.
+		stypes.NewCommissionRates(rate, math.LegacyOneDec(), math.LegacyNewDecWithPrec(1, 2)),
 		math.NewInt(1)) // Stub out minimum self delegation for now, just use 1.
 	if err != nil {
 		return errors.Wrap(err, "create validator message")
@@ -329,13 +508,17 @@ func (k Keeper) deliverCreateValidator(ctx context.Context, createValidator *bin
 }
 
 func verifyStakingDelegate(delegate *bindings.StakingDelegate) error {
-	if delegate.Delegator != delegate.Validator {
-		return errors.New("only self delegation")
-	}
-
 	if delegate.Amount == nil {
 		return errors.New("stake amount missing")
 	}
 
 	return nil
 }
+
+// stubUpgradeKeeper is a stub implementation of the upgrade keeper.
+// It reports all upgrades as not executed, so gated logic is disabled.
+type stubUpgradeKeeper struct{}
+
+func (stubUpgradeKeeper) GetDoneHeight(context.Context, string) (int64, error) {
+	return 0, nil
+}
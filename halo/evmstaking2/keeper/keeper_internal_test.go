@@ -3,6 +3,7 @@ package keeper
 import (
 	context "context"
 	"errors"
+	"math/big"
 	"strings"
 	"testing"
 
@@ -16,6 +17,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdktestutil "github.com/cosmos/cosmos-sdk/testutil"
@@ -334,6 +336,10 @@ func setupKeeper(
 			return stypes.Validator{}, errors.New("validator does not exist")
 		})
 
+	// 2_magellan network upgrade executed by default.
+	upgradeKeeperMock := testutil.NewMockUpgradeKeeper(ctrl)
+	upgradeKeeperMock.EXPECT().GetDoneHeight(gomock.Any(), gomock.Any()).AnyTimes().Return(int64(1), nil)
+
 	k, err := NewKeeper(
 		storeSvc,
 		ethCl,
@@ -341,9 +347,109 @@ func setupKeeper(
 		bKeeperMock,
 		sKeeperMock,
 		sServer,
+		new(legacyProcStub),
 		deliverInterval,
 	)
 	require.NoError(t, err, "new keeper")
 
+	k.SetUpgradeKeeper(upgradeKeeperMock)
+
 	return k, ctx
 }
+
+func TestOpenDelegation(t *testing.T) {
+	t.Parallel()
+
+	validator := common.HexToAddress("0x1111")
+	delegator := common.HexToAddress("0x2222")
+	pubkey := k1.GenPrivKey().PubKey().Bytes()
+	deposit := new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil)
+
+	var delegateMsgs []*stypes.MsgDelegate
+	var createMsgs []*stypes.MsgCreateValidator
+
+	ctrl := gomock.NewController(t)
+	sServerMock := testutil.NewMockStakingMsgServer(ctrl)
+	sServerMock.EXPECT().CreateValidator(gomock.Any(), gomock.Any()).AnyTimes().
+		DoAndReturn(func(_ context.Context, msg *stypes.MsgCreateValidator) (*stypes.MsgCreateValidatorResponse, error) {
+			createMsgs = append(createMsgs, msg)
+			return new(stypes.MsgCreateValidatorResponse), nil
+		})
+	sServerMock.EXPECT().Delegate(gomock.Any(), gomock.Any()).AnyTimes().
+		DoAndReturn(func(_ context.Context, msg *stypes.MsgDelegate) (*stypes.MsgDelegateResponse, error) {
+			delegateMsgs = append(delegateMsgs, msg)
+			return new(stypes.MsgDelegateResponse), nil
+		})
+
+	keeper, ctx := setupKeeper(t, 1, nil, sServerMock)
+
+	// Invalid commission rate
+	create := stakingEvent(t, createValidatorWithCommissionEvent, []common.Address{validator}, pubkey, deposit, uint32(101))
+	err := keeper.parseAndDeliver(ctx, create)
+	require.ErrorContains(t, err, "invalid commission rate")
+
+	// Create validator with commission (first validator lookup fails, see setupKeeper stub)
+	create = stakingEvent(t, createValidatorWithCommissionEvent, []common.Address{validator}, pubkey, deposit, uint32(10))
+	require.NoError(t, keeper.parseAndDeliver(ctx, create))
+	require.Len(t, createMsgs, 1)
+	require.True(t, math.LegacyNewDecWithPrec(10, 2).Equal(createMsgs[0].Commission.Rate))
+	require.True(t, math.LegacyOneDec().Equal(createMsgs[0].Commission.MaxRate))
+
+	// Third-party delegation
+	delegate := stakingEvent(t, delegateEvent, []common.Address{delegator, validator}, deposit)
+	require.NoError(t, keeper.parseAndDeliver(ctx, delegate))
+	require.Len(t, delegateMsgs, 1)
+	require.Equal(t, sdk.AccAddress(delegator.Bytes()).String(), delegateMsgs[0].DelegatorAddress)
+	require.Equal(t, sdk.ValAddress(validator.Bytes()).String(), delegateMsgs[0].ValidatorAddress)
+}
+
+func TestLegacyDelivery(t *testing.T) {
+	t.Parallel()
+
+	keeper, ctx := setupKeeper(t, 1, nil, nil)
+
+	// 2_magellan network upgrade not executed.
+	upgradeKeeperMock := testutil.NewMockUpgradeKeeper(gomock.NewController(t))
+	upgradeKeeperMock.EXPECT().GetDoneHeight(gomock.Any(), gomock.Any()).AnyTimes().Return(int64(0), nil)
+	keeper.SetUpgradeKeeper(upgradeKeeperMock)
+
+	legacy := &legacyProcStub{prepare: []etypes.EVMEvent{{Address: []byte{1, 2, 3}}}}
+	keeper.legacyProc = legacy
+
+	// Events are prepared and delivered immediately by the legacy processor.
+	events, err := keeper.Prepare(ctx, common.Hash{})
+	require.NoError(t, err)
+	require.Equal(t, legacy.prepare, events)
+
+	require.NoError(t, keeper.Deliver(ctx, common.Hash{}, events[0]))
+	require.Equal(t, events, legacy.delivered)
+	assertNotContains(t, ctx, keeper, 1)
+
+	// End block is a noop.
+	require.NoError(t, keeper.EndBlock(ctx))
+}
+
+var _ etypes.EvmEventProcessor = (*legacyProcStub)(nil)
+
+// legacyProcStub is a legacy event processor stub that records delivered events.
+type legacyProcStub struct {
+	prepare   []etypes.EVMEvent
+	delivered []etypes.EVMEvent
+}
+
+func (p *legacyProcStub) Prepare(context.Context, common.Hash) ([]etypes.EVMEvent, error) {
+	return p.prepare, nil
+}
+
+func (*legacyProcStub) Name() string {
+	return types.ModuleName
+}
+
+func (*legacyProcStub) Addresses() []common.Address {
+	return nil
+}
+
+func (p *legacyProcStub) Deliver(_ context.Context, _ common.Hash, elog etypes.EVMEvent) error {
+	p.delivered = append(p.delivered, elog)
+	return nil
+}
@@ -0,0 +1,215 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/log"
+
+	"github.com/ethereum/go-ethereum/common"
+	etypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// maxWithdrawalsPerPayload is the maximum number of withdrawals included in an execution payload.
+// It matches the Ethereum consensus spec's MAX_WITHDRAWALS_PER_PAYLOAD.
+const maxWithdrawalsPerPayload = 16
+
+// Withdrawals pay unbonded stake and distribution rewards back to delegators' EVM addresses.
+// Undelegated accounts are tracked until their unbonding completes. Their bonded denom balances
+// are burnt and queued as withdrawals, which are included in subsequent execution payloads.
+//
+// The module store keys don't conflict with ORM table keys which are prefixed by the (small varint) schema file ID.
+var (
+	// withdrawalAccountPrefix is the module store key prefix of accounts to withdraw.
+	withdrawalAccountPrefix = []byte("withdrawal/account/")
+	// withdrawalQueuePrefix is the module store key prefix of queued withdrawals by index.
+	withdrawalQueuePrefix = []byte("withdrawal/queue/")
+	// withdrawalNextIndexKey is the module store key of the next withdrawal index.
+	withdrawalNextIndexKey = []byte("withdrawal/next_index")
+)
+
+// trackWithdrawals tracks the account, so its balance is withdrawn until its unbonding completes.
+func (k Keeper) trackWithdrawals(ctx context.Context, addr sdk.AccAddress) error {
+	if err := k.storeService.OpenKVStore(ctx).Set(withdrawalAccountKey(addr), []byte{1}); err != nil {
+		return errors.Wrap(err, "set withdrawal account")
+	}
+
+	return nil
+}
+
+// queueWithdrawals burns the bonded denom balances of all tracked accounts and queues them as withdrawals.
+// Balances are withdrawn in whole gwei, since execution layer withdrawal amounts are denominated in gwei.
+// Accounts without pending unbondings are no longer tracked.
+func (k Keeper) queueWithdrawals(ctx context.Context) error {
+	store := k.storeService.OpenKVStore(ctx)
+
+	iter, err := store.Iterator(withdrawalAccountPrefix, prefixEnd(withdrawalAccountPrefix))
+	if err != nil {
+		return errors.Wrap(err, "iterator")
+	}
+
+	var accounts []sdk.AccAddress
+	for ; iter.Valid(); iter.Next() {
+		accounts = append(accounts, bytes.Clone(iter.Key()[len(withdrawalAccountPrefix):]))
+	}
+	if err := iter.Close(); err != nil {
+		return errors.Wrap(err, "close iterator")
+	}
+
+	gwei := math.NewInt(params.GWei)
+	for _, addr := range accounts {
+		balance := k.bKeeper.GetBalance(ctx, addr, sdk.DefaultBondDenom)
+		amount := balance.Amount.Quo(gwei)
+		if amount.IsPositive() {
+			if !amount.IsUint64() {
+				return errors.New("withdrawal amount overflow", "amount", balance.Amount)
+			}
+
+			coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, amount.Mul(gwei)))
+			if err := k.bKeeper.SendCoinsFromAccountToModule(ctx, addr, k.Name(), coins); err != nil {
+				return errors.Wrap(err, "send coins")
+			}
+
+			if err := k.bKeeper.BurnCoins(ctx, k.Name(), coins); err != nil {
+				return errors.Wrap(err, "burn coins")
+			}
+
+			if err := k.enqueueWithdrawal(ctx, common.BytesToAddress(addr), amount.Uint64()); err != nil {
+				return err
+			}
+		}
+
+		unbonding, err := k.sKeeper.GetDelegatorUnbonding(ctx, addr)
+		if err != nil {
+			return errors.Wrap(err, "get delegator unbonding")
+		} else if unbonding.IsPositive() {
+			continue // Keep tracking until unbonding completes.
+		}
+
+		if err := store.Delete(withdrawalAccountKey(addr)); err != nil {
+			return errors.Wrap(err, "delete withdrawal account")
+		}
+	}
+
+	return nil
+}
+
+// enqueueWithdrawal queues a withdrawal of the gwei amount to the address.
+func (k Keeper) enqueueWithdrawal(ctx context.Context, addr common.Address, gwei uint64) error {
+	store := k.storeService.OpenKVStore(ctx)
+
+	bz, err := store.Get(withdrawalNextIndexKey)
+	if err != nil {
+		return errors.Wrap(err, "get next index")
+	}
+	var index uint64
+	if bz != nil {
+		index = binary.BigEndian.Uint64(bz)
+	}
+
+	value := binary.BigEndian.AppendUint64(addr.Bytes(), gwei)
+	if err := store.Set(withdrawalQueueKey(index), value); err != nil {
+		return errors.Wrap(err, "set withdrawal")
+	}
+
+	if err := store.Set(withdrawalNextIndexKey, binary.BigEndian.AppendUint64(nil, index+1)); err != nil {
+		return errors.Wrap(err, "set next index")
+	}
+
+	log.Info(ctx, "Queued EVM staking withdrawal", "address", addr.Hex(), "amount_gwei", gwei, "index", index)
+
+	return nil
+}
+
+// PendingWithdrawals returns the oldest queued withdrawals to include in the next execution payload.
+// It implements the evmengine types.WithdrawalProvider interface.
+func (k Keeper) PendingWithdrawals(ctx context.Context) ([]*etypes.Withdrawal, error) {
+	iter, err := k.storeService.OpenKVStore(ctx).Iterator(withdrawalQueuePrefix, prefixEnd(withdrawalQueuePrefix))
+	if err != nil {
+		return nil, errors.Wrap(err, "iterator")
+	}
+	defer iter.Close()
+
+	resp := []*etypes.Withdrawal{} // Withdrawals must be non-nil after Shanghai.
+	for ; iter.Valid() && len(resp) < maxWithdrawalsPerPayload; iter.Next() {
+		withdrawal, err := decodeWithdrawal(iter.Key(), iter.Value())
+		if err != nil {
+			return nil, err
+		}
+
+		resp = append(resp, withdrawal)
+	}
+
+	return resp, nil
+}
+
+// CompleteWithdrawals removes the withdrawals included in a finalized execution payload from the queue.
+// It implements the evmengine types.WithdrawalProvider interface.
+func (k Keeper) CompleteWithdrawals(ctx context.Context, withdrawals []*etypes.Withdrawal) error {
+	store := k.storeService.OpenKVStore(ctx)
+	for _, withdrawal := range withdrawals {
+		key := withdrawalQueueKey(withdrawal.Index)
+		bz, err := store.Get(key)
+		if err != nil {
+			return errors.Wrap(err, "get withdrawal")
+		} else if bz == nil {
+			return errors.New("unknown withdrawal", "index", withdrawal.Index)
+		}
+
+		queued, err := decodeWithdrawal(key, bz)
+		if err != nil {
+			return err
+		} else if *queued != *withdrawal {
+			return errors.New("mismatching withdrawal", "index", withdrawal.Index)
+		}
+
+		if err := store.Delete(key); err != nil {
+			return errors.Wrap(err, "delete withdrawal")
+		}
+	}
+
+	return nil
+}
+
+// decodeWithdrawal returns the withdrawal from its queue key and value.
+// Validator index is always zero, since withdrawals aren't associated with beacon chain validators.
+func decodeWithdrawal(key []byte, value []byte) (*etypes.Withdrawal, error) {
+	if len(key) != len(withdrawalQueuePrefix)+8 {
+		return nil, errors.New("invalid withdrawal key length", "len", len(key))
+	} else if len(value) != common.AddressLength+8 {
+		return nil, errors.New("invalid withdrawal value length", "len", len(value))
+	}
+
+	return &etypes.Withdrawal{
+		Index:   binary.BigEndian.Uint64(key[len(withdrawalQueuePrefix):]),
+		Address: common.BytesToAddress(value[:common.AddressLength]),
+		Amount:  binary.BigEndian.Uint64(value[common.AddressLength:]),
+	}, nil
+}
+
+func withdrawalAccountKey(addr sdk.AccAddress) []byte {
+	return append(bytes.Clone(withdrawalAccountPrefix), addr.Bytes()...)
+}
+
+func withdrawalQueueKey(index uint64) []byte {
+	return binary.BigEndian.AppendUint64(bytes.Clone(withdrawalQueuePrefix), index)
+}
+
+// prefixEnd returns the exclusive end key of all keys with the prefix.
+func prefixEnd(prefix []byte) []byte {
+	end := bytes.Clone(prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		end[i]++
+		if end[i] != 0 {
+			return end[:i+1]
+		}
+	}
+
+	return nil
+}
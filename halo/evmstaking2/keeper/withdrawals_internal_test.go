@@ -0,0 +1,141 @@
+package keeper
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/omni-network/omni/halo/evmstaking2/testutil"
+	"github.com/omni-network/omni/halo/genutil/evm/predeploys"
+	evmenginetypes "github.com/omni-network/omni/octane/evmengine/types"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	etypes "github.com/ethereum/go-ethereum/core/types"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUndelegateAndWithdraw(t *testing.T) {
+	t.Parallel()
+
+	validator := common.HexToAddress("0x1111")
+	delegator := validator // Self delegation
+	gwei := int64(1e9)
+
+	var undelegateMsgs []*stypes.MsgUndelegate
+	var editMsgs []*stypes.MsgEditValidator
+
+	ctrl := gomock.NewController(t)
+	sServerMock := testutil.NewMockStakingMsgServer(ctrl)
+	sServerMock.EXPECT().Undelegate(gomock.Any(), gomock.Any()).AnyTimes().
+		DoAndReturn(func(_ context.Context, msg *stypes.MsgUndelegate) (*stypes.MsgUndelegateResponse, error) {
+			undelegateMsgs = append(undelegateMsgs, msg)
+			return new(stypes.MsgUndelegateResponse), nil
+		})
+	sServerMock.EXPECT().EditValidator(gomock.Any(), gomock.Any()).AnyTimes().
+		DoAndReturn(func(_ context.Context, msg *stypes.MsgEditValidator) (*stypes.MsgEditValidatorResponse, error) {
+			editMsgs = append(editMsgs, msg)
+			return new(stypes.MsgEditValidatorResponse), nil
+		})
+
+	keeper, ctx := setupKeeper(t, 1, nil, sServerMock)
+
+	// First validator lookup fails (see setupKeeper stub)
+	edit := stakingEvent(t, editValidatorEvent, []common.Address{validator}, "moniker", "", "", "", "details", int32(10))
+	err := keeper.parseAndDeliver(ctx, edit)
+	require.ErrorContains(t, err, "validator does not exist")
+
+	// Edit validator
+	require.NoError(t, keeper.parseAndDeliver(ctx, edit))
+	require.Len(t, editMsgs, 1)
+	require.Equal(t, "moniker", editMsgs[0].Description.Moniker)
+	require.Equal(t, stypes.DoNotModifyDesc, editMsgs[0].Description.Identity)
+	require.Equal(t, "details", editMsgs[0].Description.Details)
+	require.True(t, math.LegacyNewDecWithPrec(10, 2).Equal(*editMsgs[0].CommissionRate))
+	require.Nil(t, editMsgs[0].MinSelfDelegation)
+
+	// Invalid commission rate
+	edit = stakingEvent(t, editValidatorEvent, []common.Address{validator}, "", "", "", "", "", int32(101))
+	err = keeper.parseAndDeliver(ctx, edit)
+	require.ErrorContains(t, err, "invalid commission rate")
+
+	// Undelegate
+	undelegate := stakingEvent(t, undelegateEvent, []common.Address{delegator, validator}, big.NewInt(7*gwei))
+	require.NoError(t, keeper.parseAndDeliver(ctx, undelegate))
+	require.Len(t, undelegateMsgs, 1)
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 7*gwei), undelegateMsgs[0].Amount)
+
+	// First sweep withdraws whole gwei of unbonded balance, second sweep leaves dust after unbonding completed.
+	balances := []int64{3*gwei + 5, 5}
+	var sweeps int
+	bKeeperMock := keeper.bKeeper.(*testutil.MockBankKeeper)
+	bKeeperMock.EXPECT().GetBalance(gomock.Any(), sdk.AccAddress(delegator.Bytes()), sdk.DefaultBondDenom).Times(2).
+		DoAndReturn(func(context.Context, sdk.AccAddress, string) sdk.Coin {
+			sweeps++
+			return sdk.NewInt64Coin(sdk.DefaultBondDenom, balances[sweeps-1])
+		})
+	withdrawn := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 3*gwei))
+	bKeeperMock.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), sdk.AccAddress(delegator.Bytes()), keeper.Name(), withdrawn).Times(1)
+	bKeeperMock.EXPECT().BurnCoins(gomock.Any(), keeper.Name(), withdrawn).Times(1)
+
+	sKeeperMock := keeper.sKeeper.(*testutil.MockStakingKeeper)
+	sKeeperMock.EXPECT().GetDelegatorUnbonding(gomock.Any(), sdk.AccAddress(delegator.Bytes())).Times(2).
+		DoAndReturn(func(context.Context, sdk.AccAddress) (math.Int, error) {
+			if sweeps == 1 {
+				return math.NewInt(4 * gwei), nil // Still unbonding
+			}
+
+			return math.ZeroInt(), nil
+		})
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, keeper.EndBlock(ctx))
+	}
+	require.Equal(t, 2, sweeps) // Not swept after unbonding completed
+
+	expected := &etypes.Withdrawal{Index: 0, Address: delegator, Amount: 3}
+	pending, err := keeper.PendingWithdrawals(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []*etypes.Withdrawal{expected}, pending)
+
+	// Completing unknown or mismatching withdrawals fails
+	err = keeper.CompleteWithdrawals(ctx, []*etypes.Withdrawal{{Index: 1, Address: delegator, Amount: 3}})
+	require.ErrorContains(t, err, "unknown withdrawal")
+	err = keeper.CompleteWithdrawals(ctx, []*etypes.Withdrawal{{Index: 0, Address: delegator, Amount: 4}})
+	require.ErrorContains(t, err, "mismatching withdrawal")
+
+	require.NoError(t, keeper.CompleteWithdrawals(ctx, pending))
+	pending, err = keeper.PendingWithdrawals(ctx)
+	require.NoError(t, err)
+	require.Empty(t, pending)
+
+	// Next withdrawal index is incremented
+	require.NoError(t, keeper.enqueueWithdrawal(ctx, delegator, 1))
+	pending, err = keeper.PendingWithdrawals(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []*etypes.Withdrawal{{Index: 1, Address: delegator, Amount: 1}}, pending)
+}
+
+// stakingEvent returns a staking contract EVM event with the indexed address topics and non-indexed args.
+func stakingEvent(t *testing.T, event abi.Event, indexed []common.Address, args ...any) *evmenginetypes.EVMEvent {
+	t.Helper()
+
+	data, err := event.Inputs.NonIndexed().Pack(args...)
+	require.NoError(t, err)
+
+	topics := [][]byte{event.ID.Bytes()}
+	for _, addr := range indexed {
+		topics = append(topics, common.BytesToHash(addr.Bytes()).Bytes())
+	}
+
+	return &evmenginetypes.EVMEvent{
+		Address: common.HexToAddress(predeploys.Staking).Bytes(),
+		Topics:  topics,
+		Data:    data,
+	}
+}
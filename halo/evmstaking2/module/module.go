@@ -3,9 +3,11 @@ package module
 import (
 	"context"
 
+	"github.com/omni-network/omni/halo/evmstaking"
 	"github.com/omni-network/omni/halo/evmstaking2/keeper"
 	"github.com/omni-network/omni/halo/evmstaking2/types"
 	"github.com/omni-network/omni/lib/ethclient"
+	evmenginetypes "github.com/omni-network/omni/octane/evmengine/types"
 
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/store"
@@ -117,6 +119,7 @@ type ModuleInputs struct {
 	AKeeper      types.AuthKeeper
 	BKeeper      types.BankKeeper
 	SKeeper      *stakingkeeper.Keeper
+	LegacyProc   evmstaking.EventProcessor
 	Cdc          codec.Codec
 	Config       *Module
 }
@@ -124,8 +127,9 @@ type ModuleInputs struct {
 type ModuleOutputs struct {
 	depinject.Out
 
-	Keeper *keeper.Keeper
-	Module appmodule.AppModule
+	Keeper            *keeper.Keeper
+	Module            appmodule.AppModule
+	InjectedEventProc evmenginetypes.InjectedEventProc
 }
 
 func ProvideModule(in ModuleInputs) (ModuleOutputs, error) {
@@ -136,6 +140,7 @@ func ProvideModule(in ModuleInputs) (ModuleOutputs, error) {
 		in.BKeeper,
 		in.SKeeper,
 		stakingkeeper.NewMsgServerImpl(in.SKeeper),
+		in.LegacyProc,
 		in.Config.GetDeliverInterval(),
 	)
 	if err != nil {
@@ -148,7 +153,8 @@ func ProvideModule(in ModuleInputs) (ModuleOutputs, error) {
 	)
 
 	return ModuleOutputs{
-		Keeper: k,
-		Module: m,
+		Keeper:            k,
+		Module:            m,
+		InjectedEventProc: evmenginetypes.InjectEventProc(k),
 	}, nil
 }
@@ -4,6 +4,7 @@ package types
 import (
 	"context"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -15,15 +16,27 @@ type AuthKeeper interface {
 }
 
 type BankKeeper interface {
+	BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
+	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
 	MintCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 }
 
 type StakingKeeper interface {
 	GetValidator(ctx context.Context, addr sdk.ValAddress) (stypes.Validator, error)
+	GetDelegatorUnbonding(ctx context.Context, delegator sdk.AccAddress) (math.Int, error)
 }
 
 type StakingMsgServer interface {
 	CreateValidator(ctx context.Context, msg *stypes.MsgCreateValidator) (*stypes.MsgCreateValidatorResponse, error)
 	Delegate(ctx context.Context, msg *stypes.MsgDelegate) (*stypes.MsgDelegateResponse, error)
+	EditValidator(ctx context.Context, msg *stypes.MsgEditValidator) (*stypes.MsgEditValidatorResponse, error)
+	Undelegate(ctx context.Context, msg *stypes.MsgUndelegate) (*stypes.MsgUndelegateResponse, error)
+}
+
+// UpgradeKeeper abstracts the x/upgrade keeper methods used to gate logic behind network upgrades.
+type UpgradeKeeper interface {
+	// GetDoneHeight returns the height at which the given upgrade was executed, or zero if not executed.
+	GetDoneHeight(ctx context.Context, name string) (int64, error)
 }
@@ -0,0 +1,71 @@
+// Package ormgenesis exports and imports cosmos ORM module state as genesis JSON.
+//
+// The genesis JSON of a module is an object with a field per ORM table (keyed by table message name)
+// containing an array of the table's rows, as produced by ormdb.ModuleDB's genesis handler.
+package ormgenesis
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/omni-network/omni/lib/errors"
+
+	"cosmossdk.io/core/genesis"
+	"cosmossdk.io/orm/model/ormdb"
+)
+
+// Export returns the state of all tables of the module DB as genesis JSON.
+func Export(ctx context.Context, db ormdb.ModuleDB) (json.RawMessage, error) {
+	var target genesis.RawJSONTarget
+	if err := db.GenesisHandler().ExportGenesis(ctx, target.Target()); err != nil {
+		return nil, errors.Wrap(err, "export orm genesis")
+	}
+
+	resp, err := target.JSON()
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal orm genesis")
+	}
+
+	return resp, nil
+}
+
+// Import imports the state of all tables of the module DB from the genesis JSON as returned by Export.
+// It returns false if the genesis JSON doesn't contain any tables, e.g. if it is empty or a default genesis.
+func Import(ctx context.Context, db ormdb.ModuleDB, raw json.RawMessage) (bool, error) {
+	if ok, err := hasTables(raw); err != nil {
+		return false, err
+	} else if !ok {
+		return false, nil
+	}
+
+	source, err := genesis.SourceFromRawJSON(raw)
+	if err != nil {
+		return false, errors.Wrap(err, "orm genesis source")
+	}
+
+	if err := db.GenesisHandler().InitGenesis(ctx, source); err != nil {
+		return false, errors.Wrap(err, "import orm genesis")
+	}
+
+	return true, nil
+}
+
+// Validate returns an error if the genesis JSON isn't empty or a JSON object of table rows.
+func Validate(raw json.RawMessage) error {
+	_, err := hasTables(raw)
+	return err
+}
+
+// hasTables returns true if the genesis JSON contains any tables.
+func hasTables(raw json.RawMessage) (bool, error) {
+	if len(raw) == 0 {
+		return false, nil
+	}
+
+	var tables map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &tables); err != nil {
+		return false, errors.Wrap(err, "unmarshal orm genesis")
+	}
+
+	return len(tables) > 0, nil
+}
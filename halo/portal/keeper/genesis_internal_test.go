@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/omni-network/omni/halo/portal/types"
+	"github.com/omni-network/omni/lib/xchain"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesisExportImport(t *testing.T) {
+	t.Parallel()
+
+	exporter, exportCtx := SetupKeeper(t)
+
+	for i := uint64(1); i <= 3; i++ {
+		exportCtx = exportCtx.WithBlockHeight(int64(i))
+		_, err := exporter.EmitMsg(exportCtx, types.MsgTypeValSet, i, xchain.BroadcastChainID, xchain.ShardBroadcast0)
+		require.NoError(t, err)
+	}
+
+	exported, err := exporter.ExportGenesis(exportCtx)
+	require.NoError(t, err)
+
+	importer, importCtx := SetupKeeper(t)
+
+	// Default genesis is a noop
+	require.NoError(t, importer.InitGenesis(importCtx, json.RawMessage("{}")))
+	require.NoError(t, importer.InitGenesis(importCtx, nil))
+
+	require.NoError(t, importer.InitGenesis(importCtx, exported))
+
+	reexported, err := importer.ExportGenesis(importCtx)
+	require.NoError(t, err)
+	require.JSONEq(t, string(exported), string(reexported))
+
+	for i := uint64(1); i <= 3; i++ {
+		block, msgs, err := importer.getBlockAndMsgs(importCtx, i)
+		require.NoError(t, err)
+		require.Equal(t, i, block.GetCreatedHeight())
+		require.Len(t, msgs, 1)
+		require.Equal(t, i, msgs[0].GetStreamOffset())
+	}
+
+	// Emitting continues from the imported offsets and IDs.
+	importCtx = importCtx.WithBlockHeight(4)
+	blockID, err := importer.EmitMsg(importCtx, types.MsgTypeValSet, 4, xchain.BroadcastChainID, xchain.ShardBroadcast0)
+	require.NoError(t, err)
+	require.EqualValues(t, 4, blockID)
+
+	_, msgs, err := importer.getBlockAndMsgs(importCtx, blockID)
+	require.NoError(t, err)
+	require.Len(t, msgs, 1)
+	require.EqualValues(t, 4, msgs[0].GetStreamOffset())
+}
@@ -0,0 +1,20 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/omni-network/omni/halo/genutil/ormgenesis"
+)
+
+// ExportGenesis returns all network registry epochs as genesis JSON.
+func (k Keeper) ExportGenesis(ctx context.Context) (json.RawMessage, error) {
+	return ormgenesis.Export(ctx, k.db)
+}
+
+// InitGenesis imports the network registry epochs from genesis JSON as returned by ExportGenesis.
+// Empty or default genesis JSON is a noop.
+func (k Keeper) InitGenesis(ctx context.Context, raw json.RawMessage) error {
+	_, err := ormgenesis.Import(ctx, k.db, raw)
+	return err
+}
@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/omni-network/omni/lib/tutil"
+	"github.com/omni-network/omni/lib/xchain"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesisExportImport(t *testing.T) {
+	t.Parallel()
+
+	newPortal := func(chainID uint64) *Portal {
+		return &Portal{
+			ChainId:      chainID,
+			Address:      tutil.RandomAddress().Bytes(),
+			DeployHeight: chainID,
+			ShardIds:     []uint64{uint64(xchain.ShardFinalized0)},
+			Name:         "test",
+		}
+	}
+
+	exportCtx, exporter, _ := setupKeeper(t)
+
+	p1, p2 := newPortal(100), newPortal(200)
+	require.NoError(t, exporter.addPortal(exportCtx, p1))
+	exportCtx = exportCtx.WithBlockHeight(2)
+	require.NoError(t, exporter.addPortal(exportCtx, p2))
+
+	exported, err := exporter.ExportGenesis(exportCtx)
+	require.NoError(t, err)
+
+	importCtx, importer, emitPortal := setupKeeper(t)
+
+	// Default genesis is a noop
+	require.NoError(t, importer.InitGenesis(importCtx, json.RawMessage("{}")))
+	require.NoError(t, importer.InitGenesis(importCtx, nil))
+
+	require.NoError(t, importer.InitGenesis(importCtx, exported))
+
+	reexported, err := importer.ExportGenesis(importCtx)
+	require.NoError(t, err)
+	require.JSONEq(t, string(exported), string(reexported))
+
+	portals, err := importer.getLatestPortals(importCtx)
+	require.NoError(t, err)
+	require.Empty(t, cmp.Diff([]*Portal{p1, p2}, portals, cmpopts.IgnoreUnexported(Portal{})))
+
+	ok, err := importer.SupportedChain(importCtx, p2.GetChainId())
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// Adding portals continues from the imported networks.
+	importCtx = importCtx.WithBlockHeight(3)
+	require.NoError(t, importer.addPortal(importCtx, newPortal(300)))
+	require.EqualValues(t, []uint64{3}, emitPortal.emittedIDs)
+}
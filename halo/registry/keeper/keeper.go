@@ -20,6 +20,7 @@ import (
 )
 
 type Keeper struct {
+	db              ormdb.ModuleDB
 	emitPortal      ptypes.EmitPortal
 	networkTable    NetworkTable
 	ethCl           ethclient.Client
@@ -57,6 +58,7 @@ func NewKeeper(
 	}
 
 	return Keeper{
+		db:              modDB,
 		emitPortal:      emitPortal,
 		networkTable:    registryStore.NetworkTable(),
 		ethCl:           ethCl,
@@ -0,0 +1,20 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/omni-network/omni/halo/genutil/ormgenesis"
+)
+
+// ExportGenesis returns all validator sets as genesis JSON.
+func (k *Keeper) ExportGenesis(ctx context.Context) (json.RawMessage, error) {
+	return ormgenesis.Export(ctx, k.db)
+}
+
+// ImportGenesis imports the validator sets from genesis JSON as returned by ExportGenesis.
+// It returns false if the genesis JSON doesn't contain exported validator sets,
+// in which case the genesis set should be inserted via InsertGenesisSet.
+func (k *Keeper) ImportGenesis(ctx context.Context, raw json.RawMessage) (bool, error) {
+	return ormgenesis.Import(ctx, k.db, raw)
+}
@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"encoding/json"
+	"testing"
+
+	k1 "github.com/cometbft/cometbft/crypto/secp256k1"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesisExportImport(t *testing.T) {
+	t.Parallel()
+
+	exporter, exportCtx := setupKeeper(t, defaultExpectation())
+
+	newVals := func() []*Validator {
+		return []*Validator{
+			{PubKey: k1.GenPrivKey().PubKey().Bytes(), Power: 1},
+			{PubKey: k1.GenPrivKey().PubKey().Bytes(), Power: 2},
+		}
+	}
+
+	_, err := exporter.insertValidatorSet(exportCtx, newVals(), true)
+	require.NoError(t, err)
+
+	exportCtx = exportCtx.WithBlockHeight(2)
+	_, err = exporter.insertValidatorSet(exportCtx, newVals(), false)
+	require.NoError(t, err)
+
+	exported, err := exporter.ExportGenesis(exportCtx)
+	require.NoError(t, err)
+
+	importer, importCtx := setupKeeper(t, defaultExpectation())
+
+	// Default genesis is a noop, requiring the genesis set to be inserted.
+	ok, err := importer.ImportGenesis(importCtx, json.RawMessage("{}"))
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	ok, err = importer.ImportGenesis(importCtx, exported)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	reexported, err := importer.ExportGenesis(importCtx)
+	require.NoError(t, err)
+	require.JSONEq(t, string(exported), string(reexported))
+
+	// The imported unattested set is still pending attestation.
+	valset, ok, err := importer.nextUnattestedSet(importCtx)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.EqualValues(t, 2, valset.GetId())
+
+	// Inserting continues from the imported IDs.
+	importCtx = importCtx.WithBlockHeight(3)
+	id, err := importer.insertValidatorSet(importCtx, newVals(), false)
+	require.NoError(t, err)
+	require.EqualValues(t, 3, id)
+}
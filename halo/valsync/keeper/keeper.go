@@ -33,6 +33,7 @@ import (
 const cometValidatorActiveDelay = 2
 
 type Keeper struct {
+	db                ormdb.ModuleDB
 	sKeeper           types.StakingKeeper
 	aKeeper           atypes.AttestKeeper
 	valsetTable       ValidatorSetTable
@@ -75,6 +76,7 @@ func NewKeeper(
 	}
 
 	return &Keeper{
+		db:              modDB,
 		valsetTable:     valSyncStore.ValidatorSetTable(),
 		valTable:        valSyncStore.ValidatorTable(),
 		sKeeper:         sKeeper,
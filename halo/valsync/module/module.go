@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 
 	atypes "github.com/omni-network/omni/halo/attest/types"
+	"github.com/omni-network/omni/halo/genutil/ormgenesis"
 	ptypes "github.com/omni-network/omni/halo/portal/types"
 	"github.com/omni-network/omni/halo/valsync/keeper"
 	"github.com/omni-network/omni/halo/valsync/types"
@@ -83,14 +84,27 @@ func (m AppModule) EndBlock(ctx context.Context) ([]abci.ValidatorUpdate, error)
 	return m.keeper.EndBlock(ctx)
 }
 
-func (m AppModule) InitGenesis(ctx sdk.Context, _ codec.JSONCodec, _ json.RawMessage) {
+// InitGenesis imports the exported validator sets if present in the genesis state,
+// otherwise it inserts the genesis validator set from the staking module.
+func (m AppModule) InitGenesis(ctx sdk.Context, _ codec.JSONCodec, raw json.RawMessage) {
+	if imported, err := m.keeper.ImportGenesis(ctx, raw); err != nil {
+		panic(errors.Wrap(err, "import valsync genesis"))
+	} else if imported {
+		return
+	}
+
 	if err := m.keeper.InsertGenesisSet(ctx); err != nil {
 		panic(errors.Wrap(err, "insert genesis valset"))
 	}
 }
 
-func (AppModule) ExportGenesis(sdk.Context, codec.JSONCodec) json.RawMessage {
-	return nil
+func (m AppModule) ExportGenesis(ctx sdk.Context, _ codec.JSONCodec) json.RawMessage {
+	resp, err := m.keeper.ExportGenesis(ctx)
+	if err != nil {
+		panic(errors.Wrap(err, "export valsync genesis"))
+	}
+
+	return resp
 }
 
 // DefaultGenesis returns default genesis state as raw bytes for the bank
@@ -100,13 +114,9 @@ func (AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
 }
 
 // ValidateGenesis performs genesis state validation for the bank module.
-func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, _ client.TxEncodingConfig, bz json.RawMessage) error {
-	var data types.GenesisState
-	if err := cdc.UnmarshalJSON(bz, &data); err != nil {
-		return errors.Wrap(err, "unmarshal genesis state")
-	}
-
-	return nil
+// Note the genesis state is either the empty default or exported validator sets.
+func (AppModuleBasic) ValidateGenesis(_ codec.JSONCodec, _ client.TxEncodingConfig, bz json.RawMessage) error {
+	return ormgenesis.Validate(bz)
 }
 
 func NewAppModule(
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"context"
+
+	atypes "github.com/omni-network/omni/halo/attest/types"
+	"github.com/omni-network/omni/lib/log"
+	"github.com/omni-network/omni/lib/tracer"
+	"github.com/omni-network/omni/lib/xchain"
+
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+)
+
+// WithArchive returns an option that fetches attestations from the attestation archive
+// service connected to via the provided gRPC client connection.
+//
+// Attestations not yet archived, or if the archive is unavailable, are fetched from the
+// consensus chain as before. This avoids expensive historical consensus chain queries
+// which fail once its state is pruned.
+func WithArchive(cc gogogrpc.ClientConn) func(*Provider) {
+	return func(p *Provider) {
+		p.fetch = newArchiveFetchFunc(atypes.NewQueryClient(cc), p.fetch, p.chainNamer)
+	}
+}
+
+// newArchiveFetchFunc returns a fetch function that fetches attestations from the archive,
+// falling back to the provided fetch function if none are found.
+func newArchiveFetchFunc(archiveCl atypes.QueryClient, fallback fetchFunc, chainNamer func(xchain.ChainVersion) string) fetchFunc {
+	return func(ctx context.Context, chainVer xchain.ChainVersion, fromOffset uint64, cursor uint64) ([]xchain.Attestation, uint64, error) {
+		const endpoint = "archive_attestations"
+		defer latency(endpoint)()
+
+		archiveCtx, span := tracer.Start(ctx, spanName(endpoint))
+		atts, ok, err := attsFromAtHeight(archiveCtx, archiveCl, chainVer, fromOffset, 0)
+		span.End()
+		if err != nil {
+			incQueryErr(endpoint)
+			log.Warn(ctx, "Fetching attestations from archive failed (will use consensus chain)", err,
+				"chain", chainNamer(chainVer),
+				"offset", fromOffset,
+			)
+		} else if ok {
+			return atts, cursor, nil
+		}
+
+		return fallback(ctx, chainVer, fromOffset, cursor)
+	}
+}
@@ -41,6 +41,7 @@ type payloadArgs struct {
 //nolint:gochecknoglobals // This is a static mapping.
 var (
 	delegateEvent        = mustGetABI(bindings.StakingMetaData).Events["Delegate"]
+	undelegateEvent      = mustGetABI(bindings.StakingMetaData).Events["Undelegate"]
 	portalRegEvent       = mustGetABI(bindings.PortalRegistryMetaData).Events["PortalRegistered"]
 	planUpgradeEvent     = mustGetABI(bindings.UpgradeMetaData).Events["PlanUpgrade"]
 	createValidatorEvent = mustGetABI(bindings.StakingMetaData).Events["CreateValidator"]
@@ -125,6 +126,39 @@ func WithMockSelfDelegation(pubkey crypto.PubKey, ether int64) func(*engineMock)
 	}
 }
 
+// WithMockUndelegation returns an option to add a self-undelegation Undelegate event to the mock.
+func WithMockUndelegation(pubkey crypto.PubKey, ether int64) func(*engineMock) {
+	return func(mock *engineMock) {
+		mock.mu.Lock()
+		defer mock.mu.Unlock()
+
+		wei := new(big.Int).Mul(big.NewInt(ether), big.NewInt(params.Ether))
+
+		valAddr, err := k1util.PubKeyToAddress(pubkey)
+		if err != nil {
+			panic(errors.Wrap(err, "pubkey to address"))
+		}
+
+		data, err := undelegateEvent.Inputs.NonIndexed().Pack(wei)
+		if err != nil {
+			panic(errors.Wrap(err, "pack undelegate"))
+		}
+
+		contractAddr := common.HexToAddress(predeploys.Staking)
+		eventLog := types.Log{
+			Address: contractAddr,
+			Topics: []common.Hash{
+				undelegateEvent.ID,
+				common.HexToHash(valAddr.Hex()), // delegator
+				common.HexToHash(valAddr.Hex()), // validator
+			},
+			Data: data,
+		}
+
+		mock.pendingLogs[contractAddr] = append(mock.pendingLogs[contractAddr], eventLog)
+	}
+}
+
 func WithPortalRegister(network netconf.Network) func(*engineMock) {
 	return func(mock *engineMock) {
 		mock.mu.Lock()
@@ -219,7 +253,7 @@ func MockGenesisBlock() (*types.Block, error) {
 		fuzzer           = NewFuzzer(timestamp)
 	)
 
-	genesisPayload, err := makePayload(fuzzer, height, uint64(timestamp), parentHash, common.Address{}, parentHash, &parentBeaconRoot)
+	genesisPayload, err := makePayload(fuzzer, height, uint64(timestamp), parentHash, common.Address{}, parentHash, &parentBeaconRoot, nil)
 	if err != nil {
 		return nil, errors.Wrap(err, "make next payload")
 	}
@@ -460,7 +494,7 @@ func (m *engineMock) ForkchoiceUpdatedV3(ctx context.Context, update engine.Fork
 	// If we have payload attributes, make a new payload
 	if attrs != nil {
 		payload, err := makePayload(m.fuzzer, m.head.NumberU64()+1,
-			attrs.Timestamp, update.HeadBlockHash, attrs.SuggestedFeeRecipient, attrs.Random, attrs.BeaconRoot)
+			attrs.Timestamp, update.HeadBlockHash, attrs.SuggestedFeeRecipient, attrs.Random, attrs.BeaconRoot, attrs.Withdrawals)
 		if err != nil {
 			return engine.ForkChoiceResponse{}, err
 		}
@@ -519,7 +553,8 @@ func (*engineMock) GetPayloadV2(context.Context, engine.PayloadID) (*engine.Exec
 
 // makePayload returns a new fuzzed payload using head as parent if provided.
 func makePayload(fuzzer *fuzz.Fuzzer, height uint64, timestamp uint64, parentHash common.Hash,
-	feeRecipient common.Address, randao common.Hash, beaconRoot *common.Hash) (engine.ExecutableData, error) {
+	feeRecipient common.Address, randao common.Hash, beaconRoot *common.Hash, withdrawals []*types.Withdrawal,
+) (engine.ExecutableData, error) {
 	// Build a new header
 	var header types.Header
 	fuzzer.Fuzz(&header)
@@ -530,8 +565,12 @@ func makePayload(fuzzer *fuzz.Fuzzer, height uint64, timestamp uint64, parentHas
 	header.Coinbase = feeRecipient // this corresponds to SuggestedFeeRecipient field in PayloadAttributes
 	header.ParentBeaconRoot = beaconRoot
 
-	// Convert header to block
-	block := types.NewBlock(&header, nil, nil, trie.NewStackTrie(nil))
+	// Convert header to block, only including withdrawals if any.
+	var body *types.Body
+	if len(withdrawals) > 0 {
+		body = &types.Body{Withdrawals: withdrawals}
+	}
+	block := types.NewBlock(&header, body, nil, trie.NewStackTrie(nil))
 
 	// Convert block to payload
 	env := engine.BlockToExecutableData(block, big.NewInt(0), nil, nil)
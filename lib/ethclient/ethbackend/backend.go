@@ -28,8 +28,8 @@ import (
 
 type account struct {
 	from       common.Address
-	privateKey *ecdsa.PrivateKey // Either local private key is set,
-	fireCl     fireblocks.Client // or, Fireblocks is used
+	privateKey *ecdsa.PrivateKey    // Either local private key is set,
+	external   txmgr.ExternalSigner // or, an external signer (e.g. Fireblocks) is used
 	txMgr      txmgr.TxManager
 }
 
@@ -52,15 +52,15 @@ func NewFireBackend(ctx context.Context, chainName string, chainID uint64, block
 
 	accounts := make(map[common.Address]account)
 	for addr := range accs {
-		txMgr, err := newFireblocksTxMgr(ethCl, chainName, chainID, blockPeriod, addr, fireCl)
+		txMgr, err := newExternalTxMgr(ethCl, chainName, chainID, blockPeriod, addr, fireCl.Sign)
 		if err != nil {
 			return nil, errors.Wrap(err, "new txmgr")
 		}
 
 		accounts[addr] = account{
-			from:   addr,
-			fireCl: fireCl,
-			txMgr:  txMgr,
+			from:     addr,
+			external: fireCl.Sign,
+			txMgr:    txMgr,
 		}
 	}
 
@@ -73,6 +73,28 @@ func NewFireBackend(ctx context.Context, chainName string, chainID uint64, block
 	}, nil
 }
 
+// NewExternalBackend returns a backend with a single account signed by the external signer (e.g. a remote signer).
+func NewExternalBackend(chainName string, chainID uint64, blockPeriod time.Duration, ethCl ethclient.Client, from common.Address, external txmgr.ExternalSigner) (*Backend, error) {
+	txMgr, err := newExternalTxMgr(ethCl, chainName, chainID, blockPeriod, from, external)
+	if err != nil {
+		return nil, errors.Wrap(err, "new txmgr")
+	}
+
+	return &Backend{
+		Client: ethCl,
+		accounts: map[common.Address]account{
+			from: {
+				from:     from,
+				external: external,
+				txMgr:    txMgr,
+			},
+		},
+		chainName:   chainName,
+		chainID:     chainID,
+		blockPeriod: blockPeriod,
+	}, nil
+}
+
 // NewDevBackend returns a backend with all pre-funded anvil dev accounts.
 func NewDevBackend(chainName string, chainID uint64, blockPeriod time.Duration, ethCl ethclient.Client) (*Backend, error) {
 	return NewBackend(chainName, chainID, blockPeriod, ethCl, append(eoa.DevPrivateKeys(), anvil.DevPrivateKeys()...)...)
@@ -105,7 +127,7 @@ func NewBackend(chainName string, chainID uint64, blockPeriod time.Duration, eth
 }
 
 // AddAccount adds a in-memory private key account to the backend.
-// Note this can be called even if other accounts are fireblocks based.
+// Note this can be called even if other accounts are fireblocks or externally signed.
 func (b *Backend) AddAccount(privkey *ecdsa.PrivateKey) (common.Address, error) {
 	txMgr, err := newTxMgr(b.Client, b.chainName, b.chainID, b.blockPeriod, privkey)
 	if err != nil {
@@ -132,7 +154,7 @@ func (b *Backend) Sign(ctx context.Context, from common.Address, input [32]byte)
 	if !ok {
 		return [65]byte{}, errors.New("unknown from address", "from", from)
 	} else if acc.privateKey == nil {
-		return acc.fireCl.Sign(ctx, input, from)
+		return acc.external(ctx, input, from)
 	}
 
 	pk := k1.PrivKey(crypto.FromECDSA(acc.privateKey))
@@ -144,6 +166,8 @@ func (b *Backend) PublicKey(from common.Address) (*ecdsa.PublicKey, error) {
 	acc, ok := b.accounts[from]
 	if !ok {
 		return nil, errors.New("unknown from address", "from", from)
+	} else if acc.privateKey == nil {
+		return nil, errors.New("public key not available for externally signed account", "from", from)
 	}
 
 	return &acc.privateKey.PublicKey, nil
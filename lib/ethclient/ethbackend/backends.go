@@ -107,6 +107,32 @@ func BackendsFromNetwork(network netconf.Network, endpoints xchain.RPCEndpoints,
 	}, nil
 }
 
+// BackendsFromNetworkWithSigner returns backends for all EVM chains in the network with a single account
+// signed by the external signer (e.g. Fireblocks or a remote signer).
+func BackendsFromNetworkWithSigner(network netconf.Network, endpoints xchain.RPCEndpoints, from common.Address, external txmgr.ExternalSigner) (Backends, error) {
+	inner := make(map[uint64]*Backend)
+	for _, chain := range network.EVMChains() {
+		endpoint, err := endpoints.ByNameOrID(chain.Name, chain.ID)
+		if err != nil {
+			return Backends{}, err
+		}
+
+		ethCl, err := ethclient.Dial(chain.Name, endpoint)
+		if err != nil {
+			return Backends{}, errors.Wrap(err, "dial")
+		}
+
+		inner[chain.ID], err = NewExternalBackend(chain.Name, chain.ID, chain.BlockPeriod, ethCl, from, external)
+		if err != nil {
+			return Backends{}, errors.Wrap(err, "new backend")
+		}
+	}
+
+	return Backends{
+		backends: inner,
+	}, nil
+}
+
 // NewBackends returns a multi-backends backed by in-memory keys that supports configured all chains.
 func NewBackends(ctx context.Context, testnet types.Testnet, deployKeyFile string) (Backends, error) {
 	var err error
@@ -231,7 +257,7 @@ func (b Backends) RPCClients() map[uint64]ethclient.Client {
 	return clients
 }
 
-func newFireblocksTxMgr(ethCl ethclient.Client, chainName string, chainID uint64, blockPeriod time.Duration, from common.Address, fireCl fireblocks.Client) (txmgr.TxManager, error) {
+func newExternalTxMgr(ethCl ethclient.Client, chainName string, chainID uint64, blockPeriod time.Duration, from common.Address, external txmgr.ExternalSigner) (txmgr.TxManager, error) {
 	// creates our new CLI config for our tx manager
 	defaults := txmgr.DefaultSenderFlagValues
 	defaults.NetworkTimeout = time.Minute * 5
@@ -242,7 +268,7 @@ func newFireblocksTxMgr(ethCl ethclient.Client, chainName string, chainID uint64
 	)
 
 	// get the config for our tx manager
-	cfg, err := txmgr.NewConfigWithSigner(cliConfig, fireCl.Sign, from, ethCl)
+	cfg, err := txmgr.NewConfigWithSigner(cliConfig, external, from, ethCl)
 	if err != nil {
 		return nil, errors.Wrap(err, "new config")
 	}
@@ -0,0 +1,517 @@
+package ethclient
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/omni-network/omni/lib/errors"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+var _ Client = (*MultiClient)(nil)
+
+const (
+	defaultMultiCooldown = time.Second * 30
+	defaultMultiMaxLag   = 32
+)
+
+// errNoQuorum indicates that not enough clients agree on a block hash.
+// It doesn't indicate that the client returning the block is unavailable.
+var errNoQuorum = errors.NewSentinel("rpc quorum not reached")
+
+// MultiClient implements Client by wrapping multiple RPC clients (endpoints) of the same chain.
+//
+// It load-balances read calls across healthy clients (round-robin), failing over to the next client on errors.
+// Calls depending on (or modifying) a node's mempool or pending state, e.g. SendTransaction, PendingNonceAt and EstimateGas,
+// are pinned to a sticky primary client, only failing over to another client (which becomes the primary) if it is unavailable.
+// A client is unhealthy for a cooldown period after a connection error, or after its latest head
+// lagged the highest observed head by more than the max lag. Unhealthy clients are only used as last resort.
+//
+// If a quorum is configured, headers and blocks by number or type are cross-checked:
+// at least quorum clients must agree on the block hash at the returned height.
+// Since other clients may not have the latest block yet, latest headers step back
+// to the highest height (within the max lag) known by a quorum of clients.
+type MultiClient struct {
+	chain    string
+	clients  []Client
+	quorum   int
+	maxLag   uint64
+	cooldown time.Duration
+
+	mu        sync.Mutex
+	next      int         // Next round-robin client index
+	primary   int         // Sticky primary client index
+	unhealthy []time.Time // Unhealthy since per client
+	heads     []uint64    // Latest observed head per client
+}
+
+// MultiOption configures a MultiClient.
+type MultiOption func(*MultiClient)
+
+// WithQuorum returns an option that requires quorum clients to agree on block hashes.
+// A quorum of zero or one disables cross-checking.
+func WithQuorum(quorum int) MultiOption {
+	return func(m *MultiClient) {
+		m.quorum = quorum
+	}
+}
+
+// WithMaxLag returns an option that configures the maximum number of blocks a client's head
+// may lag the highest observed head before it is considered unhealthy.
+func WithMaxLag(blocks uint64) MultiOption {
+	return func(m *MultiClient) {
+		m.maxLag = blocks
+	}
+}
+
+// WithCooldown returns an option that configures the period clients are considered unhealthy.
+func WithCooldown(cooldown time.Duration) MultiOption {
+	return func(m *MultiClient) {
+		m.cooldown = cooldown
+	}
+}
+
+// NewMultiClient returns a new MultiClient wrapping the provided clients of the same chain.
+func NewMultiClient(chain string, clients []Client, opts ...MultiOption) (*MultiClient, error) {
+	m := &MultiClient{
+		chain:     chain,
+		clients:   clients,
+		maxLag:    defaultMultiMaxLag,
+		cooldown:  defaultMultiCooldown,
+		unhealthy: make([]time.Time, len(clients)),
+		heads:     make([]uint64, len(clients)),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	if len(clients) == 0 {
+		return nil, errors.New("no clients", "chain", chain)
+	} else if m.quorum > len(clients) {
+		return nil, errors.New("quorum exceeds clients", "chain", chain, "quorum", m.quorum, "clients", len(clients))
+	}
+
+	return m, nil
+}
+
+// DialMulti connects a client to the given URLs. It returns a normal client if only a single URL is provided
+// without a quorum, otherwise a MultiClient.
+func DialMulti(chainName string, urls []string, opts ...MultiOption) (Client, error) {
+	var clients []Client
+	for _, url := range urls {
+		cl, err := Dial(chainName, url)
+		if err != nil {
+			return nil, err
+		}
+		clients = append(clients, cl)
+	}
+
+	m, err := NewMultiClient(chainName, clients, opts...)
+	if err != nil {
+		return nil, err
+	} else if len(clients) == 1 && m.quorum <= 1 {
+		return clients[0], nil
+	}
+
+	return m, nil
+}
+
+// order returns the client indexes in order of preference:
+// healthy clients round-robin, followed by unhealthy clients.
+func (m *MultiClient) order() []int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	start := m.next
+	m.next = (m.next + 1) % len(m.clients)
+
+	return m.orderFrom(start)
+}
+
+// stickyOrder returns the client indexes in order of preference for sticky calls:
+// the primary client (even if unhealthy), followed by other healthy and then unhealthy clients.
+func (m *MultiClient) stickyOrder() []int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	resp := []int{m.primary}
+	for _, i := range m.orderFrom(m.primary) {
+		if i != m.primary {
+			resp = append(resp, i)
+		}
+	}
+
+	return resp
+}
+
+// orderFrom returns the client indexes from start, healthy clients followed by unhealthy clients.
+// It assumes the lock is held.
+func (m *MultiClient) orderFrom(start int) []int {
+	var healthy, unhealthy []int
+	for j := 0; j < len(m.clients); j++ {
+		i := (start + j) % len(m.clients)
+		if time.Since(m.unhealthy[i]) < m.cooldown {
+			unhealthy = append(unhealthy, i)
+		} else {
+			healthy = append(healthy, i)
+		}
+	}
+
+	return append(healthy, unhealthy...)
+}
+
+// setPrimary sets the sticky primary client.
+func (m *MultiClient) setPrimary(i int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.primary = i
+}
+
+// failed marks the client as unhealthy and returns true if the error indicates it is unavailable.
+// JSON-RPC error responses (e.g. reverts), not found errors and quorum misses are returned by available clients.
+func (m *MultiClient) failed(i int, err error) bool {
+	var rpcErr rpc.Error
+	if errors.Is(err, ethereum.NotFound) || errors.Is(err, errNoQuorum) || errors.As(err, &rpcErr) {
+		return false
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.unhealthy[i] = time.Now()
+
+	return true
+}
+
+// observeHead records the latest head of the client, marking it unhealthy if it lags the highest observed head.
+func (m *MultiClient) observeHead(i int, head uint64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.heads[i] = head
+
+	var highest uint64
+	for _, h := range m.heads {
+		highest = max(highest, h)
+	}
+
+	if head+m.maxLag < highest {
+		m.unhealthy[i] = time.Now()
+	}
+}
+
+// callAnyIdx calls the function with each client in order of preference until it succeeds,
+// or returns the last error.
+func callAnyIdx[R any](ctx context.Context, m *MultiClient, fn func(i int, cl Client) (R, error)) (R, error) {
+	var (
+		resp R
+		err  error
+	)
+	for _, i := range m.order() {
+		resp, err = fn(i, m.clients[i])
+		if err == nil || ctx.Err() != nil {
+			return resp, err
+		}
+
+		m.failed(i, err)
+	}
+
+	return resp, err
+}
+
+// callAny calls the function with each client in order of preference until it succeeds,
+// or returns the last error.
+func callAny[R any](ctx context.Context, m *MultiClient, fn func(cl Client) (R, error)) (R, error) {
+	return callAnyIdx(ctx, m, func(_ int, cl Client) (R, error) {
+		return fn(cl)
+	})
+}
+
+// callSticky calls the function with the sticky primary client, only failing over to the next client
+// in order of preference if it is unavailable. The first available client becomes the new primary.
+// Unlike callAny, JSON-RPC error responses (e.g. nonce too low) are returned as is.
+func callSticky[R any](ctx context.Context, m *MultiClient, fn func(cl Client) (R, error)) (R, error) {
+	var (
+		resp R
+		err  error
+	)
+	for _, i := range m.stickyOrder() {
+		resp, err = fn(m.clients[i])
+		if ctx.Err() != nil {
+			return resp, err
+		} else if err != nil && m.failed(i, err) {
+			continue // Client unavailable, fail over to the next client.
+		}
+
+		m.setPrimary(i)
+
+		return resp, err
+	}
+
+	return resp, err
+}
+
+// quorumVotes returns the number of clients (including the primary client that returned the header)
+// agreeing on the header's block hash at its height, the number disagreeing and the number not knowing the height.
+// It stops once quorum clients agree.
+func (m *MultiClient) quorumVotes(ctx context.Context, primary int, header *types.Header) (agree, mismatch, notFound int, err error) {
+	agree = 1
+	for _, i := range m.order() {
+		if agree >= m.quorum {
+			break
+		} else if i == primary {
+			continue
+		}
+
+		h, err := m.clients[i].HeaderByNumber(ctx, header.Number)
+		if ctx.Err() != nil {
+			return 0, 0, 0, errors.Wrap(ctx.Err(), "quorum canceled")
+		} else if errors.Is(err, ethereum.NotFound) {
+			notFound++
+			continue
+		} else if err != nil {
+			m.failed(i, err)
+			continue
+		} else if h.Hash() != header.Hash() {
+			mismatch++
+			continue
+		}
+
+		agree++
+	}
+
+	return agree, mismatch, notFound, nil
+}
+
+// verifyQuorum returns an error wrapping errNoQuorum if less than quorum clients
+// (including the primary client that returned the header) agree on the header's block hash at its height.
+func (m *MultiClient) verifyQuorum(ctx context.Context, primary int, header *types.Header) error {
+	if m.quorum <= 1 {
+		return nil
+	}
+
+	agree, mismatch, notFound, err := m.quorumVotes(ctx, primary, header)
+	if err != nil {
+		return err
+	} else if agree < m.quorum {
+		return errors.Wrap(errNoQuorum, "verify quorum",
+			"chain", m.chain,
+			"height", header.Number,
+			"agree", agree,
+			"mismatch", mismatch,
+			"not_found", notFound,
+			"quorum", m.quorum,
+		)
+	}
+
+	return nil
+}
+
+// latestQuorum returns the latest header of the primary client which a quorum of clients agree on.
+// If other clients don't know the latest header's height yet, it steps back to the highest height
+// known by a quorum of clients, at most max lag blocks.
+func (m *MultiClient) latestQuorum(ctx context.Context, primary int, cl Client, header *types.Header) (*types.Header, error) {
+	if m.quorum <= 1 {
+		return header, nil
+	}
+
+	latest := header.Number.Uint64()
+	for {
+		agree, mismatch, notFound, err := m.quorumVotes(ctx, primary, header)
+		if err != nil {
+			return nil, err
+		} else if agree >= m.quorum {
+			return header, nil
+		}
+
+		height := header.Number.Uint64()
+		if agree+notFound < m.quorum || height == 0 || latest-height >= m.maxLag {
+			return nil, errors.Wrap(errNoQuorum, "verify latest quorum",
+				"chain", m.chain,
+				"height", height,
+				"agree", agree,
+				"mismatch", mismatch,
+				"not_found", notFound,
+				"quorum", m.quorum,
+			)
+		}
+
+		// Some clients don't know the height yet, step back.
+		header, err = cl.HeaderByNumber(ctx, new(big.Int).SetUint64(height-1))
+		if err != nil {
+			return nil, errors.Wrap(err, "header by number")
+		}
+	}
+}
+
+// HeaderByNumber returns the header at the height, or the latest header if nil.
+// Latest headers may step back to the highest height known by a quorum of clients.
+func (m *MultiClient) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return callAnyIdx(ctx, m, func(i int, cl Client) (*types.Header, error) {
+		header, err := cl.HeaderByNumber(ctx, number)
+		if err != nil {
+			return nil, err
+		} else if number == nil {
+			return m.latestQuorum(ctx, i, cl, header)
+		}
+
+		if err := m.verifyQuorum(ctx, i, header); err != nil {
+			return nil, err
+		}
+
+		return header, nil
+	})
+}
+
+func (m *MultiClient) BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error) {
+	return callAnyIdx(ctx, m, func(i int, cl Client) (*types.Block, error) {
+		block, err := cl.BlockByNumber(ctx, number)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := m.verifyQuorum(ctx, i, block.Header()); err != nil {
+			return nil, err
+		}
+
+		return block, nil
+	})
+}
+
+// HeaderByType returns the block header for the given head type.
+// Latest heads are used to detect lagging clients and may step back to the highest height known by a quorum of clients.
+func (m *MultiClient) HeaderByType(ctx context.Context, typ HeadType) (*types.Header, error) {
+	return callAnyIdx(ctx, m, func(i int, cl Client) (*types.Header, error) {
+		header, err := cl.HeaderByType(ctx, typ)
+		if err != nil {
+			return nil, err
+		}
+
+		if typ == HeadLatest {
+			m.observeHead(i, header.Number.Uint64())
+			return m.latestQuorum(ctx, i, cl, header)
+		}
+
+		if err := m.verifyQuorum(ctx, i, header); err != nil {
+			return nil, err
+		}
+
+		return header, nil
+	})
+}
+
+// BlockNumber returns the most recent block number.
+// It is used to detect lagging clients.
+func (m *MultiClient) BlockNumber(ctx context.Context) (uint64, error) {
+	return callAnyIdx(ctx, m, func(i int, cl Client) (uint64, error) {
+		height, err := cl.BlockNumber(ctx)
+		if err != nil {
+			return 0, err
+		}
+
+		m.observeHead(i, height)
+
+		return height, nil
+	})
+}
+
+func (m *MultiClient) TransactionByHash(ctx context.Context, txHash common.Hash) (*types.Transaction, bool, error) {
+	type result struct {
+		tx        *types.Transaction
+		isPending bool
+	}
+
+	// Pending transactions are only known by the primary client's mempool.
+	resp, err := callSticky(ctx, m, func(cl Client) (result, error) {
+		tx, isPending, err := cl.TransactionByHash(ctx, txHash)
+		return result{tx: tx, isPending: isPending}, err
+	})
+
+	return resp.tx, resp.isPending, err
+}
+
+func (m *MultiClient) TxReceipt(ctx context.Context, txHash common.Hash) (*Receipt, error) {
+	return callSticky(ctx, m, func(cl Client) (*Receipt, error) {
+		return cl.TxReceipt(ctx, txHash)
+	})
+}
+
+// EtherBalanceAt returns the current balance in ether of the provided account.
+// Note this converts big.Int to float64 so IS NOT accurate.
+func (m *MultiClient) EtherBalanceAt(ctx context.Context, addr common.Address) (float64, error) {
+	b, err := m.BalanceAt(ctx, addr, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	bf, _ := b.Float64()
+
+	return bf / params.Ether, nil
+}
+
+func (m *MultiClient) PeerCount(ctx context.Context) (uint64, error) {
+	return callAny(ctx, m, func(cl Client) (uint64, error) {
+		return cl.PeerCount(ctx)
+	})
+}
+
+// SetHead sets the current head of all clients, since it is a destructive debug action
+// targeting the nodes themselves.
+func (m *MultiClient) SetHead(ctx context.Context, height uint64) error {
+	for _, cl := range m.clients {
+		if err := cl.SetHead(ctx, height); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *MultiClient) ProgressIfSyncing(ctx context.Context) (*ethereum.SyncProgress, bool, error) {
+	type result struct {
+		progress *ethereum.SyncProgress
+		syncing  bool
+	}
+
+	resp, err := callAny(ctx, m, func(cl Client) (result, error) {
+		progress, syncing, err := cl.ProgressIfSyncing(ctx)
+		return result{progress: progress, syncing: syncing}, err
+	})
+
+	return resp.progress, resp.syncing, err
+}
+
+//nolint:revive // interface{} required by upstream.
+func (m *MultiClient) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	_, err := callAny(ctx, m, func(cl Client) (struct{}, error) {
+		return struct{}{}, cl.CallContext(ctx, result, method, args...)
+	})
+
+	return err
+}
+
+// Address returns the comma separated underlying RPC addresses.
+func (m *MultiClient) Address() string {
+	var addrs []string
+	for _, cl := range m.clients {
+		addrs = append(addrs, cl.Address())
+	}
+
+	return strings.Join(addrs, ",")
+}
+
+// Close closes all underlying RPC connections.
+func (m *MultiClient) Close() {
+	for _, cl := range m.clients {
+		cl.Close()
+	}
+}
@@ -0,0 +1,210 @@
+package ethclient_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/ethclient"
+	"github.com/omni-network/omni/lib/ethclient/mock"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestMultiClientFailover(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	cl0 := mock.NewMockClient(ctrl)
+	cl1 := mock.NewMockClient(ctrl)
+
+	multi, err := ethclient.NewMultiClient("test", []ethclient.Client{cl0, cl1})
+	require.NoError(t, err)
+
+	// First call uses cl0 which fails, failing over to cl1.
+	cl0.EXPECT().ChainID(gomock.Any()).Return(nil, errors.New("connection refused")).Times(1)
+	cl1.EXPECT().ChainID(gomock.Any()).Return(big.NewInt(1), nil).Times(1)
+	chainID, err := multi.ChainID(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, chainID.Uint64())
+
+	// cl0 is unhealthy (cooling down), so subsequent calls use cl1.
+	cl1.EXPECT().ChainID(gomock.Any()).Return(big.NewInt(1), nil).Times(2)
+	for i := 0; i < 2; i++ {
+		_, err = multi.ChainID(ctx)
+		require.NoError(t, err)
+	}
+
+	// Errors are returned if all clients fail.
+	cl1.EXPECT().ChainID(gomock.Any()).Return(nil, errors.New("connection refused")).Times(1)
+	cl0.EXPECT().ChainID(gomock.Any()).Return(nil, errors.New("connection refused")).Times(1)
+	_, err = multi.ChainID(ctx)
+	require.ErrorContains(t, err, "connection refused")
+}
+
+func TestMultiClientLagging(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	cl0 := mock.NewMockClient(ctrl)
+	cl1 := mock.NewMockClient(ctrl)
+
+	multi, err := ethclient.NewMultiClient("test", []ethclient.Client{cl0, cl1}, ethclient.WithMaxLag(10))
+	require.NoError(t, err)
+
+	// Round-robin observes heads of both clients, cl1 lags.
+	cl0.EXPECT().BlockNumber(gomock.Any()).Return(uint64(100), nil).Times(1)
+	cl1.EXPECT().BlockNumber(gomock.Any()).Return(uint64(80), nil).Times(1)
+	for i := 0; i < 2; i++ {
+		_, err = multi.BlockNumber(ctx)
+		require.NoError(t, err)
+	}
+
+	// Lagging cl1 is unhealthy, so cl0 is used.
+	cl0.EXPECT().BlockNumber(gomock.Any()).Return(uint64(101), nil).Times(2)
+	for i := 0; i < 2; i++ {
+		height, err := multi.BlockNumber(ctx)
+		require.NoError(t, err)
+		require.EqualValues(t, 101, height)
+	}
+}
+
+func TestMultiClientQuorum(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	cl0 := mock.NewMockClient(ctrl)
+	cl1 := mock.NewMockClient(ctrl)
+	cl2 := mock.NewMockClient(ctrl)
+
+	_, err := ethclient.NewMultiClient("test", []ethclient.Client{cl0, cl1}, ethclient.WithQuorum(3))
+	require.ErrorContains(t, err, "quorum exceeds clients")
+
+	multi, err := ethclient.NewMultiClient("test", []ethclient.Client{cl0, cl1, cl2}, ethclient.WithQuorum(2))
+	require.NoError(t, err)
+
+	height := big.NewInt(10)
+	header := &types.Header{Number: height, ParentHash: common.HexToHash("0x01")}
+	forked := &types.Header{Number: height, ParentHash: common.HexToHash("0x02")}
+
+	// Primary cl0 returns the header, cl1 agrees.
+	cl0.EXPECT().HeaderByNumber(gomock.Any(), height).Return(header, nil).Times(1)
+	cl1.EXPECT().HeaderByNumber(gomock.Any(), height).Return(header, nil).Times(1)
+	resp, err := multi.HeaderByNumber(ctx, height)
+	require.NoError(t, err)
+	require.Equal(t, header.Hash(), resp.Hash())
+
+	// Primary cl2 returns a forked header which cl0 and cl1 disagree with,
+	// failing over to primary cl0 which cl1 agrees with.
+	cl2.EXPECT().HeaderByNumber(gomock.Any(), height).Return(forked, nil).Times(1)
+	cl0.EXPECT().HeaderByNumber(gomock.Any(), height).Return(header, nil).Times(2)
+	cl1.EXPECT().HeaderByNumber(gomock.Any(), height).Return(header, nil).Times(2)
+	resp, err = multi.HeaderByNumber(ctx, height)
+	require.NoError(t, err)
+	require.Equal(t, header.Hash(), resp.Hash())
+}
+
+func TestMultiClientSticky(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	cl0 := mock.NewMockClient(ctrl)
+	cl1 := mock.NewMockClient(ctrl)
+
+	multi, err := ethclient.NewMultiClient("test", []ethclient.Client{cl0, cl1})
+	require.NoError(t, err)
+
+	addr := common.HexToAddress("0x01")
+
+	// Pending state calls are pinned to primary cl0, not round-robin.
+	cl0.EXPECT().PendingNonceAt(gomock.Any(), addr).Return(uint64(1), nil).Times(2)
+	for i := 0; i < 2; i++ {
+		nonce, err := multi.PendingNonceAt(ctx, addr)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, nonce)
+	}
+
+	// JSON-RPC error responses of the primary are returned as is, without failing over.
+	cl0.EXPECT().SendTransaction(gomock.Any(), gomock.Any()).Return(jsonRPCError{msg: "nonce too low"}).Times(1)
+	err = multi.SendTransaction(ctx, new(types.Transaction))
+	require.ErrorContains(t, err, "nonce too low")
+
+	// Unavailable primary fails over to cl1, which becomes the new primary.
+	cl0.EXPECT().PendingNonceAt(gomock.Any(), addr).Return(uint64(0), errors.New("connection refused")).Times(1)
+	cl1.EXPECT().PendingNonceAt(gomock.Any(), addr).Return(uint64(2), nil).Times(3)
+	for i := 0; i < 3; i++ {
+		nonce, err := multi.PendingNonceAt(ctx, addr)
+		require.NoError(t, err)
+		require.EqualValues(t, 2, nonce)
+	}
+}
+
+// jsonRPCError implements rpc.Error, i.e., a JSON-RPC error response returned by an available client.
+type jsonRPCError struct {
+	msg string
+}
+
+func (e jsonRPCError) Error() string {
+	return e.msg
+}
+
+func (jsonRPCError) ErrorCode() int {
+	return -32000
+}
+
+func TestMultiClientQuorumLatest(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	cl0 := mock.NewMockClient(ctrl)
+	cl1 := mock.NewMockClient(ctrl)
+
+	multi, err := ethclient.NewMultiClient("test", []ethclient.Client{cl0, cl1}, ethclient.WithQuorum(2), ethclient.WithMaxLag(2))
+	require.NoError(t, err)
+
+	header := func(height int64) *types.Header {
+		return &types.Header{Number: big.NewInt(height)}
+	}
+
+	// Primary cl0 is a block ahead of cl1, so the latest header steps back to the height known by both.
+	cl0.EXPECT().HeaderByType(gomock.Any(), ethclient.HeadLatest).Return(header(11), nil).Times(1)
+	cl1.EXPECT().HeaderByNumber(gomock.Any(), big.NewInt(11)).Return(nil, ethereum.NotFound).Times(1)
+	cl0.EXPECT().HeaderByNumber(gomock.Any(), big.NewInt(10)).Return(header(10), nil).Times(1)
+	cl1.EXPECT().HeaderByNumber(gomock.Any(), big.NewInt(10)).Return(header(10), nil).Times(1)
+	resp, err := multi.HeaderByType(ctx, ethclient.HeadLatest)
+	require.NoError(t, err)
+	require.EqualValues(t, 10, resp.Number.Uint64())
+
+	// Quorum misses (cl1 lags more than the max lag) fail over without marking clients unhealthy.
+	cl1.EXPECT().HeaderByType(gomock.Any(), ethclient.HeadLatest).Return(header(8), nil).Times(1)
+	cl0.EXPECT().HeaderByNumber(gomock.Any(), big.NewInt(8)).Return(header(8), nil).Times(1)
+	resp, err = multi.HeaderByType(ctx, ethclient.HeadLatest)
+	require.NoError(t, err)
+	require.EqualValues(t, 8, resp.Number.Uint64())
+
+	cl0.EXPECT().HeaderByType(gomock.Any(), ethclient.HeadLatest).Return(header(20), nil).Times(1)
+	cl1.EXPECT().HeaderByNumber(gomock.Any(), gomock.Any()).Return(nil, ethereum.NotFound).Times(3)
+	cl0.EXPECT().HeaderByNumber(gomock.Any(), big.NewInt(19)).Return(header(19), nil).Times(1)
+	cl0.EXPECT().HeaderByNumber(gomock.Any(), big.NewInt(18)).Return(header(18), nil).Times(1)
+	cl1.EXPECT().HeaderByType(gomock.Any(), ethclient.HeadLatest).Return(nil, errors.New("connection refused")).Times(1)
+	_, err = multi.HeaderByType(ctx, ethclient.HeadLatest)
+	require.Error(t, err)
+
+	// cl0 is still healthy (only cl1 failed), so it is preferred.
+	cl0.EXPECT().ChainID(gomock.Any()).Return(big.NewInt(1), nil).Times(2)
+	for i := 0; i < 2; i++ {
+		_, err = multi.ChainID(ctx)
+		require.NoError(t, err)
+	}
+}
@@ -124,6 +124,71 @@ func GetMultiProof(tree [][32]byte, indices ...int) (MultiProof, error) {
 	}, nil
 }
 
+// ProcessMultiProof returns the root hash of the tree given a multi proof.
+func ProcessMultiProof(multi MultiProof) ([32]byte, error) {
+	if err := verifyMultiProof(multi); err != nil {
+		return [32]byte{}, err
+	}
+
+	// Copy leaves and proof.
+	stack := make([][32]byte, len(multi.Leaves))
+	copy(stack, multi.Leaves)
+	proof := make([][32]byte, len(multi.Proof))
+	copy(proof, multi.Proof)
+
+	for _, flag := range multi.ProofFlags {
+		// Pop from the beginning of the stack.
+		a := stack[0]
+		stack = stack[1:]
+
+		// Either pop from the stack or the proof, depending on the flag.
+		var b [32]byte
+		if flag {
+			b = stack[0]
+			stack = stack[1:]
+		} else {
+			b = proof[0]
+			proof = proof[1:]
+		}
+
+		stack = append(stack, hashPair(a, b)) //nolint:makezero // Appending to non-zero initialized slice is ok
+	}
+
+	// Either the stack or the proof should have one element left.
+	if len(stack)+len(proof) != 1 {
+		return [32]byte{}, errors.New("broken invariant")
+	}
+
+	if len(stack) > 0 {
+		return stack[0], nil
+	}
+
+	return proof[0], nil
+}
+
+// verifyMultiProof returns an error if the given multi proof is invalid.
+func verifyMultiProof(multi MultiProof) error {
+	var falseFlags int
+	for _, flag := range multi.ProofFlags {
+		if !flag {
+			falseFlags++
+		}
+	}
+	if len(multi.Proof) != falseFlags {
+		return errors.New("false proof flags don't match proof")
+	}
+
+	if len(multi.Leaves)+len(multi.Proof) != len(multi.ProofFlags)+1 {
+		return errors.New("proof flags don't match leaves and proof")
+	}
+
+	if len(multi.Leaves) == 0 {
+		return errors.New("no leaves provided")
+	}
+
+	return nil
+}
+
 // isTreeNode returns true if the given index is a node in the tree.
 func isTreeNode(tree [][32]byte, i int) bool {
 	return i >= 0 && i < len(tree)
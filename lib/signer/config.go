@@ -0,0 +1,70 @@
+package signer
+
+import (
+	"github.com/omni-network/omni/lib/errors"
+
+	"github.com/spf13/pflag"
+)
+
+// Type is the type of transaction signer.
+type Type string
+
+const (
+	// TypeLocal signs with a private key file on disk.
+	TypeLocal Type = "local"
+	// TypeFireblocks signs with a Fireblocks vault account.
+	TypeFireblocks Type = "fireblocks"
+	// TypeRemote signs with a generic remote signer over HTTP.
+	TypeRemote Type = "remote"
+)
+
+// Config defines the transaction signer configuration.
+type Config struct {
+	Type        Type
+	Address     string // Signer account address, required for fireblocks and remote signers.
+	FireAPIKey  string // Fireblocks API key.
+	FireKeyPath string // Fireblocks RSA private key path.
+	RemoteURL   string // Remote signer base URL, e.g. "http://signer:8080".
+}
+
+// DefaultConfig returns the default local signer configuration.
+func DefaultConfig() Config {
+	return Config{
+		Type: TypeLocal,
+	}
+}
+
+// BindFlags binds the signer flags to the corresponding fields in the Config struct.
+func BindFlags(flags *pflag.FlagSet, cfg *Config) {
+	flags.StringVar((*string)(&cfg.Type), "signer-type", string(cfg.Type), "Transaction signer type: local, fireblocks, or remote")
+	flags.StringVar(&cfg.Address, "signer-address", cfg.Address, "Signer account address, required for fireblocks and remote signers")
+	flags.StringVar(&cfg.FireAPIKey, "signer-fireblocks-api-key", cfg.FireAPIKey, "Fireblocks API key")
+	flags.StringVar(&cfg.FireKeyPath, "signer-fireblocks-key-path", cfg.FireKeyPath, "Fireblocks RSA private key path")
+	flags.StringVar(&cfg.RemoteURL, "signer-remote-url", cfg.RemoteURL, "Remote signer base URL e.g. http://signer:8080")
+}
+
+// Validate returns an error if the config is invalid.
+func (c Config) Validate() error {
+	switch c.Type {
+	case TypeLocal:
+		return nil
+	case TypeFireblocks:
+		if c.FireAPIKey == "" {
+			return errors.New("fireblocks api key not set")
+		} else if c.FireKeyPath == "" {
+			return errors.New("fireblocks key path not set")
+		}
+	case TypeRemote:
+		if c.RemoteURL == "" {
+			return errors.New("remote signer url not set")
+		}
+	default:
+		return errors.New("unknown signer type", "type", c.Type)
+	}
+
+	if c.Address == "" {
+		return errors.New("signer address not set", "type", c.Type)
+	}
+
+	return nil
+}
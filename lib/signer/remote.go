@@ -0,0 +1,104 @@
+package signer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/omni-network/omni/lib/cast"
+	"github.com/omni-network/omni/lib/errors"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+)
+
+const remoteTimeout = 30 * time.Second
+
+// signRequest is the request body of the remote signer /sign endpoint.
+type signRequest struct {
+	Address common.Address `json:"address"`
+	Digest  common.Hash    `json:"digest"`
+}
+
+// signResponse is the response body of the remote signer /sign endpoint.
+type signResponse struct {
+	Signature hexutil.Bytes `json:"signature"` // 65 byte Ethereum [R || S || V] signature, V is 0/1 or 27/28.
+	Error     string        `json:"error,omitempty"`
+}
+
+// remote is a generic remote signer client.
+//
+// It POSTs {"address":"0x..","digest":"0x.."} to <url>/sign
+// and expects {"signature":"0x.."} in response.
+type remote struct {
+	url  string
+	http http.Client
+}
+
+func newRemote(url string) remote {
+	return remote{
+		url:  strings.TrimSuffix(url, "/"),
+		http: http.Client{Timeout: remoteTimeout},
+	}
+}
+
+// Sign returns the signature of the digest by the signer address as per txmgr.ExternalSigner.
+// The signature is verified to be from the signer address.
+func (r remote) Sign(ctx context.Context, digest common.Hash, signer common.Address) ([65]byte, error) {
+	reqBz, err := json.Marshal(signRequest{Address: signer, Digest: digest})
+	if err != nil {
+		return [65]byte{}, errors.Wrap(err, "marshal request")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.url+"/sign", bytes.NewReader(reqBz))
+	if err != nil {
+		return [65]byte{}, errors.Wrap(err, "new request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := r.http.Do(req)
+	if err != nil {
+		return [65]byte{}, errors.Wrap(err, "remote sign")
+	}
+	defer resp.Body.Close()
+
+	respBz, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return [65]byte{}, errors.Wrap(err, "read response")
+	}
+
+	var res signResponse
+	if err := json.Unmarshal(respBz, &res); err != nil && resp.StatusCode/100 == 2 {
+		return [65]byte{}, errors.Wrap(err, "unmarshal response")
+	} else if resp.StatusCode/100 != 2 {
+		return [65]byte{}, errors.New("remote sign failed", "status", resp.StatusCode, "error", res.Error)
+	}
+
+	sig, err := cast.Array65(res.Signature)
+	if err != nil {
+		return [65]byte{}, errors.Wrap(err, "invalid signature")
+	}
+
+	return normalizeSig(sig, digest, signer)
+}
+
+// normalizeSig returns the signature with V converted to 0/1, ensuring it was signed by the signer address.
+func normalizeSig(sig [65]byte, digest common.Hash, signer common.Address) ([65]byte, error) {
+	if sig[64] == 27 || sig[64] == 28 {
+		sig[64] -= 27
+	}
+
+	pubkey, err := ethcrypto.SigToPub(digest[:], sig[:])
+	if err != nil {
+		return [65]byte{}, errors.Wrap(err, "recover public key")
+	} else if actual := ethcrypto.PubkeyToAddress(*pubkey); actual != signer {
+		return [65]byte{}, errors.New("signed address mismatch", "expect", signer, "actual", actual)
+	}
+
+	return sig, nil
+}
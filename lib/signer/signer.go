@@ -0,0 +1,133 @@
+// Package signer provides transaction signers backed by local private keys, Fireblocks or a remote HTTP signer.
+package signer
+
+import (
+	"context"
+	"crypto/ecdsa"
+
+	"github.com/omni-network/omni/lib/cast"
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/ethclient"
+	"github.com/omni-network/omni/lib/fireblocks"
+	"github.com/omni-network/omni/lib/netconf"
+	"github.com/omni-network/omni/lib/txmgr"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+)
+
+// Signer signs digests on behalf of a single account.
+// Local signers also expose their private key.
+type Signer struct {
+	address    common.Address
+	privateKey *ecdsa.PrivateKey    // Either local private key is set,
+	external   txmgr.ExternalSigner // or, an external signer is used
+}
+
+// New returns a signer as per the config. Local signers load the private key from keyFile.
+func New(ctx context.Context, network netconf.ID, cfg Config, keyFile string) (Signer, error) {
+	if err := cfg.Validate(); err != nil {
+		return Signer{}, errors.Wrap(err, "validate signer config")
+	}
+
+	if cfg.Type == TypeLocal {
+		if keyFile == "" {
+			return Signer{}, errors.New("private key not set")
+		}
+
+		privKey, err := ethcrypto.LoadECDSA(keyFile)
+		if err != nil {
+			return Signer{}, errors.Wrap(err, "load private key")
+		}
+
+		return NewLocal(privKey), nil
+	}
+
+	if !common.IsHexAddress(cfg.Address) {
+		return Signer{}, errors.New("invalid signer address", "address", cfg.Address)
+	}
+	addr := common.HexToAddress(cfg.Address)
+
+	switch cfg.Type {
+	case TypeFireblocks:
+		return newFireblocks(ctx, network, cfg, addr)
+	case TypeRemote:
+		return NewExternal(addr, newRemote(cfg.RemoteURL).Sign), nil
+	default:
+		return Signer{}, errors.New("unknown signer type", "type", cfg.Type)
+	}
+}
+
+// NewLocal returns a signer backed by the in-memory private key.
+func NewLocal(privKey *ecdsa.PrivateKey) Signer {
+	return Signer{
+		address:    ethcrypto.PubkeyToAddress(privKey.PublicKey),
+		privateKey: privKey,
+	}
+}
+
+// NewExternal returns a signer backed by the external signer function.
+func NewExternal(addr common.Address, external txmgr.ExternalSigner) Signer {
+	return Signer{
+		address:  addr,
+		external: external,
+	}
+}
+
+// Address returns the signer account address.
+func (s Signer) Address() common.Address {
+	return s.address
+}
+
+// PrivateKey returns the private key of local signers, or false for external signers.
+func (s Signer) PrivateKey() (*ecdsa.PrivateKey, bool) {
+	return s.privateKey, s.privateKey != nil
+}
+
+// Sign returns the 65 byte Ethereum [R || S || V] signature of the digest, where V is 0 or 1.
+// It implements txmgr.ExternalSigner.
+func (s Signer) Sign(ctx context.Context, digest common.Hash, signer common.Address) ([65]byte, error) {
+	if signer != s.address {
+		return [65]byte{}, errors.New("unknown signer address", "signer", signer, "expect", s.address)
+	} else if s.privateKey == nil {
+		return s.external(ctx, digest, signer)
+	}
+
+	sig, err := ethcrypto.Sign(digest[:], s.privateKey)
+	if err != nil {
+		return [65]byte{}, errors.Wrap(err, "sign")
+	}
+
+	return cast.Array65(sig)
+}
+
+// TxMgrConfig returns a txmgr config that signs transactions with this signer.
+func (s Signer) TxMgrConfig(cliCfg txmgr.CLIConfig, ethCl ethclient.Client) (txmgr.Config, error) {
+	if s.privateKey != nil {
+		return txmgr.NewConfig(cliCfg, s.privateKey, ethCl)
+	}
+
+	return txmgr.NewConfigWithSigner(cliCfg, s.external, s.address, ethCl)
+}
+
+// newFireblocks returns a signer backed by the Fireblocks vault account of the address.
+func newFireblocks(ctx context.Context, network netconf.ID, cfg Config, addr common.Address) (Signer, error) {
+	key, err := fireblocks.LoadKey(cfg.FireKeyPath)
+	if err != nil {
+		return Signer{}, err
+	}
+
+	fireCl, err := fireblocks.New(network, cfg.FireAPIKey, key)
+	if err != nil {
+		return Signer{}, errors.Wrap(err, "new fireblocks client")
+	}
+
+	accounts, err := fireCl.Accounts(ctx)
+	if err != nil {
+		return Signer{}, errors.Wrap(err, "fireblocks accounts")
+	} else if _, ok := accounts[addr]; !ok {
+		return Signer{}, errors.New("signer address not found in fireblocks", "address", addr)
+	}
+
+	return NewExternal(addr, fireCl.Sign), nil
+}
@@ -0,0 +1,121 @@
+package signer
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/omni-network/omni/lib/netconf"
+	"github.com/omni-network/omni/lib/tutil"
+
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocal(t *testing.T) {
+	t.Parallel()
+
+	privKey, err := ethcrypto.GenerateKey()
+	require.NoError(t, err)
+
+	s := NewLocal(privKey)
+	require.Equal(t, ethcrypto.PubkeyToAddress(privKey.PublicKey), s.Address())
+
+	pk, ok := s.PrivateKey()
+	require.True(t, ok)
+	require.Equal(t, privKey, pk)
+
+	digest := tutil.RandomHash()
+	sig, err := s.Sign(context.Background(), digest, s.Address())
+	require.NoError(t, err)
+
+	normalized, err := normalizeSig(sig, digest, s.Address())
+	require.NoError(t, err)
+	require.Equal(t, sig, normalized)
+
+	_, err = s.Sign(context.Background(), digest, tutil.RandomAddress())
+	require.Error(t, err)
+}
+
+func TestRemote(t *testing.T) {
+	t.Parallel()
+
+	privKey, err := ethcrypto.GenerateKey()
+	require.NoError(t, err)
+	addr := ethcrypto.PubkeyToAddress(privKey.PublicKey)
+
+	otherKey, err := ethcrypto.GenerateKey()
+	require.NoError(t, err)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/sign", r.URL.Path)
+
+		var req signRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		if req.Address != addr {
+			w.WriteHeader(http.StatusNotFound)
+			_ = json.NewEncoder(w).Encode(signResponse{Error: "unknown address"})
+
+			return
+		}
+
+		key := privKey
+		if req.Digest[0]%2 == 1 {
+			key = otherKey // Sign with the wrong key
+		}
+
+		sig, err := ethcrypto.Sign(req.Digest[:], key)
+		require.NoError(t, err)
+		sig[64] += 27 // Remote signers may return V as 27/28
+
+		_ = json.NewEncoder(w).Encode(signResponse{Signature: sig})
+	}))
+	t.Cleanup(srv.Close)
+
+	s, err := New(context.Background(), netconf.Devnet, Config{Type: TypeRemote, Address: addr.Hex(), RemoteURL: srv.URL + "/"}, "")
+	require.NoError(t, err)
+	require.Equal(t, addr, s.Address())
+
+	_, ok := s.PrivateKey()
+	require.False(t, ok)
+
+	digest := tutil.RandomHash()
+	digest[0] = 0
+	sig, err := s.Sign(context.Background(), digest, addr)
+	require.NoError(t, err)
+	require.Less(t, sig[64], byte(2))
+
+	pubkey, err := ethcrypto.SigToPub(digest[:], sig[:])
+	require.NoError(t, err)
+	require.Equal(t, addr, ethcrypto.PubkeyToAddress(*pubkey))
+
+	// Signatures from other keys are rejected
+	digest[0] = 1
+	_, err = s.Sign(context.Background(), digest, addr)
+	require.ErrorContains(t, err, "signed address mismatch")
+
+	// Remote errors are returned
+	other := tutil.RandomAddress()
+	_, err = NewExternal(other, newRemote(srv.URL).Sign).Sign(context.Background(), digest, other)
+	require.ErrorContains(t, err, "remote sign failed")
+}
+
+func TestConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	addr := tutil.RandomAddress().Hex()
+
+	require.NoError(t, DefaultConfig().Validate())
+	require.NoError(t, Config{Type: TypeRemote, Address: addr, RemoteURL: "http://signer"}.Validate())
+	require.NoError(t, Config{Type: TypeFireblocks, Address: addr, FireAPIKey: "key", FireKeyPath: "path"}.Validate())
+
+	require.Error(t, Config{}.Validate())
+	require.Error(t, Config{Type: "unknown"}.Validate())
+	require.Error(t, Config{Type: TypeRemote, Address: addr}.Validate())
+	require.Error(t, Config{Type: TypeRemote, RemoteURL: "http://signer"}.Validate())
+	require.Error(t, Config{Type: TypeFireblocks, Address: addr, FireAPIKey: "key"}.Validate())
+}
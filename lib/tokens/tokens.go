@@ -3,14 +3,23 @@ package tokens
 type Token string
 
 const (
-	OMNI Token = "OMNI"
-	ETH  Token = "ETH"
+	OMNI   Token = "OMNI"
+	ETH    Token = "ETH"
+	WSTETH Token = "wstETH"
+	USDC   Token = "USDC"
 )
 
 var (
 	coingeckoIDs = map[Token]string{
-		OMNI: "omni-network",
-		ETH:  "ethereum",
+		OMNI:   "omni-network",
+		ETH:    "ethereum",
+		WSTETH: "wrapped-steth",
+		USDC:   "usd-coin",
+	}
+
+	// decimals of tokens not using the default 18.
+	decimals = map[Token]uint{
+		USDC: 6,
 	}
 )
 
@@ -22,6 +31,15 @@ func (t Token) CoingeckoID() string {
 	return coingeckoIDs[t]
 }
 
+// Decimals returns the number of decimals of the token's smallest unit, defaulting to 18.
+func (t Token) Decimals() uint {
+	if d, ok := decimals[t]; ok {
+		return d
+	}
+
+	return 18
+}
+
 func FromCoingeckoID(id string) (Token, bool) {
 	for t, i := range coingeckoIDs {
 		if i == id {
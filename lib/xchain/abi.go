@@ -95,6 +95,40 @@ func DecodeXSubmit(txCallData []byte) (bindings.XSubmission, error) {
 	return wrap.Sub, nil
 }
 
+// DecodeAddValidatorSet decodes the addValidatorSet function call data
+// of consensus chain validator set messages.
+func DecodeAddValidatorSet(callData []byte) (uint64, []bindings.Validator, error) {
+	const method = "addValidatorSet"
+	m, ok := omniPortalABI.Methods[method]
+	if !ok {
+		return 0, nil, errors.New("missing method")
+	}
+
+	trimmed := bytes.TrimPrefix(callData, m.ID)
+	if bytes.Equal(trimmed, callData) {
+		return 0, nil, errors.New("data not prefixed with addValidatorSet method ID")
+	}
+
+	unpacked, err := m.Inputs.Unpack(trimmed)
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "unpack validator set")
+	} else if len(unpacked) != 2 {
+		return 0, nil, errors.New("unexpected number of arguments")
+	}
+
+	valSetID, ok := unpacked[0].(uint64)
+	if !ok {
+		return 0, nil, errors.New("invalid validator set ID type")
+	}
+
+	vals, ok := abi.ConvertType(unpacked[1], new([]bindings.Validator)).(*[]bindings.Validator)
+	if !ok {
+		return 0, nil, errors.New("invalid validators type")
+	}
+
+	return valSetID, *vals, nil
+}
+
 func SubmissionFromBinding(sub bindings.XSubmission, destChainID uint64) (Submission, error) {
 	sigs := make([]SigTuple, 0, len(sub.Signatures))
 	for _, sig := range sub.Signatures {
@@ -10,23 +10,24 @@ func _() {
 	var x [1]struct{}
 	_ = x[ConfUnknown-0]
 	_ = x[ConfLatest-1]
+	_ = x[ConfSafe-2]
 	_ = x[ConfFinalized-4]
 	_ = x[confSentinel-5]
 }
 
 const (
-	_ConfLevel_name_0 = "unknownlatest"
+	_ConfLevel_name_0 = "unknownlatestsafe"
 	_ConfLevel_name_1 = "finalsentinel must always be last"
 )
 
 var (
-	_ConfLevel_index_0 = [...]uint8{0, 7, 13}
+	_ConfLevel_index_0 = [...]uint8{0, 7, 13, 17}
 	_ConfLevel_index_1 = [...]uint8{0, 5, 33}
 )
 
 func (i ConfLevel) String() string {
 	switch {
-	case i <= 1:
+	case i <= 2:
 		return _ConfLevel_name_0[_ConfLevel_index_0[i]:_ConfLevel_index_0[i+1]]
 	case 4 <= i && i <= 5:
 		i -= 4
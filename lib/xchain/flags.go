@@ -2,22 +2,35 @@ package xchain
 
 import (
 	"strconv"
+	"strings"
 
 	"github.com/omni-network/omni/lib/errors"
 
 	"github.com/spf13/pflag"
 )
 
+// RPCEndpoints maps chain names (or IDs) to one or more whitespace separated RPC URLs.
 type RPCEndpoints map[string]string
 
+// ByNameOrID returns the first (primary) RPC URL of the chain by name or ID.
 func (e RPCEndpoints) ByNameOrID(name string, chainID uint64) (string, error) {
-	if val := e[name]; val != "" {
-		return val, nil
-	} else if val := e[strconv.FormatUint(chainID, 10)]; val != "" {
-		return val, nil
+	urls, err := e.URLsByNameOrID(name, chainID)
+	if err != nil {
+		return "", err
 	}
 
-	return "", errors.New("no rpc endpoint for chain", "chain_name", name, "chain_id", chainID)
+	return urls[0], nil
+}
+
+// URLsByNameOrID returns all RPC URLs of the chain by name or ID.
+func (e RPCEndpoints) URLsByNameOrID(name string, chainID uint64) ([]string, error) {
+	if urls := strings.Fields(e[name]); len(urls) > 0 {
+		return urls, nil
+	} else if urls := strings.Fields(e[strconv.FormatUint(chainID, 10)]); len(urls) > 0 {
+		return urls, nil
+	}
+
+	return nil, errors.New("no rpc endpoint for chain", "chain_name", name, "chain_id", chainID)
 }
 
 func (e RPCEndpoints) Keys() []string {
@@ -31,5 +44,5 @@ func (e RPCEndpoints) Keys() []string {
 
 // BindFlags binds the xchain evm rpc flag.
 func BindFlags(flags *pflag.FlagSet, endpoints *RPCEndpoints) {
-	flags.StringToStringVar((*map[string]string)(endpoints), "xchain-evm-rpc-endpoints", *endpoints, "Cross-chain EVM RPC endpoints. e.g. \"ethereum=http://geth:8545,optimism=https://optimism.io\"")
+	flags.StringToStringVar((*map[string]string)(endpoints), "xchain-evm-rpc-endpoints", *endpoints, "Cross-chain EVM RPC endpoints, multiple space separated URLs per chain enable failover. e.g. \"ethereum=http://geth:8545 https://backup.io,optimism=https://optimism.io\"")
 }
@@ -0,0 +1,278 @@
+// Package lightclient provides an off-chain verifier of Omni cross-chain attestations and submissions.
+//
+// It verifies quorum signatures, attestation roots and message merkle multi-proofs the same way
+// portal contracts do, allowing indexers and bridges to verify Omni messages without trusting an RPC.
+// Validator set transitions are tracked from verified consensus chain validator set messages.
+package lightclient
+
+import (
+	"bytes"
+	"slices"
+	"sync"
+
+	"github.com/omni-network/omni/contracts/bindings"
+	"github.com/omni-network/omni/lib/cchain"
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/umath"
+	"github.com/omni-network/omni/lib/xchain"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+//nolint:gochecknoglobals // Static ABI method ID
+var addValSetMethodID = mustMethodID(bindings.OmniPortalMetaData, "addValidatorSet")
+
+// Verifier verifies attestations and submissions against the validator sets it tracks.
+// It is safe for concurrent use.
+type Verifier struct {
+	cChainID uint64
+
+	mu      sync.RWMutex
+	valSets map[uint64][]cchain.PortalValidator
+	latest  uint64
+}
+
+// New returns a new verifier for the consensus chain ID, trusting the provided initial validator set.
+//
+// The initial validator set must be obtained from a trusted source, e.g. the consensus chain genesis
+// or a portal contract. All subsequent validator sets are only added after being verified.
+func New(cChainID uint64, valSetID uint64, vals []cchain.PortalValidator) (*Verifier, error) {
+	v := &Verifier{
+		cChainID: cChainID,
+		valSets:  make(map[uint64][]cchain.PortalValidator),
+	}
+
+	if err := v.addValSet(valSetID, vals); err != nil {
+		return nil, errors.Wrap(err, "initial validator set")
+	}
+
+	return v, nil
+}
+
+// LatestValSetID returns the ID of the latest tracked validator set.
+func (v *Verifier) LatestValSetID() uint64 {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+
+	return v.latest
+}
+
+// ValSet returns the tracked validator set with the provided ID or false if not tracked.
+func (v *Verifier) ValSet(valSetID uint64) ([]cchain.PortalValidator, bool) {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+
+	vals, ok := v.valSets[valSetID]
+
+	return vals, ok
+}
+
+// VerifyAttestation returns an error if the attestation isn't approved by a quorum of its validator set.
+func (v *Verifier) VerifyAttestation(att xchain.Attestation) error {
+	if err := v.verifyAttHeader(att.AttestHeader, att.BlockHeader); err != nil {
+		return err
+	}
+
+	attRoot, err := att.AttestationRoot()
+	if err != nil {
+		return err
+	}
+
+	return v.verifyQuorum(att.ValidatorSetID, attRoot, att.Signatures)
+}
+
+// VerifyBlock returns an error if the attestation isn't approved or if the block's messages
+// do not match the attested message root. Validator set messages of verified consensus chain
+// blocks are applied.
+func (v *Verifier) VerifyBlock(att xchain.Attestation, block xchain.Block) error {
+	if err := v.VerifyAttestation(att); err != nil {
+		return err
+	}
+
+	if block.ChainID != att.ChainID || block.BlockHeight != att.BlockHeight {
+		return errors.New("block doesn't match attestation",
+			"block_chain", block.ChainID,
+			"block_height", block.BlockHeight,
+			"att_chain", att.ChainID,
+			"att_height", att.BlockHeight,
+		)
+	}
+
+	var msgRoot common.Hash
+	if len(block.Msgs) > 0 {
+		for _, msg := range block.Msgs {
+			if msg.SourceChainID != block.ChainID {
+				return errors.New("msg source chain mismatch", "msg_chain", msg.SourceChainID, "block_chain", block.ChainID)
+			}
+		}
+
+		tree, err := xchain.NewMsgTree(block.Msgs)
+		if err != nil {
+			return err
+		}
+
+		msgRoot = tree.MsgRoot()
+	} // else use zero value msgRoot
+
+	if msgRoot != att.MsgRoot {
+		return errors.New("msg root mismatch", "block", msgRoot, "attestation", att.MsgRoot)
+	}
+
+	return v.applyValSets(block.ChainID, block.Msgs)
+}
+
+// VerifySubmission returns an error if the submission isn't approved by a quorum of its validator set
+// or if its messages and block header are not included in the attestation root.
+// Validator set messages of verified consensus chain submissions are applied.
+func (v *Verifier) VerifySubmission(sub xchain.Submission) error {
+	if err := v.verifyAttHeader(sub.AttHeader, sub.BlockHeader); err != nil {
+		return err
+	} else if len(sub.Msgs) == 0 {
+		return errors.New("empty submission")
+	}
+
+	if err := v.verifyQuorum(sub.ValidatorSetID, sub.AttestationRoot, sub.Signatures); err != nil {
+		return err
+	}
+
+	confLevel := sub.AttHeader.ChainVersion.ConfLevel
+	for _, msg := range sub.Msgs {
+		if msg.SourceChainID != sub.BlockHeader.ChainID {
+			return errors.New("msg source chain mismatch", "msg_chain", msg.SourceChainID, "block_chain", sub.BlockHeader.ChainID)
+		}
+
+		// Finalized attestations may include messages of any shard, similar to portals.
+		if confLevel != xchain.ConfFinalized && msg.ShardID.ConfLevel() != confLevel {
+			return errors.New("msg conf level mismatch", "shard", msg.ShardID.Label(), "conf_level", confLevel)
+		}
+	}
+
+	msgRoot, err := xchain.MsgRootFromProof(sub.Msgs, sub.Proof, sub.ProofFlags)
+	if err != nil {
+		return errors.Wrap(err, "msg proof")
+	}
+
+	attRoot, err := xchain.AttestationRoot(sub.AttHeader, sub.BlockHeader, msgRoot)
+	if err != nil {
+		return err
+	} else if attRoot != sub.AttestationRoot {
+		return errors.New("attestation root mismatch", "expected", attRoot, "actual", sub.AttestationRoot)
+	}
+
+	return v.applyValSets(sub.BlockHeader.ChainID, sub.Msgs)
+}
+
+// verifyAttHeader returns an error if the attest and block headers are inconsistent or for another consensus chain.
+func (v *Verifier) verifyAttHeader(attHeader xchain.AttestHeader, blockHeader xchain.BlockHeader) error {
+	if attHeader.ConsensusChainID != v.cChainID {
+		return errors.New("consensus chain mismatch", "expected", v.cChainID, "actual", attHeader.ConsensusChainID)
+	} else if attHeader.ChainVersion.ID != blockHeader.ChainID {
+		return errors.New("chain version mismatch", "chain_version", attHeader.ChainVersion.ID, "block_chain", blockHeader.ChainID)
+	}
+
+	return nil
+}
+
+// verifyQuorum returns an error if the attestation root isn't signed by a quorum of the validator set.
+func (v *Verifier) verifyQuorum(valSetID uint64, attRoot common.Hash, sigs []xchain.SigTuple) error {
+	vals, ok := v.ValSet(valSetID)
+	if !ok {
+		return errors.New("unknown validator set", "valset_id", valSetID)
+	}
+
+	if err := VerifyQuorum(vals, attRoot, sigs); err != nil {
+		return errors.Wrap(err, "verify quorum", "valset_id", valSetID)
+	}
+
+	return nil
+}
+
+// applyValSets adds the validator sets of any consensus chain validator set messages.
+// The messages MUST be verified.
+func (v *Verifier) applyValSets(sourceChainID uint64, msgs []xchain.Msg) error {
+	if sourceChainID != v.cChainID {
+		return nil
+	}
+
+	for _, msg := range msgs {
+		if !isValSetMsg(msg) {
+			continue
+		}
+
+		valSetID, vals, err := xchain.DecodeAddValidatorSet(msg.Data)
+		if err != nil {
+			return errors.Wrap(err, "decode validator set", "offset", msg.StreamOffset)
+		}
+
+		portalVals := make([]cchain.PortalValidator, 0, len(vals))
+		for _, val := range vals {
+			power, err := umath.ToInt64(val.Power)
+			if err != nil {
+				return errors.Wrap(err, "validator power", "valset_id", valSetID)
+			}
+
+			portalVals = append(portalVals, cchain.PortalValidator{
+				Address: val.Addr,
+				Power:   power,
+			})
+		}
+
+		if err := v.addValSet(valSetID, portalVals); err != nil {
+			return errors.Wrap(err, "add validator set", "valset_id", valSetID)
+		}
+	}
+
+	return nil
+}
+
+// addValSet adds the validator set if not already tracked.
+func (v *Verifier) addValSet(valSetID uint64, vals []cchain.PortalValidator) error {
+	if valSetID == 0 {
+		return errors.New("zero validator set ID")
+	} else if err := verifyValSet(vals); err != nil {
+		return err
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if existing, ok := v.valSets[valSetID]; ok {
+		if !slices.Equal(existing, vals) {
+			return errors.New("conflicting validator set", "valset_id", valSetID)
+		}
+
+		return nil // Replayed validator set, ignore.
+	}
+
+	v.valSets[valSetID] = vals
+	v.latest = max(v.latest, valSetID)
+
+	return nil
+}
+
+// isValSetMsg returns true if the consensus chain message is a validator set system call.
+// See OmniPortal._exec for the corresponding syscall validation.
+func isValSetMsg(msg xchain.Msg) bool {
+	return msg.DestChainID == xchain.BroadcastChainID &&
+		msg.ShardID == xchain.ShardBroadcast0 &&
+		msg.SourceMsgSender == common.Address{} &&
+		msg.DestAddress == common.Address{} &&
+		bytes.HasPrefix(msg.Data, addValSetMethodID)
+}
+
+// mustMethodID returns the ID of the named method in the metadata's ABI.
+// It panics on error.
+func mustMethodID(metadata *bind.MetaData, name string) []byte {
+	parsed, err := metadata.GetAbi()
+	if err != nil {
+		panic(err)
+	}
+
+	method, ok := parsed.Methods[name]
+	if !ok {
+		panic("missing method: " + name)
+	}
+
+	return method.ID
+}
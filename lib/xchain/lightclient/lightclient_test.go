@@ -0,0 +1,269 @@
+package lightclient_test
+
+import (
+	"testing"
+
+	"github.com/omni-network/omni/contracts/bindings"
+	"github.com/omni-network/omni/lib/cchain"
+	"github.com/omni-network/omni/lib/k1util"
+	"github.com/omni-network/omni/lib/xchain"
+	"github.com/omni-network/omni/lib/xchain/lightclient"
+
+	"github.com/cometbft/cometbft/crypto"
+	k1 "github.com/cometbft/cometbft/crypto/secp256k1"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	cChainID  = 1654
+	srcChain  = 100
+	destChain = 200
+)
+
+func TestVerifySubmission(t *testing.T) {
+	t.Parallel()
+
+	keys, vals := makeValSet(t, 4)
+	verifier, err := lightclient.New(cChainID, 1, vals)
+	require.NoError(t, err)
+
+	var msgs []xchain.Msg
+	for i := uint64(1); i <= 5; i++ {
+		msgs = append(msgs, xchain.Msg{
+			MsgID: xchain.MsgID{
+				StreamID: xchain.StreamID{
+					SourceChainID: srcChain,
+					DestChainID:   destChain,
+					ShardID:       xchain.ShardFinalized0,
+				},
+				StreamOffset: i,
+			},
+			SourceMsgSender: common.HexToAddress("0x01"),
+			DestAddress:     common.HexToAddress("0x02"),
+			Data:            []byte{byte(i)},
+			DestGasLimit:    100_000,
+			LogIndex:        i * 2,
+		})
+	}
+
+	att := makeAttestation(t, keys[:3], xchain.BlockHeader{ChainID: srcChain, BlockHeight: 99}, msgs)
+	makeSub := func() xchain.Submission {
+		return makeSubmission(t, att, msgs, msgs[1:4])
+	}
+
+	require.NoError(t, verifier.VerifyAttestation(att))
+	require.NoError(t, verifier.VerifySubmission(makeSub()))
+
+	tests := []struct {
+		Name   string
+		Mutate func(*xchain.Submission)
+		Err    string
+	}{
+		{
+			Name:   "tampered msg",
+			Mutate: func(s *xchain.Submission) { s.Msgs[0].Data = []byte("evil") },
+			Err:    "attestation root mismatch",
+		},
+		{
+			Name:   "missing msg",
+			Mutate: func(s *xchain.Submission) { s.Msgs = s.Msgs[1:] },
+			Err:    "msg proof",
+		},
+		{
+			Name:   "tampered block header",
+			Mutate: func(s *xchain.Submission) { s.BlockHeader.BlockHeight++ },
+			Err:    "attestation root mismatch",
+		},
+		{
+			Name:   "insufficient signatures",
+			Mutate: func(s *xchain.Submission) { s.Signatures = s.Signatures[:2] },
+			Err:    "quorum not reached",
+		},
+		{
+			Name:   "duplicate signature",
+			Mutate: func(s *xchain.Submission) { s.Signatures[1] = s.Signatures[0] },
+			Err:    "duplicate validator signature",
+		},
+		{
+			Name:   "invalid signature",
+			Mutate: func(s *xchain.Submission) { s.Signatures[0].ValidatorAddress = s.Signatures[1].ValidatorAddress },
+			Err:    "invalid attestation signature",
+		},
+		{
+			Name:   "unknown validator set",
+			Mutate: func(s *xchain.Submission) { s.ValidatorSetID = 2 },
+			Err:    "unknown validator set",
+		},
+		{
+			Name:   "wrong consensus chain",
+			Mutate: func(s *xchain.Submission) { s.AttHeader.ConsensusChainID++ },
+			Err:    "consensus chain mismatch",
+		},
+		{
+			Name:   "empty submission",
+			Mutate: func(s *xchain.Submission) { s.Msgs = nil },
+			Err:    "empty submission",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			sub := makeSub()
+			test.Mutate(&sub)
+			require.ErrorContains(t, verifier.VerifySubmission(sub), test.Err)
+		})
+	}
+}
+
+func TestValSetTransitions(t *testing.T) {
+	t.Parallel()
+
+	keys1, vals1 := makeValSet(t, 3)
+	keys2, vals2 := makeValSet(t, 2)
+
+	verifier, err := lightclient.New(cChainID, 1, vals1)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, verifier.LatestValSetID())
+
+	// Valset 2 isn't tracked yet.
+	block := xchain.BlockHeader{ChainID: srcChain, BlockHeight: 1}
+	att2 := makeAttestation(t, keys2, block, nil)
+	att2.ValidatorSetID = 2
+	require.ErrorContains(t, verifier.VerifyAttestation(att2), "unknown validator set")
+
+	// Consensus chain block containing valset 2 attested by valset 1.
+	cBlock := xchain.Block{
+		BlockHeader: xchain.BlockHeader{ChainID: cChainID, BlockHeight: 10},
+		Msgs:        []xchain.Msg{makeValSetMsg(t, 2, vals2)},
+	}
+	cAtt := makeAttestation(t, keys1, cBlock.BlockHeader, cBlock.Msgs)
+
+	// Tampered blocks are rejected and not applied.
+	tampered := cBlock
+	tampered.Msgs = []xchain.Msg{makeValSetMsg(t, 2, vals1)}
+	require.ErrorContains(t, verifier.VerifyBlock(cAtt, tampered), "msg root mismatch")
+	_, ok := verifier.ValSet(2)
+	require.False(t, ok)
+
+	require.NoError(t, verifier.VerifyBlock(cAtt, cBlock))
+	require.EqualValues(t, 2, verifier.LatestValSetID())
+	vals, ok := verifier.ValSet(2)
+	require.True(t, ok)
+	require.Equal(t, vals2, vals)
+
+	// Valset 2 attestations are now verified, and replays are ignored.
+	require.NoError(t, verifier.VerifyAttestation(att2))
+	require.NoError(t, verifier.VerifyBlock(cAtt, cBlock))
+
+	// Valset 1 signatures are not valid for valset 2.
+	att2.Signatures = makeAttestation(t, keys1, block, nil).Signatures
+	require.ErrorContains(t, verifier.VerifyAttestation(att2), "signature not in validator set")
+}
+
+func makeValSet(t *testing.T, n int) ([]crypto.PrivKey, []cchain.PortalValidator) {
+	t.Helper()
+
+	var keys []crypto.PrivKey
+	var vals []cchain.PortalValidator
+	for i := 0; i < n; i++ {
+		key := k1.GenPrivKey()
+		addr, err := k1util.PubKeyToAddress(key.PubKey())
+		require.NoError(t, err)
+
+		keys = append(keys, key)
+		vals = append(vals, cchain.PortalValidator{Address: addr, Power: 10})
+	}
+
+	return keys, vals
+}
+
+func makeAttestation(t *testing.T, keys []crypto.PrivKey, header xchain.BlockHeader, msgs []xchain.Msg) xchain.Attestation {
+	t.Helper()
+
+	var msgRoot common.Hash
+	if len(msgs) > 0 {
+		tree, err := xchain.NewMsgTree(msgs)
+		require.NoError(t, err)
+		msgRoot = tree.MsgRoot()
+	}
+
+	att := xchain.Attestation{
+		AttestHeader: xchain.AttestHeader{
+			ConsensusChainID: cChainID,
+			ChainVersion:     xchain.ChainVersion{ID: header.ChainID, ConfLevel: xchain.ConfFinalized},
+			AttestOffset:     header.BlockHeight,
+		},
+		BlockHeader:    header,
+		MsgRoot:        msgRoot,
+		ValidatorSetID: 1,
+	}
+
+	attRoot, err := att.AttestationRoot()
+	require.NoError(t, err)
+
+	for _, key := range keys {
+		sig, err := k1util.Sign(key, attRoot)
+		require.NoError(t, err)
+		addr, err := k1util.PubKeyToAddress(key.PubKey())
+		require.NoError(t, err)
+
+		att.Signatures = append(att.Signatures, xchain.SigTuple{ValidatorAddress: addr, Signature: sig})
+	}
+
+	return att
+}
+
+func makeSubmission(t *testing.T, att xchain.Attestation, all []xchain.Msg, msgs []xchain.Msg) xchain.Submission {
+	t.Helper()
+
+	tree, err := xchain.NewMsgTree(all)
+	require.NoError(t, err)
+
+	multi, err := tree.Proof(msgs)
+	require.NoError(t, err)
+
+	attRoot, err := att.AttestationRoot()
+	require.NoError(t, err)
+
+	return xchain.Submission{
+		AttestationRoot: attRoot,
+		ValidatorSetID:  att.ValidatorSetID,
+		AttHeader:       att.AttestHeader,
+		BlockHeader:     att.BlockHeader,
+		Msgs:            append([]xchain.Msg(nil), msgs...),
+		Proof:           multi.Proof,
+		ProofFlags:      multi.ProofFlags,
+		Signatures:      append([]xchain.SigTuple(nil), att.Signatures...),
+		DestChainID:     destChain,
+	}
+}
+
+func makeValSetMsg(t *testing.T, valSetID uint64, vals []cchain.PortalValidator) xchain.Msg {
+	t.Helper()
+
+	portalABI, err := bindings.OmniPortalMetaData.GetAbi()
+	require.NoError(t, err)
+
+	var portalVals []bindings.Validator
+	for _, val := range vals {
+		portalVals = append(portalVals, bindings.Validator{Addr: val.Address, Power: uint64(val.Power)})
+	}
+
+	data, err := portalABI.Pack("addValidatorSet", valSetID, portalVals)
+	require.NoError(t, err)
+
+	return xchain.Msg{
+		MsgID: xchain.MsgID{
+			StreamID: xchain.StreamID{
+				SourceChainID: cChainID,
+				DestChainID:   xchain.BroadcastChainID,
+				ShardID:       xchain.ShardBroadcast0,
+			},
+			StreamOffset: valSetID,
+		},
+		Data: data,
+	}
+}
@@ -0,0 +1,72 @@
+package lightclient
+
+import (
+	"github.com/omni-network/omni/lib/cchain"
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/k1util"
+	"github.com/omni-network/omni/lib/xchain"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// VerifyQuorum returns an error if any signature over the attestation root is invalid, duplicated or not
+// by a member of the validator set, or if the signers' power doesn't exceed 2/3 of the total power.
+// This matches the quorum rules of halo attestation approval and portal submissions.
+func VerifyQuorum(vals []cchain.PortalValidator, attRoot common.Hash, sigs []xchain.SigTuple) error {
+	if err := verifyValSet(vals); err != nil {
+		return err
+	}
+
+	var total int64
+	powers := make(map[common.Address]int64)
+	for _, val := range vals {
+		total += val.Power
+		powers[val.Address] = val.Power
+	}
+
+	var sum int64
+	signed := make(map[common.Address]bool)
+	for _, sig := range sigs {
+		power, ok := powers[sig.ValidatorAddress]
+		if !ok {
+			return errors.New("signature not in validator set", "validator", sig.ValidatorAddress)
+		} else if signed[sig.ValidatorAddress] {
+			return errors.New("duplicate validator signature", "validator", sig.ValidatorAddress)
+		}
+
+		ok, err := k1util.Verify(sig.ValidatorAddress, attRoot, sig.Signature)
+		if err != nil {
+			return errors.Wrap(err, "verify signature", "validator", sig.ValidatorAddress)
+		} else if !ok {
+			return errors.New("invalid attestation signature", "validator", sig.ValidatorAddress)
+		}
+
+		signed[sig.ValidatorAddress] = true
+		sum += power
+	}
+
+	if quorum := total * 2 / 3; sum <= quorum {
+		return errors.New("quorum not reached", "got", sum, "need", quorum+1)
+	}
+
+	return nil
+}
+
+// verifyValSet returns an error if the validator set is empty or contains invalid or duplicate validators.
+func verifyValSet(vals []cchain.PortalValidator) error {
+	if len(vals) == 0 {
+		return errors.New("empty validator set")
+	}
+
+	dups := make(map[common.Address]bool)
+	for _, val := range vals {
+		if err := val.Verify(); err != nil {
+			return err
+		} else if dups[val.Address] {
+			return errors.New("duplicate validator", "validator", val.Address)
+		}
+		dups[val.Address] = true
+	}
+
+	return nil
+}
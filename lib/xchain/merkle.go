@@ -93,6 +93,30 @@ func NewMsgTree(msgs []Msg) (MsgTree, error) {
 	}, nil
 }
 
+// MsgRootFromProof returns the message merkle root given a subset of a cross-chain block's messages
+// (ordered by log index) and their merkle multi proof. It is the inverse of MsgTree.Proof.
+func MsgRootFromProof(msgs []Msg, proof [][32]byte, proofFlags []bool) (common.Hash, error) {
+	leaves := make([][32]byte, 0, len(msgs))
+	for _, msg := range msgs {
+		leaf, err := msgLeaf(msg)
+		if err != nil {
+			return common.Hash{}, err
+		}
+		leaves = append(leaves, leaf)
+	}
+
+	root, err := merkle.ProcessMultiProof(merkle.MultiProof{
+		Leaves:     leaves,
+		Proof:      proof,
+		ProofFlags: proofFlags,
+	})
+	if err != nil {
+		return common.Hash{}, errors.Wrap(err, "process multi proof")
+	}
+
+	return root, nil
+}
+
 func msgLeaf(msg Msg) ([32]byte, error) {
 	bz, err := encodeMsg(msg)
 	if err != nil {
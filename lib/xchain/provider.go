@@ -118,6 +118,8 @@ func HeightRef(height uint64) Ref {
 var (
 	// LatestRef references the latest confirmation level.
 	LatestRef = ConfRef(ConfLatest)
+	// SafeRef references the safe confirmation level.
+	SafeRef = ConfRef(ConfSafe)
 	// FinalizedRef references the latest confirmation level.
 	FinalizedRef = ConfRef(ConfFinalized)
 )
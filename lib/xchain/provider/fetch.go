@@ -9,6 +9,7 @@ import (
 	"github.com/omni-network/omni/lib/cchain"
 	"github.com/omni-network/omni/lib/errors"
 	"github.com/omni-network/omni/lib/ethclient"
+	"github.com/omni-network/omni/lib/forkjoin"
 	"github.com/omni-network/omni/lib/tracer"
 	"github.com/omni-network/omni/lib/umath"
 	"github.com/omni-network/omni/lib/xchain"
@@ -233,27 +234,117 @@ func (p *Provider) GetBlock(ctx context.Context, req xchain.ProviderRequest) (xc
 		return xchain.Block{}, false, errors.Wrap(err, "get msgs and receipts")
 	}
 
-	timeSecs, err := umath.ToInt64(header.Time)
+	block, err := newXBlock(req.ChainID, header, msgs, receipts)
 	if err != nil {
 		return xchain.Block{}, false, err
 	}
 
+	return block, true, nil
+}
+
+// getBlockRange returns the xblocks of the EVM chain for the inclusive height range.
+// It fetches the xmsg and xreceipt logs of the whole range with a single query,
+// but still fetches each header (concurrently), ensuring the logs match the header block hashes.
+func (p *Provider) getBlockRange(ctx context.Context, chainID uint64, from, to uint64) ([]xchain.Block, error) {
+	ctx, span := tracer.Start(ctx, spanName("get_block_range"))
+	defer span.End()
+
+	chain, ethCl, err := p.getEVMChain(chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	msgTopic, receiptTopic, err := portalTopics()
+	if err != nil {
+		return nil, err
+	}
+
+	logs, err := getEventLogsRange(ctx, ethCl, chain.PortalAddress, from, to, []common.Hash{msgTopic, receiptTopic})
+	if err != nil {
+		return nil, errors.Wrap(err, "get logs range")
+	}
+
+	headers, err := getHeadersRange(ctx, ethCl, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make([]xchain.Block, 0, to-from+1)
+	for height := from; height <= to; height++ {
+		header := headers[height]
+		for _, log := range logs[height] {
+			if log.BlockHash != header.Hash() {
+				return nil, errors.New("log block hash mismatch (reorg?)", "height", height)
+			}
+		}
+
+		msgs, receipts, err := p.parseMsgsAndReceipts(chainID, logs[height])
+		if err != nil {
+			return nil, errors.Wrap(err, "parse msgs and receipts", "height", height)
+		}
+
+		block, err := newXBlock(chainID, header, msgs, receipts)
+		if err != nil {
+			return nil, err
+		}
+
+		resp = append(resp, block)
+	}
+
+	return resp, nil
+}
+
+// getHeadersRange returns the headers of the inclusive height range by height.
+// Headers are fetched concurrently, since catching up requires a query per height.
+func getHeadersRange(ctx context.Context, ethCl ethclient.Client, from, to uint64) (map[uint64]*types.Header, error) {
+	heights := make([]uint64, 0, to-from+1)
+	for height := from; height <= to; height++ {
+		heights = append(heights, height)
+	}
+
+	fetch := func(ctx context.Context, height uint64) (*types.Header, error) {
+		return ethCl.HeaderByNumber(ctx, umath.NewBigInt(height))
+	}
+
+	results, cancel := forkjoin.NewWithInputs(ctx, fetch, heights,
+		forkjoin.WithWorkers(headerWorkers),
+		forkjoin.WithInputBuffer(len(heights)),
+	)
+	defer cancel()
+
+	resp := make(map[uint64]*types.Header, len(heights))
+	for res := range results {
+		if res.Err != nil {
+			return nil, errors.Wrap(res.Err, "header by number", "height", res.Input)
+		}
+
+		resp[res.Input] = res.Output
+	}
+
+	return resp, nil
+}
+
+// newXBlock returns a xblock constructed from the EVM header, xmsgs and xreceipts.
+func newXBlock(chainID uint64, header *types.Header, msgs []xchain.Msg, receipts []xchain.Receipt) (xchain.Block, error) {
+	timeSecs, err := umath.ToInt64(header.Time)
+	if err != nil {
+		return xchain.Block{}, err
+	}
+
 	return xchain.Block{
 		BlockHeader: xchain.BlockHeader{
-			ChainID:     req.ChainID,
-			BlockHeight: req.Height,
+			ChainID:     chainID,
+			BlockHeight: header.Number.Uint64(),
 			BlockHash:   header.Hash(),
 		},
 		Msgs:       msgs,
 		Receipts:   receipts,
 		ParentHash: header.ParentHash,
 		Timestamp:  time.Unix(timeSecs, 0),
-	}, true, nil
+	}, nil
 }
 
 // getMsgsAndReceipts returns the xmsgs and xreceipts for the chain and block hash.
-//
-//nolint:nestif // Not worth refactoring
 func (p *Provider) getMsgsAndReceipts(ctx context.Context, chainID uint64, blockHash common.Hash) ([]xchain.Msg, []xchain.Receipt, error) {
 	ctx, span := tracer.Start(ctx, spanName("get_mgs_and_receipts"))
 	defer span.End()
@@ -263,25 +354,51 @@ func (p *Provider) getMsgsAndReceipts(ctx context.Context, chainID uint64, block
 		return nil, nil, errors.Wrap(err, "get evm chain")
 	}
 
+	msgTopic, receiptTopic, err := portalTopics()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	events, err := getEventLogs(ctx, rpcClient, chain.PortalAddress, blockHash, []common.Hash{msgTopic, receiptTopic})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "get logs")
+	}
+
+	return p.parseMsgsAndReceipts(chainID, events)
+}
+
+// portalTopics returns the portal XMsg and XReceipt event topics.
+func portalTopics() (common.Hash, common.Hash, error) {
 	portalAbi, err := bindings.OmniPortalMetaData.GetAbi()
 	if err != nil {
-		return nil, nil, errors.Wrap(err, "get abi")
+		return common.Hash{}, common.Hash{}, errors.Wrap(err, "get abi")
 	}
 
 	msgEvent, ok := portalAbi.Events["XMsg"]
 	if !ok {
-		return nil, nil, errors.New("missing XMsg event [BUG]")
+		return common.Hash{}, common.Hash{}, errors.New("missing XMsg event [BUG]")
 	}
 
 	receiptEvent, ok := portalAbi.Events["XReceipt"]
 	if !ok {
-		return nil, nil, errors.New("missing XReceipt event [BUG]")
+		return common.Hash{}, common.Hash{}, errors.New("missing XReceipt event [BUG]")
 	}
 
-	topics := []common.Hash{msgEvent.ID, receiptEvent.ID}
-	events, err := getEventLogs(ctx, rpcClient, chain.PortalAddress, blockHash, topics)
+	return msgEvent.ID, receiptEvent.ID, nil
+}
+
+// parseMsgsAndReceipts returns the xmsgs and xreceipts parsed from the chain's portal event logs.
+//
+//nolint:nestif // Not worth refactoring
+func (p *Provider) parseMsgsAndReceipts(chainID uint64, events []types.Log) ([]xchain.Msg, []xchain.Receipt, error) {
+	chain, rpcClient, err := p.getEVMChain(chainID)
 	if err != nil {
-		return nil, nil, errors.Wrap(err, "get logs")
+		return nil, nil, errors.Wrap(err, "get evm chain")
+	}
+
+	msgTopic, receiptTopic, err := portalTopics()
+	if err != nil {
+		return nil, nil, err
 	}
 
 	expectedShards := make(map[xchain.ShardID]bool)
@@ -308,7 +425,7 @@ func (p *Provider) getMsgsAndReceipts(ctx context.Context, chainID uint64, block
 	var msgs []xchain.Msg
 	var receipts []xchain.Receipt
 	for _, event := range events {
-		if event.Topics[0] == msgEvent.ID {
+		if event.Topics[0] == msgTopic {
 			msg, err := parseXMsg(filterer, event, chainID)
 			if err != nil {
 				return nil, nil, err
@@ -317,7 +434,7 @@ func (p *Provider) getMsgsAndReceipts(ctx context.Context, chainID uint64, block
 			}
 
 			msgs = append(msgs, msg)
-		} else if event.Topics[0] == receiptEvent.ID {
+		} else if event.Topics[0] == receiptTopic {
 			receipt, err := parseXReceipt(filterer, event, chainID)
 			if err != nil {
 				return nil, nil, err
@@ -363,6 +480,14 @@ func (p *Provider) confirmedCache(chainVer xchain.ChainVersion, height uint64) b
 	return p.confHeads[chainVer] >= height
 }
 
+// cachedHead returns the cached confirmed head height of the chain version, or zero if not cached yet.
+func (p *Provider) cachedHead(chainVer xchain.ChainVersion) uint64 {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.confHeads[chainVer]
+}
+
 // headerByChainVersion returns the chain's header by confirmation level (finalization/latest)
 // by querying via ethclient. It caches the result.
 func (p *Provider) headerByChainVersion(ctx context.Context, chainVer xchain.ChainVersion) (*types.Header, error) {
@@ -471,6 +596,8 @@ func headTypeFromConfLevel(conf xchain.ConfLevel) (ethclient.HeadType, bool) {
 	switch conf {
 	case xchain.ConfLatest:
 		return ethclient.HeadLatest, true
+	case xchain.ConfSafe:
+		return ethclient.HeadSafe, true
 	case xchain.ConfFinalized:
 		return ethclient.HeadFinalized, true
 	default:
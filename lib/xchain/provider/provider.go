@@ -151,6 +151,15 @@ func (p *Provider) stream(
 		fromHeight = chain.DeployHeight
 	}
 
+	// Catch up via block range log queries if far behind the head.
+	// Note that consensus chain heads are never cached, so it is always fetched per block.
+	ranges := newRangeFetcher(
+		func() uint64 { return p.cachedHead(req.ChainVersion()) },
+		func(ctx context.Context, from, to uint64) ([]xchain.Block, error) {
+			return p.getBlockRange(ctx, req.ChainID, from, to)
+		},
+	)
+
 	deps := stream.Deps[xchain.Block]{
 		FetchWorkers: workers,
 		FetchBatch: func(ctx context.Context, height uint64) ([]xchain.Block, error) {
@@ -160,11 +169,19 @@ func (p *Provider) stream(
 				ConfLevel: req.ConfLevel,
 			}
 
+			fetch := func(ctx context.Context) (xchain.Block, bool, error) {
+				if xBlock, ok, err := ranges.Fetch(ctx, height); err != nil || ok {
+					return xBlock, ok, err
+				}
+
+				return p.GetBlock(ctx, fetchReq)
+			}
+
 			var lastErr error
 			const retryCount = 5
 			backoff := expbackoff.New(ctx, expbackoff.WithPeriodicConfig(time.Millisecond*100))
 			for i := 0; i < retryCount; i++ {
-				xBlock, exists, err := p.GetBlock(ctx, fetchReq)
+				xBlock, exists, err := fetch(ctx)
 				if err != nil {
 					lastErr = err
 					backoff()
@@ -0,0 +1,202 @@
+package provider
+
+import (
+	"context"
+	"sync"
+
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/ethclient"
+	"github.com/omni-network/omni/lib/umath"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+const (
+	// catchUpThreshold is the minimum number of blocks a height must be behind the
+	// confirmed chain version head to be fetched via block range log queries.
+	// Heights closer to the head are fetched per block hash, since reorgs matter there.
+	catchUpThreshold = 64
+
+	// Range sizes bound the adaptive number of blocks queried per eth_getLogs call.
+	// Providers limit either the block range or the number of results per query,
+	// so the size is halved on errors and increased additively on success.
+	initRangeSize = 1000
+	minRangeSize  = 1
+	maxRangeSize  = 10000
+	rangeSizeStep = 100
+
+	// headerWorkers is the number of concurrent header queries per fetched block range.
+	headerWorkers = 16
+)
+
+// rangeFetcher fetches stream elements far behind the chain head by querying
+// event logs over adaptive block ranges instead of per block hash.
+//
+// Since lib/stream only supports concurrent fetching of single-element-batches,
+// the elements of each range are buffered and returned one height at a time.
+// Ranges are fetched concurrently without holding the lock, but never overlap:
+// workers wait for in-flight ranges including their height instead of refetching it.
+type rangeFetcher[E any] struct {
+	// cachedHead returns the cached confirmed chain version head.
+	cachedHead func() uint64
+	// fetchRange returns the elements of all heights in the inclusive range.
+	fetchRange func(ctx context.Context, from, to uint64) ([]E, error)
+
+	mu       sync.Mutex
+	size     uint64
+	buffer   map[uint64]E
+	inflight map[*inflightRange]struct{}
+}
+
+// inflightRange is an inclusive block range being fetched. Done is closed once fetched (or failed).
+type inflightRange struct {
+	from, to uint64
+	done     chan struct{}
+}
+
+func newRangeFetcher[E any](
+	cachedHead func() uint64,
+	fetchRange func(ctx context.Context, from, to uint64) ([]E, error),
+) *rangeFetcher[E] {
+	return &rangeFetcher[E]{
+		cachedHead: cachedHead,
+		fetchRange: fetchRange,
+		size:       initRangeSize,
+		buffer:     make(map[uint64]E),
+		inflight:   make(map[*inflightRange]struct{}),
+	}
+}
+
+// Fetch returns the element at the height and true if it was fetched via a block range,
+// or false if the height isn't far enough behind the cached head (i.e., fetch it per block hash).
+func (f *rangeFetcher[E]) Fetch(ctx context.Context, height uint64) (E, bool, error) {
+	var zero E
+	for {
+		f.mu.Lock()
+
+		if elem, ok := f.buffer[height]; ok {
+			delete(f.buffer, height)
+			f.mu.Unlock()
+
+			return elem, true, nil
+		}
+
+		// Wait for any in-flight range including the height, then check the buffer again.
+		// If that range failed, the height is fetched below.
+		if r, ok := f.inflightIncluding(height); ok {
+			f.mu.Unlock()
+
+			select {
+			case <-ctx.Done():
+				return zero, false, errors.Wrap(ctx.Err(), "wait for range")
+			case <-r.done:
+				continue
+			}
+		}
+
+		// Note that the head cache is only refreshed by per block fetches when reaching it.
+		// This avoids additional head queries when following the chain.
+		head := f.cachedHead()
+		if head < height+catchUpThreshold {
+			f.mu.Unlock()
+
+			return zero, false, nil
+		}
+
+		to := min(height+f.size-1, head-catchUpThreshold)
+
+		// Don't overlap in-flight ranges of higher heights.
+		for r := range f.inflight {
+			if r.from > height {
+				to = min(to, r.from-1)
+			}
+		}
+
+		r := &inflightRange{from: height, to: to, done: make(chan struct{})}
+		f.inflight[r] = struct{}{}
+		f.mu.Unlock()
+
+		elems, err := f.fetchRange(ctx, height, to)
+
+		return f.completeRange(r, elems, err)
+	}
+}
+
+// inflightIncluding returns the in-flight range including the height, if any.
+// It must be called while holding the lock.
+func (f *rangeFetcher[E]) inflightIncluding(height uint64) (*inflightRange, bool) {
+	for r := range f.inflight {
+		if r.from <= height && height <= r.to {
+			return r, true
+		}
+	}
+
+	return nil, false
+}
+
+// completeRange buffers the fetched range elements (excluding the first, which is returned),
+// adapts the range size and releases any workers waiting for the range.
+func (f *rangeFetcher[E]) completeRange(r *inflightRange, elems []E, err error) (E, bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	defer close(r.done)
+
+	delete(f.inflight, r)
+
+	var zero E
+	if err != nil {
+		f.size = max(f.size/2, minRangeSize)
+		return zero, false, errors.Wrap(err, "fetch range", "from", r.from, "to", r.to)
+	} else if umath.Len(elems) != r.to-r.from+1 {
+		return zero, false, errors.New("unexpected range length [BUG]")
+	}
+
+	f.size = min(f.size+rangeSizeStep, maxRangeSize)
+
+	for i, elem := range elems[1:] {
+		f.buffer[r.from+uint64(i)+1] = elem
+	}
+
+	return elems[0], true, nil
+}
+
+// getEventLogsRange returns the logs for the contract address and inclusive block range with any of the
+// provided topics in the first position, grouped by block height.
+func getEventLogsRange(ctx context.Context, rpcClient ethclient.Client, contractAddr common.Address, from, to uint64, topics []common.Hash) (map[uint64][]types.Log, error) {
+	logs, err := rpcClient.FilterLogs(ctx, ethereum.FilterQuery{
+		FromBlock: umath.NewBigInt(from),
+		ToBlock:   umath.NewBigInt(to),
+		Addresses: []common.Address{contractAddr},
+		Topics:    [][]common.Hash{topics}, // Match any of the topics in the first position.
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "filter logs")
+	}
+
+	// Ensure events are valid and sorted by height and index.
+	resp := make(map[uint64][]types.Log)
+	for i, log := range logs {
+		if log.BlockNumber < from || log.BlockNumber > to {
+			return nil, errors.New("log height out of range", "index", i, "height", log.BlockNumber)
+		} else if len(log.Topics) == 0 {
+			return nil, errors.New("missing log topics", "index", i)
+		}
+
+		if i > 0 {
+			prev := logs[i-1]
+			if log.BlockNumber < prev.BlockNumber {
+				return nil, errors.New("unordered log height", "index", i)
+			} else if log.BlockNumber == prev.BlockNumber && log.Index <= prev.Index {
+				return nil, errors.New("unordered log index", "index", i)
+			} else if log.BlockNumber == prev.BlockNumber && log.BlockHash != prev.BlockHash {
+				return nil, errors.New("inconsistent log block hash", "index", i)
+			}
+		}
+
+		resp[log.BlockNumber] = append(resp[log.BlockNumber], log)
+	}
+
+	return resp, nil
+}
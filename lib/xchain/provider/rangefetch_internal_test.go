@@ -0,0 +1,211 @@
+package provider
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/ethclient/mock"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/sync/errgroup"
+)
+
+func TestRangeFetcher(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	type fetched struct{ From, To uint64 }
+
+	var (
+		head    uint64
+		fails   int
+		queries []fetched
+	)
+
+	f := newRangeFetcher(
+		func() uint64 { return head },
+		func(_ context.Context, from, to uint64) ([]uint64, error) {
+			queries = append(queries, fetched{From: from, To: to})
+			if fails > 0 {
+				fails--
+				return nil, errors.New("range too large")
+			}
+
+			var resp []uint64
+			for h := from; h <= to; h++ {
+				resp = append(resp, h)
+			}
+
+			return resp, nil
+		},
+	)
+
+	// Not cached head, fetch per block
+	_, ok, err := f.Fetch(ctx, 1)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// Near the head, fetch per block
+	head = 1 + catchUpThreshold - 1
+	_, ok, err = f.Fetch(ctx, 1)
+	require.NoError(t, err)
+	require.False(t, ok)
+	require.Empty(t, queries)
+
+	// Far behind the head, fetch range up to the threshold
+	head = 1 + catchUpThreshold + 10
+	for h := uint64(1); h <= 11; h++ {
+		elem, ok, err := f.Fetch(ctx, h)
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.Equal(t, h, elem)
+	}
+	require.Equal(t, []fetched{{From: 1, To: 11}}, queries)
+	require.EqualValues(t, initRangeSize+rangeSizeStep, f.size)
+	require.Empty(t, f.buffer)
+
+	// Range size is halved on errors and increased on success
+	head = 100_000
+	fails = 2
+	for i := 0; i < 2; i++ {
+		_, _, err = f.Fetch(ctx, 12)
+		require.Error(t, err)
+	}
+	require.EqualValues(t, (initRangeSize+rangeSizeStep)/4, f.size)
+
+	elem, ok, err := f.Fetch(ctx, 12)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.EqualValues(t, 12, elem)
+	require.Equal(t, fetched{From: 12, To: 12 + (initRangeSize+rangeSizeStep)/4 - 1}, queries[len(queries)-1])
+	require.EqualValues(t, (initRangeSize+rangeSizeStep)/4+rangeSizeStep, f.size)
+}
+
+func TestRangeFetcherConcurrent(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	const (
+		workers = 8
+		heights = 5000
+	)
+
+	var (
+		mu      sync.Mutex
+		fetched = make(map[uint64]int)
+	)
+
+	f := newRangeFetcher(
+		func() uint64 { return heights + catchUpThreshold },
+		func(_ context.Context, from, to uint64) ([]uint64, error) {
+			time.Sleep(time.Millisecond) // Allow other workers to race.
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			var resp []uint64
+			for h := from; h <= to; h++ {
+				fetched[h]++
+				resp = append(resp, h)
+			}
+
+			return resp, nil
+		},
+	)
+
+	// Workers fetch heights concurrently, like lib/stream.
+	var (
+		eg   errgroup.Group
+		next atomic.Uint64
+	)
+	for i := 0; i < workers; i++ {
+		eg.Go(func() error {
+			for {
+				h := next.Add(1)
+				if h > heights {
+					return nil
+				}
+
+				elem, ok, err := f.Fetch(ctx, h)
+				if err != nil {
+					return err
+				} else if !ok || elem != h {
+					return errors.New("unexpected element", "height", h, "elem", elem, "ok", ok)
+				}
+			}
+		})
+	}
+	require.NoError(t, eg.Wait())
+
+	// Each height is fetched exactly once and nothing remains buffered.
+	require.Len(t, fetched, heights)
+	for h, count := range fetched {
+		require.Equal(t, 1, count, "height %d", h)
+	}
+	require.Empty(t, f.buffer)
+	require.Empty(t, f.inflight)
+}
+
+func TestGetEventLogsRange(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	var (
+		addr  = common.HexToAddress("0x1234")
+		topic = common.HexToHash("0x5678")
+		hash1 = common.HexToHash("0x01")
+		hash3 = common.HexToHash("0x03")
+	)
+
+	newLog := func(height uint64, hash common.Hash, index uint) types.Log {
+		return types.Log{
+			Address:     addr,
+			Topics:      []common.Hash{topic},
+			BlockNumber: height,
+			BlockHash:   hash,
+			Index:       index,
+		}
+	}
+
+	ctrl := gomock.NewController(t)
+	ethCl := mock.NewMockClient(ctrl)
+
+	// Valid logs are grouped by height
+	ethCl.EXPECT().FilterLogs(gomock.Any(), gomock.Any()).Times(1).
+		DoAndReturn(func(_ context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+			require.EqualValues(t, 1, q.FromBlock.Uint64())
+			require.EqualValues(t, 3, q.ToBlock.Uint64())
+			require.Nil(t, q.BlockHash)
+
+			return []types.Log{newLog(1, hash1, 0), newLog(1, hash1, 2), newLog(3, hash3, 0)}, nil
+		})
+
+	logs, err := getEventLogsRange(ctx, ethCl, addr, 1, 3, []common.Hash{topic})
+	require.NoError(t, err)
+	require.Len(t, logs[1], 2)
+	require.Empty(t, logs[2])
+	require.Len(t, logs[3], 1)
+
+	// Invalid logs are rejected
+	invalids := map[string][]types.Log{
+		"log height out of range":     {newLog(4, hash3, 0)},
+		"unordered log height":        {newLog(3, hash3, 0), newLog(1, hash1, 0)},
+		"unordered log index":         {newLog(1, hash1, 1), newLog(1, hash1, 1)},
+		"inconsistent log block hash": {newLog(1, hash1, 0), newLog(1, hash3, 1)},
+	}
+	for expect, invalid := range invalids {
+		ethCl.EXPECT().FilterLogs(gomock.Any(), gomock.Any()).Times(1).Return(invalid, nil)
+
+		_, err := getEventLogsRange(ctx, ethCl, addr, 1, 3, []common.Hash{topic})
+		require.ErrorContains(t, err, expect)
+	}
+}
@@ -28,7 +28,7 @@ func (p *Provider) StreamEventLogs(ctx context.Context, req xchain.EventLogsReq,
 		return errors.New("invalid zero height")
 	}
 
-	chain, _, err := p.getEVMChain(req.ChainID)
+	chain, ethCl, err := p.getEVMChain(req.ChainID)
 	if err != nil {
 		return err
 	}
@@ -40,6 +40,28 @@ func (p *Provider) StreamEventLogs(ctx context.Context, req xchain.EventLogsReq,
 
 	chainVersionName := p.network.ChainVersionName(req.ChainVersion())
 
+	// Catch up via block range log queries if far behind the head.
+	// Reorgs are not a concern that far behind, so headers aren't fetched.
+	ranges := newRangeFetcher(
+		func() uint64 { return p.cachedHead(req.ChainVersion()) },
+		func(ctx context.Context, from, to uint64) ([]events, error) {
+			logs, err := getEventLogsRange(ctx, ethCl, req.FilterAddress, from, to, req.FilterTopics)
+			if err != nil {
+				return nil, err
+			}
+
+			resp := make([]events, 0, to-from+1)
+			for height := from; height <= to; height++ {
+				resp = append(resp, events{
+					Height: height,
+					Events: logs[height],
+				})
+			}
+
+			return resp, nil
+		},
+	)
+
 	deps := stream.Deps[events]{
 		FetchWorkers: workers,
 		FetchBatch: func(ctx context.Context, height uint64) ([]events, error) {
@@ -51,21 +73,28 @@ func (p *Provider) StreamEventLogs(ctx context.Context, req xchain.EventLogsReq,
 				FilterTopics:  req.FilterTopics,
 			}
 
+			fetch := func(ctx context.Context) (events, bool, error) {
+				if elem, ok, err := ranges.Fetch(ctx, height); err != nil || ok {
+					return elem, ok, err
+				}
+
+				logs, exists, err := p.GetEventLogs(ctx, fetchReq)
+
+				return events{Height: height, Events: logs}, exists, err
+			}
+
 			var lastErr error
 			const retryCount = 5
 			backoff := expbackoff.New(ctx, expbackoff.WithPeriodicConfig(time.Millisecond*100))
 			for i := 0; i < retryCount; i++ {
-				logs, exists, err := p.GetEventLogs(ctx, fetchReq)
+				elem, exists, err := fetch(ctx)
 				if err != nil {
 					lastErr = err
 					backoff()
 				} else if !exists {
 					return nil, nil
 				} else {
-					return []events{{
-						Height: height,
-						Events: logs,
-					}}, nil
+					return []events{elem}, nil
 				}
 			}
 
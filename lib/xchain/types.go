@@ -60,7 +60,7 @@ func (c ConfLevel) Label() string {
 const (
 	ConfUnknown   ConfLevel = 0 // unknown
 	ConfLatest    ConfLevel = 1 // latest
-	_             ConfLevel = 2 // reserved
+	ConfSafe      ConfLevel = 2 // safe
 	_             ConfLevel = 3 // reserved
 	ConfFinalized ConfLevel = 4 // final
 	confSentinel  ConfLevel = 5 // sentinel must always be last
@@ -68,7 +68,7 @@ const (
 
 // FuzzyConfLevels returns a list of all fuzzy confirmation levels.
 func FuzzyConfLevels() []ConfLevel {
-	return []ConfLevel{ConfLatest}
+	return []ConfLevel{ConfLatest, ConfSafe}
 }
 
 type ShardID uint64
@@ -80,6 +80,9 @@ const (
 	// ShardLatest0 is the default latest confirmation level shard.
 	ShardLatest0 = ShardID(ConfLatest)
 
+	// ShardSafe0 is the default safe confirmation level shard.
+	ShardSafe0 = ShardID(ConfSafe)
+
 	// ShardBroadcast0 is the default broadcast shard. It uses the finalized confirmation level.
 	ShardBroadcast0 = ShardID(ConfFinalized) | 0x0100
 )
@@ -101,6 +104,7 @@ func (s ShardID) Label() string {
 	resp, ok := map[ShardID]string{
 		ShardFinalized0: "F",
 		ShardLatest0:    "L",
+		ShardSafe0:      "S",
 		ShardBroadcast0: "B",
 	}[s]
 	if ok {
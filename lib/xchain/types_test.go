@@ -19,6 +19,14 @@ func TestShards(t *testing.T) {
 	require.Equal(t, xchain.ConfLatest, s.ConfLevel())
 	require.False(t, s.Broadcast())
 
+	s = xchain.ShardSafe0
+	require.Equal(t, xchain.ConfSafe, s.ConfLevel())
+	require.False(t, s.Broadcast())
+	require.Equal(t, "S", s.Label())
+	require.Equal(t, "S", s.ConfLevel().Label())
+	require.True(t, s.ConfLevel().Valid())
+	require.True(t, s.ConfLevel().IsFuzzy())
+
 	s = xchain.ShardBroadcast0
 	require.Equal(t, xchain.ConfFinalized, s.ConfLevel())
 	require.True(t, s.Broadcast())
@@ -207,13 +207,13 @@ func newClient(tmNodeAddr string) (client.Client, error) {
 func initializeEthClients(chains []netconf.Chain, endpoints xchain.RPCEndpoints) (map[uint64]ethclient.Client, error) {
 	rpcClientPerChain := make(map[uint64]ethclient.Client)
 	for _, chain := range chains {
-		rpc, err := endpoints.ByNameOrID(chain.Name, chain.ID)
+		urls, err := endpoints.URLsByNameOrID(chain.Name, chain.ID)
 		if err != nil {
 			return nil, err
 		}
-		c, err := ethclient.Dial(chain.Name, rpc)
+		c, err := ethclient.DialMulti(chain.Name, urls)
 		if err != nil {
-			return nil, errors.Wrap(err, "dial rpc", "chain_name", chain.Name, "chain_id", chain.ID, "rpc_url", rpc)
+			return nil, errors.Wrap(err, "dial rpc", "chain_name", chain.Name, "chain_id", chain.ID, "rpc_urls", urls)
 		}
 		rpcClientPerChain[chain.ID] = c
 	}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	magellan2 "github.com/omni-network/omni/halo/app/upgrades/magellan"
 	uluwatu1 "github.com/omni-network/omni/halo/app/upgrades/uluwatu"
 	"github.com/omni-network/omni/lib/cchain"
 	"github.com/omni-network/omni/lib/log"
@@ -13,7 +14,7 @@ import (
 
 // upgrades defines the list upgrades to monitor.
 // Add new upgrades here.
-var upgrades = []string{uluwatu1.UpgradeName}
+var upgrades = []string{uluwatu1.UpgradeName, magellan2.UpgradeName}
 
 // monitorUpgradesForever blocks until the context is closed and
 // periodically updates the planned upgrade gauge.
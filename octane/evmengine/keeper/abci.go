@@ -17,7 +17,6 @@ import (
 
 	"github.com/ethereum/go-ethereum/beacon/engine"
 	"github.com/ethereum/go-ethereum/common"
-	etypes "github.com/ethereum/go-ethereum/core/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -245,6 +244,11 @@ func (k *Keeper) startBuild(ctx context.Context, appHash common.Hash, timestamp
 		return engine.ForkChoiceResponse{}, err
 	}
 
+	withdrawals, err := k.pendingWithdrawals(ctx)
+	if err != nil {
+		return engine.ForkChoiceResponse{}, err
+	}
+
 	// CometBFT has instant finality, so head/safe/finalized is latest height.
 	fcs := engine.ForkchoiceStateV1{
 		HeadBlockHash:      headHash,
@@ -256,7 +260,7 @@ func (k *Keeper) startBuild(ctx context.Context, appHash common.Hash, timestamp
 		Timestamp:             ts,
 		Random:                headHash, // We use head block hash as randao.
 		SuggestedFeeRecipient: k.feeRecProvider.LocalFeeRecipient(),
-		Withdrawals:           []*etypes.Withdrawal{}, // Withdrawals not supported yet.
+		Withdrawals:           withdrawals,
 		BeaconRoot:            &appHash,
 	}
 
@@ -6,6 +6,7 @@ import (
 
 	"github.com/omni-network/omni/lib/cast"
 	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/octane/evmengine/types"
 
 	"github.com/ethereum/go-ethereum/beacon/engine"
 	"github.com/ethereum/go-ethereum/common"
@@ -21,20 +22,22 @@ func (h *ExecutionHead) Hash() (common.Hash, error) {
 const executionHeadID = 1
 
 // InsertGenesisHead inserts the genesis execution head into the database.
-func (k *Keeper) InsertGenesisHead(ctx context.Context, executionBlockHash []byte) error {
+// The block height and time are zero for execution genesis blocks, or those of the exported execution head
+// when importing an exported genesis.
+func (k *Keeper) InsertGenesisHead(ctx context.Context, executionBlockHash []byte, blockHeight uint64, blockTime uint64) error {
 	if len(executionBlockHash) != common.HashLength {
 		return errors.New("invalid execution block hash length", "length", len(executionBlockHash))
 	} else if bytes.Equal(executionBlockHash, common.Hash{}.Bytes()) {
 		return errors.New("invalid zero execution block hash")
 	}
 
-	const genesisHeight = 0 // Genesis block height is 0.
+	const genesisHeight = 0 // Genesis consensus height is 0.
 
 	id, err := k.headTable.InsertReturningId(ctx, &ExecutionHead{
 		CreatedHeight: genesisHeight,
-		BlockHeight:   genesisHeight,
+		BlockHeight:   blockHeight,
 		BlockHash:     executionBlockHash,
-		BlockTime:     0, // Timestamp isn't critical, skip it in genesis.
+		BlockTime:     blockTime, // Zero for execution genesis blocks, timestamp isn't critical.
 	})
 	if err != nil {
 		return errors.Wrap(err, "insert genesis head")
@@ -45,6 +48,21 @@ func (k *Keeper) InsertGenesisHead(ctx context.Context, executionBlockHash []byt
 	return nil
 }
 
+// ExportGenesis returns the genesis state containing the current execution head.
+// Importing it continues building on top of the same execution head, so the execution chain must be retained.
+func (k *Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	head, err := k.getExecutionHead(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GenesisState{
+		ExecutionBlockHash:   head.GetBlockHash(),
+		ExecutionBlockHeight: head.GetBlockHeight(),
+		ExecutionBlockTime:   head.GetBlockTime(),
+	}, nil
+}
+
 // getExecutionHead returns the current execution head.
 func (k *Keeper) getExecutionHead(ctx context.Context) (*ExecutionHead, error) {
 	head, err := k.headTable.Get(ctx, executionHeadID)
@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/omni-network/omni/octane/evmengine/types"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesisExportImport(t *testing.T) {
+	t.Parallel()
+
+	cdc := getCodec(t)
+	txConfig := authtx.NewTxConfig(cdc, nil)
+	mockEngine, err := newMockEngineAPI(0)
+	require.NoError(t, err)
+	frp := newRandomFeeRecipientProvider()
+
+	exportCtx, exportStore := setupCtxStore(t, nil)
+	exporter, err := NewKeeper(cdc, exportStore, &mockEngine, txConfig, nil, frp)
+	require.NoError(t, err)
+	populateGenesisHead(exportCtx, t, exporter)
+
+	// Progress the execution chain beyond its genesis block.
+	const height, timestamp = 5, 1000
+	head, err := exporter.getExecutionHead(exportCtx)
+	require.NoError(t, err)
+	_, payload := mockEngine.nextBlock(t, height, timestamp, common.BytesToHash(head.GetBlockHash()), frp.LocalFeeRecipient(), nil)
+	require.NoError(t, exporter.updateExecutionHead(exportCtx, payload))
+
+	exported, err := exporter.ExportGenesis(exportCtx)
+	require.NoError(t, err)
+	require.EqualValues(t, height, exported.GetExecutionBlockHeight())
+	require.EqualValues(t, timestamp, exported.GetExecutionBlockTime())
+	require.Equal(t, payload.BlockHash.Bytes(), exported.GetExecutionBlockHash())
+
+	// Round trip the exported genesis via JSON.
+	bz, err := cdc.MarshalJSON(exported)
+	require.NoError(t, err)
+	var imported types.GenesisState
+	require.NoError(t, cdc.UnmarshalJSON(bz, &imported))
+
+	importCtx, importStore := setupCtxStore(t, nil)
+	importer, err := NewKeeper(cdc, importStore, &mockEngine, txConfig, nil, frp)
+	require.NoError(t, err)
+	require.NoError(t, importer.InsertGenesisHead(importCtx,
+		imported.GetExecutionBlockHash(),
+		imported.GetExecutionBlockHeight(),
+		imported.GetExecutionBlockTime(),
+	))
+
+	reexported, err := importer.ExportGenesis(importCtx)
+	require.NoError(t, err)
+	require.Equal(t, exported, reexported)
+
+	// The next proposed payload builds on top of the imported execution head.
+	_, next := mockEngine.nextBlock(t, height+1, timestamp+1, payload.BlockHash, frp.LocalFeeRecipient(), nil)
+	nextBz, err := json.Marshal(next)
+	require.NoError(t, err)
+	_, err = importer.parseAndVerifyProposedPayload(importCtx, &types.MsgExecutionPayload{ExecutionPayload: nextBz})
+	require.NoError(t, err)
+}
@@ -6,6 +6,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/omni-network/omni/halo/app/upgrades/magellan"
 	"github.com/omni-network/omni/halo/comet"
 	"github.com/omni-network/omni/lib/errors"
 	"github.com/omni-network/omni/lib/ethclient"
@@ -13,6 +14,7 @@ import (
 	"github.com/omni-network/omni/octane/evmengine/types"
 
 	"github.com/ethereum/go-ethereum/beacon/engine"
+	etypes "github.com/ethereum/go-ethereum/core/types"
 
 	ormv1alpha1 "cosmossdk.io/api/cosmos/orm/v1alpha1"
 	"cosmossdk.io/core/store"
@@ -34,6 +36,8 @@ type Keeper struct {
 	cmtAPI          comet.API
 	addrProvider    types.AddressProvider
 	feeRecProvider  types.FeeRecipientProvider
+	withdrawalProv  types.WithdrawalProvider
+	upgrades        types.UpgradeKeeper
 	buildDelay      time.Duration
 	buildOptimistic bool
 
@@ -87,6 +91,18 @@ func (k *Keeper) SetVoteProvider(p types.VoteExtensionProvider) {
 	k.voteProvider = p
 }
 
+// SetWithdrawalProvider sets the provider of execution payload withdrawals.
+// Payloads do not include withdrawals if not set.
+func (k *Keeper) SetWithdrawalProvider(p types.WithdrawalProvider) {
+	k.withdrawalProv = p
+}
+
+// SetUpgradeKeeper sets the upgrade keeper used to gate logic behind network upgrades.
+// Payloads do not include withdrawals if not set.
+func (k *Keeper) SetUpgradeKeeper(u types.UpgradeKeeper) {
+	k.upgrades = u
+}
+
 // SetCometAPI sets the comet API client.
 func (k *Keeper) SetCometAPI(c comet.API) {
 	k.cmtAPI = c
@@ -117,9 +133,9 @@ func (k *Keeper) parseAndVerifyProposedPayload(ctx context.Context, msg *types.M
 		return engine.ExecutableData{}, errors.Wrap(err, "unmarshal payload")
 	}
 
-	// Ensure no withdrawals are included in the payload.
-	if len(payload.Withdrawals) > 0 {
-		return engine.ExecutableData{}, errors.New("withdrawals not allowed in payload")
+	// Ensure the payload includes exactly the pending withdrawals (if enabled).
+	if err := k.verifyWithdrawals(ctx, payload.Withdrawals); err != nil {
+		return engine.ExecutableData{}, errors.Wrap(err, "verify proposed withdrawals")
 	}
 
 	// Ensure fee recipient using provider
@@ -164,6 +180,68 @@ func (k *Keeper) parseAndVerifyProposedPayload(ctx context.Context, msg *types.M
 	return payload, nil
 }
 
+// withdrawalsEnabled returns true if execution payload withdrawals are enabled,
+// i.e., if a withdrawal provider is set and the 2_magellan network upgrade was executed.
+func (k *Keeper) withdrawalsEnabled(ctx context.Context) (bool, error) {
+	if k.withdrawalProv == nil || k.upgrades == nil {
+		return false, nil
+	}
+
+	height, err := k.upgrades.GetDoneHeight(ctx, magellan.UpgradeName)
+	if err != nil {
+		return false, errors.Wrap(err, "get upgrade done height")
+	}
+
+	return height > 0, nil
+}
+
+// pendingWithdrawals returns the withdrawals to include in the next execution payload.
+func (k *Keeper) pendingWithdrawals(ctx context.Context) ([]*etypes.Withdrawal, error) {
+	if ok, err := k.withdrawalsEnabled(ctx); err != nil {
+		return nil, err
+	} else if !ok {
+		return []*etypes.Withdrawal{}, nil
+	}
+
+	withdrawals, err := k.withdrawalProv.PendingWithdrawals(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "pending withdrawals")
+	}
+
+	return withdrawals, nil
+}
+
+// verifyWithdrawals returns an error if the proposed withdrawals do not match the pending withdrawals.
+// If withdrawals are not enabled, no withdrawals are allowed.
+func (k *Keeper) verifyWithdrawals(ctx context.Context, proposed []*etypes.Withdrawal) error {
+	if ok, err := k.withdrawalsEnabled(ctx); err != nil {
+		return err
+	} else if !ok {
+		if len(proposed) > 0 {
+			return errors.New("withdrawals not allowed in payload")
+		}
+
+		return nil
+	}
+
+	pending, err := k.pendingWithdrawals(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(proposed) != len(pending) {
+		return errors.New("invalid withdrawal count", "proposed", len(proposed), "pending", len(pending))
+	}
+
+	for i, withdrawal := range proposed {
+		if withdrawal == nil || *withdrawal != *pending[i] {
+			return errors.New("invalid withdrawal", "index", pending[i].Index)
+		}
+	}
+
+	return nil
+}
+
 // isNextProposer returns true if the local node is the proposer
 // for the next block.
 //
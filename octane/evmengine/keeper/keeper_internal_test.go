@@ -8,11 +8,16 @@ import (
 	"github.com/omni-network/omni/halo/comet"
 	"github.com/omni-network/omni/lib/errors"
 	"github.com/omni-network/omni/lib/k1util"
+	etypes "github.com/omni-network/omni/octane/evmengine/types"
 
 	k1 "github.com/cometbft/cometbft/crypto/secp256k1"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	cmttypes "github.com/cometbft/cometbft/types"
 
+	eengine "github.com/ethereum/go-ethereum/beacon/engine"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	fuzz "github.com/google/gofuzz"
 	"github.com/stretchr/testify/require"
@@ -113,6 +118,86 @@ func TestKeeper_isNextProposer(t *testing.T) {
 	}
 }
 
+func TestKeeper_withdrawals(t *testing.T) {
+	t.Parallel()
+
+	cdc := getCodec(t)
+	txConfig := authtx.NewTxConfig(cdc, nil)
+	mockEngine, err := newMockEngineAPI(0)
+	require.NoError(t, err)
+
+	var attrs *eengine.PayloadAttributes
+	mockEngine.forkchoiceUpdatedV3Func = func(_ context.Context, _ eengine.ForkchoiceStateV1, a *eengine.PayloadAttributes) (eengine.ForkChoiceResponse, error) {
+		attrs = a
+		return eengine.ForkChoiceResponse{}, nil
+	}
+
+	ctx, storeService := setupCtxStore(t, nil)
+	keeper, err := NewKeeper(cdc, storeService, &mockEngine, txConfig, nil, newRandomFeeRecipientProvider())
+	require.NoError(t, err)
+	populateGenesisHead(ctx, t, keeper)
+
+	// Without provider, payloads have empty (non-nil) withdrawals.
+	_, err = keeper.startBuild(ctx, common.Hash{}, time.Now())
+	require.NoError(t, err)
+	require.NotNil(t, attrs.Withdrawals)
+	require.Empty(t, attrs.Withdrawals)
+	require.NoError(t, keeper.verifyWithdrawals(ctx, nil))
+
+	pending := []*types.Withdrawal{
+		{Index: 1, Address: common.HexToAddress("0x01"), Amount: 2},
+		{Index: 2, Address: common.HexToAddress("0x02"), Amount: 3},
+	}
+	keeper.SetWithdrawalProvider(mockWithdrawalProvider(pending))
+	upgrades := &mockUpgradeKeeper{}
+	keeper.SetUpgradeKeeper(upgrades)
+
+	// Before 2_magellan, payloads have empty withdrawals and proposed withdrawals are not allowed.
+	_, err = keeper.startBuild(ctx, common.Hash{}, time.Now())
+	require.NoError(t, err)
+	require.NotNil(t, attrs.Withdrawals)
+	require.Empty(t, attrs.Withdrawals)
+	require.NoError(t, keeper.verifyWithdrawals(ctx, nil))
+	require.ErrorContains(t, keeper.verifyWithdrawals(ctx, pending), "withdrawals not allowed in payload")
+
+	upgrades.doneHeight = 1
+
+	// After 2_magellan, payloads include pending withdrawals.
+	_, err = keeper.startBuild(ctx, common.Hash{}, time.Now())
+	require.NoError(t, err)
+	require.Equal(t, pending, attrs.Withdrawals)
+
+	// Proposed payloads must include exactly the pending withdrawals.
+	require.NoError(t, keeper.verifyWithdrawals(ctx, pending))
+	require.ErrorContains(t, keeper.verifyWithdrawals(ctx, nil), "invalid withdrawal count")
+	require.ErrorContains(t, keeper.verifyWithdrawals(ctx, pending[:1]), "invalid withdrawal count")
+
+	mismatch := []*types.Withdrawal{pending[0], {Index: 2, Address: common.HexToAddress("0x02"), Amount: 4}}
+	require.ErrorContains(t, keeper.verifyWithdrawals(ctx, mismatch), "invalid withdrawal")
+}
+
+var _ etypes.WithdrawalProvider = mockWithdrawalProvider(nil)
+
+type mockWithdrawalProvider []*types.Withdrawal
+
+func (m mockWithdrawalProvider) PendingWithdrawals(context.Context) ([]*types.Withdrawal, error) {
+	return m, nil
+}
+
+func (mockWithdrawalProvider) CompleteWithdrawals(context.Context, []*types.Withdrawal) error {
+	return nil
+}
+
+var _ etypes.UpgradeKeeper = (*mockUpgradeKeeper)(nil)
+
+type mockUpgradeKeeper struct {
+	doneHeight int64
+}
+
+func (m *mockUpgradeKeeper) GetDoneHeight(context.Context, string) (int64, error) {
+	return m.doneHeight, nil
+}
+
 var _ comet.API = (*mockCometAPI)(nil)
 
 type mockCometAPI struct {
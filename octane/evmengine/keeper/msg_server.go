@@ -99,6 +99,14 @@ func (s msgServer) ExecutionPayload(ctx context.Context, msg *types.MsgExecution
 		return nil, errors.Wrap(err, "deliver event logs")
 	}
 
+	if ok, err := s.withdrawalsEnabled(ctx); err != nil {
+		return nil, err
+	} else if ok {
+		if err := s.withdrawalProv.CompleteWithdrawals(ctx, payload.Withdrawals); err != nil {
+			return nil, errors.Wrap(err, "complete withdrawals")
+		}
+	}
+
 	if err := s.updateExecutionHead(ctx, payload); err != nil {
 		return nil, errors.Wrap(err, "update execution head")
 	}
@@ -125,7 +125,7 @@ func populateGenesisHead(ctx context.Context, t *testing.T, keeper *Keeper) {
 	genesisBlock, err := ethclient.MockGenesisBlock()
 	require.NoError(t, err)
 
-	require.NoError(t, keeper.InsertGenesisHead(ctx, genesisBlock.Hash().Bytes()))
+	require.NoError(t, keeper.InsertGenesisHead(ctx, genesisBlock.Hash().Bytes(), 0, 0))
 }
 
 func Test_pushPayload(t *testing.T) {
@@ -90,13 +90,18 @@ func (m AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, raw json.Ra
 	var data types.GenesisState
 	cdc.MustUnmarshalJSON(raw, &data)
 
-	if err := m.keeper.InsertGenesisHead(ctx, data.ExecutionBlockHash); err != nil {
+	if err := m.keeper.InsertGenesisHead(ctx, data.ExecutionBlockHash, data.ExecutionBlockHeight, data.ExecutionBlockTime); err != nil {
 		panic(errors.Wrap(err, "insert genesis head"))
 	}
 }
 
-func (AppModule) ExportGenesis(sdk.Context, codec.JSONCodec) json.RawMessage {
-	return nil
+func (m AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
+	data, err := m.keeper.ExportGenesis(ctx)
+	if err != nil {
+		panic(errors.Wrap(err, "export genesis"))
+	}
+
+	return cdc.MustMarshalJSON(data)
 }
 
 func (AppModuleBasic) DefaultGenesis(codec.JSONCodec) json.RawMessage {
@@ -151,6 +156,7 @@ type ModuleInputs struct {
 	AddrProvider   types.AddressProvider
 	FeeRecProvider types.FeeRecipientProvider
 	EventProcs     []types.InjectedEventProc
+	WithdrawalProv types.WithdrawalProvider `optional:"true"`
 }
 
 type ModuleOutputs struct {
@@ -181,6 +187,11 @@ func ProvideModule(in ModuleInputs) (ModuleOutputs, error) {
 		return ModuleOutputs{}, err
 	}
 
+	// Withdrawals are provided by the EVM staking module, if registered.
+	if in.WithdrawalProv != nil {
+		k.SetWithdrawalProvider(in.WithdrawalProv)
+	}
+
 	m := NewAppModule(
 		in.Cdc,
 		k,
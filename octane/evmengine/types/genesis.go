@@ -2,7 +2,7 @@ package types
 
 import "github.com/ethereum/go-ethereum/common"
 
-// NewGenesisState creates a new GenesisState instance.
+// NewGenesisState creates a new GenesisState instance starting from the execution genesis block.
 func NewGenesisState(executionBlockHash common.Hash) *GenesisState {
 	return &GenesisState{
 		ExecutionBlockHash: executionBlockHash.Bytes(),
@@ -29,9 +29,11 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
-// GenesisState is an empty genesis state required to trigger valsync genesis logic only.
+// GenesisState defines the execution head to start building on top of.
 type GenesisState struct {
-	ExecutionBlockHash []byte `protobuf:"bytes,1,opt,name=execution_block_hash,json=executionBlockHash,proto3" json:"execution_block_hash,omitempty"`
+	ExecutionBlockHash   []byte `protobuf:"bytes,1,opt,name=execution_block_hash,json=executionBlockHash,proto3" json:"execution_block_hash,omitempty"`
+	ExecutionBlockHeight uint64 `protobuf:"varint,2,opt,name=execution_block_height,json=executionBlockHeight,proto3" json:"execution_block_height,omitempty"`
+	ExecutionBlockTime   uint64 `protobuf:"varint,3,opt,name=execution_block_time,json=executionBlockTime,proto3" json:"execution_block_time,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -74,6 +76,20 @@ func (m *GenesisState) GetExecutionBlockHash() []byte {
 	return nil
 }
 
+func (m *GenesisState) GetExecutionBlockHeight() uint64 {
+	if m != nil {
+		return m.ExecutionBlockHeight
+	}
+	return 0
+}
+
+func (m *GenesisState) GetExecutionBlockTime() uint64 {
+	if m != nil {
+		return m.ExecutionBlockTime
+	}
+	return 0
+}
+
 // MsgExecutionPayload defines the  next EVM execution payload and the
 // logs from previous execution payload.
 type MsgExecutionPayload struct {
@@ -252,35 +268,37 @@ func init() {
 func init() { proto.RegisterFile("octane/evmengine/types/tx.proto", fileDescriptor_288b272163299061) }
 
 var fileDescriptor_288b272163299061 = []byte{
-	// 434 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x73, 0x4d, 0x28, 0xf4, 0x11, 0x41, 0x7a, 0xad, 0x82, 0x65, 0x21, 0x37, 0xca, 0x14,
-	0x5a, 0xc9, 0x0e, 0x65, 0x63, 0x42, 0x41, 0x11, 0x2c, 0x91, 0x2a, 0x57, 0xea, 0xc0, 0x12, 0x5d,
-	0xec, 0x27, 0xdb, 0x22, 0xf6, 0x19, 0xbf, 0xab, 0xd5, 0x6c, 0x88, 0x81, 0x99, 0x8f, 0xd2, 0x8f,
-	0xd1, 0xb1, 0x23, 0x13, 0x42, 0xc9, 0xd0, 0x0f, 0xc0, 0x17, 0x40, 0x3e, 0xc7, 0xb5, 0xd4, 0x26,
-	0x93, 0xef, 0xde, 0xfb, 0xdd, 0xff, 0xef, 0xfb, 0xdf, 0x83, 0x23, 0xe9, 0x29, 0x91, 0xa0, 0x83,
-	0x79, 0x8c, 0x49, 0x10, 0x25, 0xe8, 0xa8, 0x45, 0x8a, 0xe4, 0xa8, 0x2b, 0x3b, 0xcd, 0xa4, 0x92,
-	0xbc, 0x5b, 0x02, 0xf6, 0x3d, 0x60, 0x6b, 0xc0, 0x3c, 0x0c, 0x64, 0x20, 0x35, 0xe2, 0x14, 0xab,
-	0x92, 0x36, 0x5f, 0x79, 0x92, 0x62, 0x49, 0x4e, 0x4c, 0x81, 0x93, 0xbf, 0x2d, 0x3e, 0x65, 0xa3,
-	0xff, 0x01, 0xda, 0x9f, 0x30, 0x41, 0x8a, 0xe8, 0x5c, 0x09, 0x85, 0x7c, 0x08, 0x87, 0x78, 0x85,
-	0xde, 0xa5, 0x8a, 0x64, 0x32, 0x9d, 0xcd, 0xa5, 0xf7, 0x75, 0x1a, 0x0a, 0x0a, 0x0d, 0xd6, 0x63,
-	0x83, 0xb6, 0xcb, 0xef, 0x7b, 0xa3, 0xa2, 0xf5, 0x59, 0x50, 0xd8, 0xff, 0xc7, 0xe0, 0x60, 0x42,
-	0xc1, 0xb8, 0xea, 0x9c, 0x89, 0xc5, 0x5c, 0x0a, 0x9f, 0xbf, 0x86, 0x3d, 0x71, 0xa9, 0x42, 0x99,
-	0x45, 0x6a, 0xa1, 0x8f, 0xef, 0xb9, 0x75, 0x81, 0x9f, 0xc0, 0x7e, 0xed, 0x93, 0x96, 0x47, 0x8c,
-	0x1d, 0x6d, 0xd2, 0xc1, 0x87, 0x52, 0x17, 0x70, 0x90, 0x66, 0x98, 0x57, 0xdc, 0x14, 0x73, 0x4c,
-	0x14, 0x19, 0xcd, 0x5e, 0x73, 0xf0, 0xfc, 0xb4, 0x67, 0x6f, 0x4e, 0xc2, 0x1e, 0x5f, 0x4c, 0xc6,
-	0x05, 0x38, 0x6a, 0xdd, 0xfc, 0x39, 0x6a, 0xb8, 0xfb, 0x85, 0xc4, 0x5a, 0x51, 0xd7, 0x89, 0xbf,
-	0x81, 0xce, 0x6c, 0x2e, 0x67, 0x53, 0x4f, 0xc6, 0x71, 0xa4, 0x62, 0x2d, 0xda, 0xea, 0x35, 0x07,
-	0x6d, 0xf7, 0x65, 0x51, 0xff, 0x58, 0x97, 0xdf, 0xbf, 0xf8, 0x71, 0x77, 0x7d, 0x5c, 0xff, 0x7f,
-	0xdf, 0x04, 0xe3, 0xe1, 0x8d, 0x5d, 0xa4, 0x54, 0x26, 0x84, 0xfd, 0x33, 0x78, 0x56, 0x79, 0x73,
-	0x03, 0x9e, 0x0a, 0xdf, 0xcf, 0x90, 0x68, 0x1d, 0x61, 0xb5, 0xe5, 0x5d, 0xd8, 0x55, 0x32, 0x8d,
-	0x3c, 0x32, 0x76, 0xb4, 0xe5, 0x7a, 0xc7, 0x39, 0xb4, 0x7c, 0xa1, 0x84, 0xd1, 0xd4, 0xb8, 0x5e,
-	0x9f, 0xfe, 0x64, 0x00, 0x13, 0x0a, 0xce, 0x31, 0xcb, 0x23, 0x0f, 0xf9, 0x37, 0xe8, 0x3c, 0x8a,
-	0xfb, 0x64, 0x5b, 0x0c, 0x1b, 0xde, 0xc6, 0x1c, 0x6e, 0xcd, 0x6c, 0xcb, 0x9d, 0xcc, 0x27, 0xdf,
-	0xef, 0xae, 0x8f, 0xd9, 0x68, 0x78, 0xb3, 0xb4, 0xd8, 0xed, 0xd2, 0x62, 0x7f, 0x97, 0x16, 0xfb,
-	0xb5, 0xb2, 0x1a, 0xb7, 0x2b, 0xab, 0xf1, 0x7b, 0x65, 0x35, 0xbe, 0x74, 0x37, 0x0f, 0xec, 0x6c,
-	0x57, 0xcf, 0xd9, 0xbb, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0xc0, 0xbb, 0xaa, 0x4a, 0xd1, 0x02,
-	0x00, 0x00,
+	// 465 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x53, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xce, 0x35, 0xa1, 0xd0, 0xc3, 0x82, 0xf4, 0x1a, 0x05, 0xcb, 0x42, 0xae, 0x95, 0x29, 0xb4,
+	0x92, 0x1d, 0x0a, 0x13, 0x63, 0x50, 0x04, 0x4b, 0xa4, 0xca, 0x45, 0x1d, 0x58, 0xa2, 0x8b, 0xf3,
+	0x64, 0x9f, 0xc8, 0xf9, 0x8c, 0xdf, 0xd5, 0x6a, 0x36, 0xc4, 0xc0, 0xcc, 0x9f, 0xc1, 0xd8, 0x3f,
+	0xa3, 0x63, 0x47, 0x26, 0x84, 0x92, 0xa1, 0x7f, 0x00, 0xff, 0x00, 0xf2, 0x25, 0x69, 0xa4, 0xfc,
+	0x98, 0x7c, 0xf7, 0xbe, 0xef, 0x7d, 0xdf, 0xbb, 0xef, 0x7c, 0xf4, 0x58, 0x45, 0x9a, 0xa7, 0x10,
+	0x40, 0x21, 0x21, 0x8d, 0x45, 0x0a, 0x81, 0x9e, 0x64, 0x80, 0x81, 0xbe, 0xf6, 0xb3, 0x5c, 0x69,
+	0xc5, 0x9a, 0x73, 0x82, 0xff, 0x40, 0xf0, 0x0d, 0xc1, 0x69, 0xc4, 0x2a, 0x56, 0x86, 0x12, 0x94,
+	0xab, 0x39, 0xdb, 0x79, 0x11, 0x29, 0x94, 0x0a, 0x03, 0x89, 0x71, 0x50, 0xbc, 0x2e, 0x3f, 0x73,
+	0xa0, 0xf5, 0x8b, 0x50, 0xeb, 0x03, 0xa4, 0x80, 0x02, 0x2f, 0x34, 0xd7, 0xc0, 0x3a, 0xb4, 0x01,
+	0xd7, 0x10, 0x5d, 0x69, 0xa1, 0xd2, 0xc1, 0x70, 0xac, 0xa2, 0x2f, 0x83, 0x84, 0x63, 0x62, 0x13,
+	0x8f, 0xb4, 0xad, 0x90, 0x3d, 0x60, 0xdd, 0x12, 0xfa, 0xc8, 0x31, 0x61, 0x6f, 0x69, 0x73, 0xa3,
+	0x03, 0x44, 0x9c, 0x68, 0x7b, 0xcf, 0x23, 0xed, 0x5a, 0xd8, 0x58, 0xeb, 0x31, 0xd8, 0x36, 0x1f,
+	0x2d, 0x24, 0xd8, 0x55, 0xd3, 0xb3, 0xe6, 0xf3, 0x49, 0x48, 0x68, 0xfd, 0x23, 0xf4, 0xa8, 0x8f,
+	0x71, 0x6f, 0x89, 0x9c, 0xf3, 0xc9, 0x58, 0xf1, 0x11, 0x7b, 0x49, 0x0f, 0xf8, 0x95, 0x4e, 0x54,
+	0x2e, 0xf4, 0xc4, 0x8c, 0x79, 0x10, 0xae, 0x0a, 0xec, 0x94, 0x1e, 0xae, 0x7c, 0xb2, 0x79, 0x8b,
+	0x19, 0xcc, 0x0a, 0xeb, 0xb0, 0x2e, 0x75, 0x49, 0x8f, 0xb2, 0x1c, 0x8a, 0x25, 0x6f, 0x00, 0x05,
+	0xa4, 0x1a, 0xed, 0xaa, 0x57, 0x6d, 0x3f, 0x3d, 0xf3, 0xfc, 0xed, 0x91, 0xfb, 0xbd, 0xcb, 0x7e,
+	0xaf, 0x24, 0x76, 0x6b, 0xb7, 0x7f, 0x8e, 0x2b, 0xe1, 0x61, 0x29, 0xb1, 0x50, 0x34, 0x75, 0x64,
+	0xaf, 0x68, 0x7d, 0x38, 0x56, 0xc3, 0x41, 0xa4, 0xa4, 0x14, 0x5a, 0x1a, 0xd1, 0x9a, 0x57, 0x6d,
+	0x5b, 0xe1, 0xf3, 0xb2, 0xfe, 0x7e, 0x55, 0x7e, 0xf7, 0xec, 0xfb, 0xfd, 0xcd, 0xc9, 0x6a, 0xfe,
+	0x96, 0x43, 0xed, 0xf5, 0x13, 0x87, 0x80, 0x99, 0x4a, 0x11, 0x5a, 0xe7, 0xf4, 0xc9, 0xd2, 0x9b,
+	0xd9, 0xf4, 0x31, 0x1f, 0x8d, 0x72, 0x40, 0x5c, 0x5c, 0xd5, 0x72, 0xcb, 0x9a, 0x74, 0x5f, 0xab,
+	0x4c, 0x44, 0x68, 0xef, 0x19, 0xcb, 0xc5, 0x8e, 0x31, 0x5a, 0x1b, 0x71, 0xcd, 0x4d, 0xe2, 0x56,
+	0x68, 0xd6, 0x67, 0x3f, 0x08, 0xa5, 0x7d, 0x8c, 0x2f, 0x20, 0x2f, 0x44, 0x04, 0xec, 0x2b, 0xad,
+	0x6f, 0xc4, 0x7d, 0xba, 0x2b, 0x86, 0x2d, 0x77, 0xe3, 0x74, 0x76, 0x66, 0xb6, 0xe3, 0x4c, 0xce,
+	0xa3, 0x6f, 0xf7, 0x37, 0x27, 0xa4, 0xdb, 0xb9, 0x9d, 0xba, 0xe4, 0x6e, 0xea, 0x92, 0xbf, 0x53,
+	0x97, 0xfc, 0x9c, 0xb9, 0x95, 0xbb, 0x99, 0x5b, 0xf9, 0x3d, 0x73, 0x2b, 0x9f, 0x9b, 0xdb, 0x5f,
+	0xc6, 0x70, 0xdf, 0xfc, 0xd0, 0x6f, 0xfe, 0x0f, 0x00, 0xb3, 0x33, 0xf9, 0xa5, 0x3a, 0x03, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -386,6 +404,16 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExecutionBlockTime != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExecutionBlockTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ExecutionBlockHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExecutionBlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.ExecutionBlockHash) > 0 {
 		i -= len(m.ExecutionBlockHash)
 		copy(dAtA[i:], m.ExecutionBlockHash)
@@ -546,6 +574,12 @@ func (m *GenesisState) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.ExecutionBlockHeight != 0 {
+		n += 1 + sovTx(uint64(m.ExecutionBlockHeight))
+	}
+	if m.ExecutionBlockTime != 0 {
+		n += 1 + sovTx(uint64(m.ExecutionBlockTime))
+	}
 	return n
 }
 
@@ -679,6 +713,44 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				m.ExecutionBlockHash = []byte{}
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionBlockHeight", wireType)
+			}
+			m.ExecutionBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionBlockTime", wireType)
+			}
+			m.ExecutionBlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionBlockTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
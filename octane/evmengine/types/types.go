@@ -1,6 +1,11 @@
 package types
 
-import "github.com/ethereum/go-ethereum/common"
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	etypes "github.com/ethereum/go-ethereum/core/types"
+)
 
 type AddressProvider interface {
 	// LocalAddress returns the local validator's ethereum address.
@@ -13,3 +18,18 @@ type FeeRecipientProvider interface {
 	// VerifyFeeRecipient returns true if the given address is a valid fee recipient
 	VerifyFeeRecipient(proposedFeeRecipient common.Address) error
 }
+
+// UpgradeKeeper abstracts the x/upgrade keeper methods used to gate logic behind network upgrades.
+type UpgradeKeeper interface {
+	// GetDoneHeight returns the height at which the given upgrade was executed, or zero if not executed.
+	GetDoneHeight(ctx context.Context, name string) (int64, error)
+}
+
+// WithdrawalProvider provides the withdrawals to include in execution payloads.
+// Withdrawals must be deterministic, since proposed payloads are verified against them.
+type WithdrawalProvider interface {
+	// PendingWithdrawals returns the withdrawals to include in the next execution payload.
+	PendingWithdrawals(ctx context.Context) ([]*etypes.Withdrawal, error)
+	// CompleteWithdrawals marks the withdrawals included in a finalized execution payload as completed.
+	CompleteWithdrawals(ctx context.Context, withdrawals []*etypes.Withdrawal) error
+}
@@ -0,0 +1,263 @@
+package relayer
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/omni-network/omni/lib/cchain"
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/log"
+	"github.com/omni-network/omni/lib/netconf"
+	"github.com/omni-network/omni/lib/xchain"
+	"github.com/omni-network/omni/relayer/app/cursor"
+)
+
+// adminStream is the admin API status of a stream.
+type adminStream struct {
+	Stream                string `json:"stream"`
+	SubmittedMsgOffset    uint64 `json:"submitted_msg_offset"`
+	SubmittedAttestOffset uint64 `json:"submitted_attest_offset"`
+	LatestAttestOffset    uint64 `json:"latest_attest_offset"` // Latest approved attestation of the stream's chain version
+}
+
+// adminCursor is the admin API status of a stored (pending) worker cursor.
+type adminCursor struct {
+	ChainVersion  string            `json:"chain_version"`
+	AttestOffset  uint64            `json:"attest_offset"`
+	Confirmed     bool              `json:"confirmed"`
+	StreamOffsets map[string]uint64 `json:"stream_offsets"` // Highest submitted msg offset by shard
+}
+
+// adminDest is the admin API status of a destination chain.
+type adminDest struct {
+	Chain   string        `json:"chain"`
+	Streams []adminStream `json:"streams"`
+	Cursors []adminCursor `json:"cursors"`
+}
+
+// adminResubmitRequest is the admin API request to resubmit a stream's messages.
+// All messages from the offset are resubmitted, since streams are submitted sequentially.
+type adminResubmitRequest struct {
+	Stream     string `json:"stream"`
+	FromOffset uint64 `json:"from_offset"`
+}
+
+// adminResubmitResponse is the admin API response of a resubmit request.
+type adminResubmitResponse struct {
+	ChainVersion string `json:"chain_version"`
+	AttestOffset uint64 `json:"attest_offset"` // Attest offset the worker restreams from
+}
+
+// admin serves the relayer admin API.
+type admin struct {
+	network netconf.Network
+	cProv   cchain.Provider
+	xProv   xchain.Provider
+	cursors *cursor.Store
+	workers map[uint64]*Worker // Workers by destination chain ID
+}
+
+// serveAdmin serves the admin API on the provided address, separate from the monitoring server
+// since it exposes mutating endpoints. It returns a channel that receives the server error.
+// The admin API is disabled if the address is empty, in which case the channel never receives.
+func serveAdmin(ctx context.Context, address string, a admin) <-chan error {
+	errChan := make(chan error)
+	if address == "" {
+		return errChan
+	}
+
+	log.Info(ctx, "Serving admin API", "address", address)
+
+	go func() {
+		mux := http.NewServeMux()
+		a.Register(mux)
+
+		srv := &http.Server{
+			Addr:              address,
+			ReadHeaderTimeout: 5 * time.Second,
+			IdleTimeout:       5 * time.Second,
+			WriteTimeout:      30 * time.Second,
+			Handler:           mux,
+		}
+		errChan <- errors.Wrap(srv.ListenAndServe(), "serve admin")
+	}()
+
+	return errChan
+}
+
+// Register registers the admin API handlers on the mux.
+func (a admin) Register(mux *http.ServeMux) {
+	mux.HandleFunc("GET /admin/streams", a.handle(a.streams))
+	mux.HandleFunc("POST /admin/workers/{chain}/reset", a.handle(a.reset))
+	mux.HandleFunc("POST /admin/workers/{chain}/resubmit", a.handle(a.resubmit))
+}
+
+// handle returns a http handler func that writes the response or error as JSON.
+func (a admin) handle(fn func(ctx context.Context, r *http.Request) (any, int, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := log.WithCtx(r.Context(), "path", r.URL.Path)
+
+		resp, status, err := fn(ctx, r)
+		if err != nil {
+			log.Warn(ctx, "Admin request failed", err)
+			resp = struct {
+				Error string `json:"error"`
+			}{Error: err.Error()}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_ = json.NewEncoder(w).Encode(resp)
+	}
+}
+
+// streams returns the status of all streams and stored cursors of each destination chain.
+func (a admin) streams(ctx context.Context, _ *http.Request) (any, int, error) {
+	latest := make(map[xchain.ChainVersion]uint64)
+	latestOffset := func(chainVer xchain.ChainVersion) (uint64, error) {
+		if offset, ok := latest[chainVer]; ok {
+			return offset, nil
+		}
+
+		att, ok, err := a.cProv.LatestAttestation(ctx, chainVer)
+		if err != nil {
+			return 0, errors.Wrap(err, "latest attestation")
+		} else if ok {
+			latest[chainVer] = att.AttestOffset
+		}
+
+		return latest[chainVer], nil
+	}
+
+	var resp []adminDest
+	for _, dest := range a.network.EVMChains() {
+		status := adminDest{Chain: dest.Name}
+		for _, stream := range a.network.StreamsTo(dest.ID) {
+			submitted, _, err := a.xProv.GetSubmittedCursor(ctx, xchain.LatestRef, stream)
+			if err != nil {
+				return nil, http.StatusInternalServerError, errors.Wrap(err, "submitted cursor")
+			}
+
+			latestAttest, err := latestOffset(stream.ChainVersion())
+			if err != nil {
+				return nil, http.StatusInternalServerError, err
+			}
+
+			status.Streams = append(status.Streams, adminStream{
+				Stream:                a.network.StreamName(stream),
+				SubmittedMsgOffset:    submitted.MsgOffset,
+				SubmittedAttestOffset: submitted.AttestOffset,
+				LatestAttestOffset:    latestAttest,
+			})
+		}
+
+		cursors, err := a.cursors.ListTo(ctx, dest.ID)
+		if err != nil {
+			return nil, http.StatusInternalServerError, errors.Wrap(err, "list cursors")
+		}
+
+		for _, c := range cursors {
+			offsets := make(map[string]uint64)
+			for shard, offset := range c.GetStreamOffsetsByShard() {
+				offsets[xchain.ShardID(shard).Label()] = offset
+			}
+
+			chainVer := xchain.ChainVersion{ID: c.GetSrcChainId(), ConfLevel: xchain.ConfLevel(c.GetConfLevel())}
+			status.Cursors = append(status.Cursors, adminCursor{
+				ChainVersion:  a.network.ChainVersionName(chainVer),
+				AttestOffset:  c.GetAttestOffset(),
+				Confirmed:     c.GetConfirmed(),
+				StreamOffsets: offsets,
+			})
+		}
+
+		resp = append(resp, status)
+	}
+
+	return resp, http.StatusOK, nil
+}
+
+// reset resets the worker of the destination chain.
+func (a admin) reset(ctx context.Context, r *http.Request) (any, int, error) {
+	worker, err := a.worker(r)
+	if err != nil {
+		return nil, http.StatusNotFound, err
+	}
+
+	log.Info(ctx, "Admin worker reset requested", "dst_chain", worker.destChain.Name)
+	worker.Reset()
+
+	return struct{}{}, http.StatusOK, nil
+}
+
+// resubmit restreams the stream of the destination chain worker from the attestation
+// before the provided message offset, resubmitting all messages from the offset.
+func (a admin) resubmit(ctx context.Context, r *http.Request) (any, int, error) {
+	worker, err := a.worker(r)
+	if err != nil {
+		return nil, http.StatusNotFound, err
+	}
+
+	var req adminResubmitRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, http.StatusBadRequest, errors.Wrap(err, "decode request")
+	}
+
+	var stream xchain.StreamID
+	var ok bool
+	for _, s := range a.network.StreamsTo(worker.destChain.ID) {
+		if a.network.StreamName(s) == req.Stream {
+			stream, ok = s, true
+			break
+		}
+	}
+	if !ok {
+		return nil, http.StatusBadRequest, errors.New("unknown stream", "stream", req.Stream)
+	}
+
+	submitted, ok, err := a.xProv.GetSubmittedCursor(ctx, xchain.LatestRef, stream)
+	if err != nil {
+		return nil, http.StatusInternalServerError, errors.Wrap(err, "submitted cursor")
+	} else if ok && req.FromOffset <= submitted.MsgOffset {
+		return nil, http.StatusBadRequest, errors.New("messages already submitted", "from_offset", req.FromOffset, "submitted", submitted.MsgOffset)
+	}
+
+	chainVer := stream.ChainVersion()
+	attestOffset, found, err := a.cursors.AttestOffsetBefore(ctx, chainVer, stream.DestChainID, stream.ShardID, req.FromOffset)
+	if err != nil {
+		return nil, http.StatusInternalServerError, errors.Wrap(err, "stored cursor")
+	} else if !found && ok {
+		attestOffset = submitted.AttestOffset // Restream from the last submitted attestation.
+	} else if !found {
+		attestOffset = initialAttestOffset
+	}
+
+	log.Info(ctx, "Admin resubmit requested",
+		"stream", req.Stream,
+		"from_offset", req.FromOffset,
+		"attest_offset", attestOffset,
+	)
+	worker.Restream(chainVer, attestOffset)
+
+	return adminResubmitResponse{
+		ChainVersion: a.network.ChainVersionName(chainVer),
+		AttestOffset: attestOffset,
+	}, http.StatusOK, nil
+}
+
+// worker returns the worker of the destination chain name in the request path.
+func (a admin) worker(r *http.Request) (*Worker, error) {
+	chain, ok := a.network.ChainByName(r.PathValue("chain"))
+	if !ok {
+		return nil, errors.New("unknown chain", "chain", r.PathValue("chain"))
+	}
+
+	worker, ok := a.workers[chain.ID]
+	if !ok {
+		return nil, errors.New("no worker for chain", "chain", chain.Name)
+	}
+
+	return worker, nil
+}
@@ -0,0 +1,133 @@
+package relayer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/omni-network/omni/lib/cchain"
+	"github.com/omni-network/omni/lib/netconf"
+	"github.com/omni-network/omni/lib/xchain"
+	"github.com/omni-network/omni/relayer/app/cursor"
+
+	db "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+)
+
+type adminMockProvider struct {
+	cchain.Provider
+	latest map[xchain.ChainVersion]uint64
+}
+
+func (m adminMockProvider) LatestAttestation(_ context.Context, chainVer xchain.ChainVersion) (xchain.Attestation, bool, error) {
+	offset, ok := m.latest[chainVer]
+
+	return xchain.Attestation{AttestHeader: xchain.AttestHeader{ChainVersion: chainVer, AttestOffset: offset}}, ok, nil
+}
+
+func TestAdmin(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	network := netconf.Network{
+		ID: netconf.Devnet,
+		Chains: []netconf.Chain{
+			{ID: 1, Name: "chain_a", Shards: []xchain.ShardID{xchain.ShardFinalized0}},
+			{ID: 2, Name: "chain_b", Shards: []xchain.ShardID{xchain.ShardFinalized0}},
+		},
+	}
+	stream := xchain.StreamID{SourceChainID: 1, DestChainID: 2, ShardID: xchain.ShardFinalized0}
+	chainVer := stream.ChainVersion()
+
+	xProv := &mockXChainClient{
+		GetSubmittedCursorFn: func(_ context.Context, _ xchain.Ref, s xchain.StreamID) (xchain.SubmitCursor, bool, error) {
+			if s != stream {
+				return xchain.SubmitCursor{}, false, nil
+			}
+
+			return xchain.SubmitCursor{StreamID: s, MsgOffset: 7, AttestOffset: 4}, true, nil
+		},
+	}
+
+	cursors, err := cursor.New(db.NewMemDB(), xProv.GetSubmittedCursor, network)
+	require.NoError(t, err)
+	require.NoError(t, cursors.Insert(ctx, chainVer, stream.DestChainID, 5, map[xchain.StreamID][]xchain.Msg{
+		stream: {{MsgID: xchain.MsgID{StreamID: stream, StreamOffset: 10}}},
+	}))
+
+	worker := NewWorker(network.Chains[1], network, nil, xProv, nil, nil, nil, cursors, submitPolicies{})
+
+	mux := http.NewServeMux()
+	admin{
+		network: network,
+		cProv:   adminMockProvider{latest: map[xchain.ChainVersion]uint64{chainVer: 9}},
+		xProv:   xProv,
+		cursors: cursors,
+		workers: map[uint64]*Worker{2: worker},
+	}.Register(mux)
+
+	do := func(method string, path string, body any) *httptest.ResponseRecorder {
+		var bz []byte
+		if body != nil {
+			bz, err = json.Marshal(body)
+			require.NoError(t, err)
+		}
+
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, httptest.NewRequest(method, path, bytes.NewReader(bz)))
+
+		return rec
+	}
+
+	// Stream status
+	rec := do(http.MethodGet, "/admin/streams", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var dests []adminDest
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&dests))
+	require.Len(t, dests, 2)
+	require.Equal(t, "chain_b", dests[1].Chain)
+	require.Equal(t, []adminStream{{
+		Stream:                "chain_a|F|chain_b",
+		SubmittedMsgOffset:    7,
+		SubmittedAttestOffset: 4,
+		LatestAttestOffset:    9,
+	}}, dests[1].Streams)
+	require.Equal(t, []adminCursor{{
+		ChainVersion:  network.ChainVersionName(chainVer),
+		AttestOffset:  5,
+		StreamOffsets: map[string]uint64{"F": 10},
+	}}, dests[1].Cursors)
+
+	// Resubmit from after stored cursor restreams from stored cursor
+	rec = do(http.MethodPost, "/admin/workers/chain_b/resubmit", adminResubmitRequest{Stream: "chain_a|F|chain_b", FromOffset: 11})
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var resp adminResubmitResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	require.EqualValues(t, 5, resp.AttestOffset)
+	require.EqualValues(t, 5, worker.restream[chainVer])
+
+	// Resubmit without stored cursor restreams from submitted cursor
+	rec = do(http.MethodPost, "/admin/workers/chain_b/resubmit", adminResubmitRequest{Stream: "chain_a|F|chain_b", FromOffset: 8})
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.EqualValues(t, 4, worker.restream[chainVer])
+
+	// Already submitted messages cannot be resubmitted
+	rec = do(http.MethodPost, "/admin/workers/chain_b/resubmit", adminResubmitRequest{Stream: "chain_a|F|chain_b", FromOffset: 7})
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	// Unknown streams and chains
+	rec = do(http.MethodPost, "/admin/workers/chain_b/resubmit", adminResubmitRequest{Stream: "chain_b|F|chain_a", FromOffset: 8})
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	rec = do(http.MethodPost, "/admin/workers/chain_c/reset", nil)
+	require.Equal(t, http.StatusNotFound, rec.Code)
+
+	// Reset
+	rec = do(http.MethodPost, "/admin/workers/chain_b/reset", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+}
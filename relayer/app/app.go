@@ -12,6 +12,7 @@ import (
 	"github.com/omni-network/omni/lib/ethclient"
 	"github.com/omni-network/omni/lib/log"
 	"github.com/omni-network/omni/lib/netconf"
+	"github.com/omni-network/omni/lib/signer"
 	"github.com/omni-network/omni/lib/xchain"
 	xprovider "github.com/omni-network/omni/lib/xchain/provider"
 	"github.com/omni-network/omni/relayer/app/cursor"
@@ -20,9 +21,10 @@ import (
 	"github.com/cometbft/cometbft/rpc/client/http"
 
 	"github.com/ethereum/go-ethereum/common"
-	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 
 	dbm "github.com/cosmos/cosmos-db"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
 func Run(ctx context.Context, cfg Config) error {
@@ -49,9 +51,15 @@ func Run(ctx context.Context, cfg Config) error {
 		return err
 	}
 
-	privateKey, err := ethcrypto.LoadECDSA(cfg.PrivateKey)
+	sgnr, err := signer.New(ctx, network.ID, cfg.Signer, cfg.PrivateKey)
 	if err != nil {
-		return errors.Wrap(err, "failed to load private key")
+		return errors.Wrap(err, "new signer")
+	}
+	log.Info(ctx, "Using relayer address", "address", sgnr.Address().Hex(), "signer", cfg.Signer.Type, "lanes", 1+len(cfg.LaneSigners))
+
+	lanes, err := newLaneSigners(ctx, network.ID, cfg.Signer, sgnr, cfg.LaneSigners)
+	if err != nil {
+		return err
 	}
 
 	tmClient, err := newClient(cfg.HaloURL)
@@ -59,12 +67,26 @@ func Run(ctx context.Context, cfg Config) error {
 		return err
 	}
 
-	cprov := cprovider.NewABCI(tmClient, network.ID)
+	var cprovOpts []func(*cprovider.Provider)
+	if cfg.ArchiveURL != "" {
+		archiveConn, err := grpc.NewClient(cfg.ArchiveURL, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			return errors.Wrap(err, "new archive grpc client")
+		}
+		cprovOpts = append(cprovOpts, cprovider.WithArchive(archiveConn))
+	}
+
+	cprov := cprovider.NewABCI(tmClient, network.ID, cprovOpts...)
 	xprov := xprovider.New(network, rpcClientPerChain, cprov)
 
 	pricer := newTokenPricer(ctx)
 	pnl := newPnlLogger(network.ID, pricer)
 
+	streamPolicy, err := parseSubmitPolicies(network, cfg.SubmitPolicy, cfg.SubmitPolicies)
+	if err != nil {
+		return errors.Wrap(err, "parse submit policies")
+	}
+
 	db, err := initializeDB(ctx, cfg)
 	if err != nil {
 		return err
@@ -75,24 +97,36 @@ func Run(ctx context.Context, cfg Config) error {
 	}
 	cursors.StartLoops(ctx)
 
+	workers := make(map[uint64]*Worker)
 	for _, destChain := range network.EVMChains() {
 		// Setup send provider
-		sendProvider := func() (SendAsync, error) {
-			sender, err := NewSender(
-				network.ID,
-				destChain,
-				rpcClientPerChain[destChain.ID],
-				*privateKey,
-				network.ChainVersionNames(),
-				pnl.log,
-			)
-			if err != nil {
-				return nil, err
+		sendProvider := func() ([]SendAsync, error) {
+			var resp []SendAsync
+			for _, lane := range lanes {
+				sender, err := NewSender(
+					network.ID,
+					destChain,
+					rpcClientPerChain[destChain.ID],
+					lane,
+					network.ChainVersionNames(),
+					pnl.log,
+				)
+				if err != nil {
+					return nil, err
+				}
+
+				resp = append(resp, sender.SendAsync)
 			}
 
-			return sender.SendAsync, nil
+			return resp, nil
 		}
 
+		var laneAddrs []common.Address
+		for _, lane := range lanes {
+			laneAddrs = append(laneAddrs, lane.Address())
+		}
+		go monitorBalancesForever(ctx, destChain, rpcClientPerChain[destChain.ID], laneAddrs)
+
 		// Setup validator set awaiter
 		portal, err := bindings.NewOmniPortal(destChain.PortalAddress, rpcClientPerChain[destChain.ID])
 		if err != nil {
@@ -100,6 +134,12 @@ func Run(ctx context.Context, cfg Config) error {
 		}
 		awaitValSet := newValSetAwaiter(portal, destChain.BlockPeriod)
 
+		policies := submitPolicies{
+			Policy:   streamPolicy,
+			Estimate: newCostEstimator(network.ID, rpcClientPerChain[destChain.ID], pricer),
+			MaxDelay: cfg.SubmitMaxDelay,
+		}
+
 		// Start worker
 		worker := NewWorker(
 			destChain,
@@ -110,20 +150,62 @@ func Run(ctx context.Context, cfg Config) error {
 			sendProvider,
 			awaitValSet,
 			cursors,
+			policies,
 		)
 
 		go worker.Run(ctx)
+
+		workers[destChain.ID] = worker
 	}
 
+	adminChan := serveAdmin(ctx, cfg.AdminAddr, admin{
+		network: network,
+		cProv:   cprov,
+		xProv:   xprov,
+		cursors: cursors,
+		workers: workers,
+	})
+
 	select {
 	case <-ctx.Done():
 		log.Info(ctx, "Shutdown detected, stopping...")
 		return nil
 	case err := <-monitorChan:
 		return err
+	case err := <-adminChan:
+		return err
 	}
 }
 
+// newLaneSigners returns the signers of all send lanes; the primary signer and an additional signer per lane.
+// Lane signers use the configured signer type; lanes are private key paths for local signers, else account addresses.
+func newLaneSigners(ctx context.Context, network netconf.ID, cfg signer.Config, primary signer.Signer, lanes []string) ([]signer.Signer, error) {
+	resp := []signer.Signer{primary}
+	dedup := map[common.Address]bool{primary.Address(): true}
+	for _, laneID := range lanes {
+		laneCfg, keyFile := cfg, ""
+		if cfg.Type == signer.TypeLocal {
+			keyFile = laneID
+		} else {
+			laneCfg.Address = laneID
+		}
+
+		lane, err := signer.New(ctx, network, laneCfg, keyFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "new lane signer", "lane", laneID)
+		}
+
+		if dedup[lane.Address()] {
+			return nil, errors.New("duplicate lane address", "address", lane.Address())
+		}
+		dedup[lane.Address()] = true
+
+		resp = append(resp, lane)
+	}
+
+	return resp, nil
+}
+
 func newClient(tmNodeAddr string) (client.Client, error) {
 	c, err := http.New("tcp://"+tmNodeAddr, "/websocket")
 	if err != nil {
@@ -136,13 +218,13 @@ func newClient(tmNodeAddr string) (client.Client, error) {
 func initializeRPCClients(chains []netconf.Chain, endpoints xchain.RPCEndpoints) (map[uint64]ethclient.Client, error) {
 	rpcClientPerChain := make(map[uint64]ethclient.Client)
 	for _, chain := range chains {
-		rpc, err := endpoints.ByNameOrID(chain.Name, chain.ID)
+		urls, err := endpoints.URLsByNameOrID(chain.Name, chain.ID)
 		if err != nil {
 			return nil, err
 		}
-		c, err := ethclient.Dial(chain.Name, rpc)
+		c, err := ethclient.DialMulti(chain.Name, urls)
 		if err != nil {
-			return nil, errors.Wrap(err, "dial rpc", "chain_name", chain.Name, "chain_id", chain.ID, "rpc_url", rpc)
+			return nil, errors.Wrap(err, "dial rpc", "chain_name", chain.Name, "chain_id", chain.ID, "rpc_urls", urls)
 		}
 		rpcClientPerChain[chain.ID] = c
 	}
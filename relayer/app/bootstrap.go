@@ -1,72 +1,78 @@
 package relayer
 
 import (
-	"github.com/omni-network/omni/lib/netconf"
+	"context"
+
+	"github.com/omni-network/omni/lib/cchain"
+	"github.com/omni-network/omni/lib/errors"
 	"github.com/omni-network/omni/lib/xchain"
 )
 
-func getBootstrapOffset(network netconf.Network, destChain netconf.Chain, chainVer xchain.ChainVersion) (uint64, bool) {
-	chainVerName := network.ChainVersionName(chainVer)
-	for _, b := range bootstrapCursors[network.ID] {
-		if b.Worker == destChain.Name && b.ChainVersion == chainVerName {
-			return b.AttestOffset, true
+// discoverBootstrapOffset returns the highest attest offset of the chain version, not lower than from,
+// up to which all messages emitted to the destination chain have already been submitted.
+// Streaming can start from that offset instead of from the last submitted attestation,
+// which is very old for quiet streams or new relayer instances.
+//
+// It binary-searches approved attestations, comparing the emitted source chain cursors
+// at each attested block height to the submitted cursors (destination portal InXStreamOffset).
+// Note this requires historical source chain state, i.e., archive RPC nodes for old attestations.
+func discoverBootstrapOffset(
+	ctx context.Context,
+	cProv cchain.Provider,
+	xProv xchain.Provider,
+	chainVer xchain.ChainVersion,
+	from uint64,
+	streams []xchain.StreamID, // All streams of the chain version to the destination chain
+	submitted map[xchain.StreamID]uint64, // Submitted msg offsets by stream
+) (uint64, error) {
+	latest, ok, err := cProv.LatestAttestation(ctx, chainVer)
+	if err != nil {
+		return 0, errors.Wrap(err, "latest attestation")
+	} else if !ok || latest.AttestOffset <= from {
+		return from, nil
+	}
+
+	// caughtUp returns true if all messages emitted up to the attestation have been submitted.
+	caughtUp := func(attestOffset uint64) (bool, error) {
+		atts, err := cProv.AttestationsFrom(ctx, chainVer, attestOffset)
+		if err != nil {
+			return false, errors.Wrap(err, "fetch attestation", "attest_offset", attestOffset)
+		} else if len(atts) == 0 || atts[0].AttestOffset != attestOffset {
+			return false, errors.New("attestation not found", "attest_offset", attestOffset)
 		}
+
+		height := atts[0].BlockHeight
+		for _, stream := range streams {
+			emitted, ok, err := xProv.GetEmittedCursor(ctx, xchain.Ref{Height: &height}, stream)
+			if err != nil {
+				return false, errors.Wrap(err, "emitted cursor", "height", height)
+			} else if ok && emitted.MsgOffset > submitted[stream] {
+				return false, nil
+			}
+		}
+
+		return true, nil
 	}
 
-	return 0, false
-}
+	if ok, err := caughtUp(from); err != nil {
+		return 0, err
+	} else if !ok {
+		return from, nil // Pending messages already at from, nothing to skip.
+	}
 
-type bootstrapCursor struct {
-	ChainVersion string
-	Worker       string
-	AttestOffset uint64
-}
+	// Invariant: caughtUp(lo) is true and caughtUp(hi+1) is false (or hi is latest).
+	lo, hi := from, latest.AttestOffset
+	for lo < hi {
+		mid := lo + (hi-lo+1)/2
+		ok, err := caughtUp(mid)
+		if err != nil {
+			return 0, err
+		} else if ok {
+			lo = mid
+		} else {
+			hi = mid - 1
+		}
+	}
 
-// bootstrapCursors obtained from grafana
-// Instant value of `max(lib_cprovider_stream_offset{network="omega",job="relayer"}) by (chain_version,worker)`
-// At 2024-11-26 19:42:54.227 UTC.
-var bootstrapCursors = map[netconf.ID][]bootstrapCursor{
-	netconf.Omega: {
-		{"arb_sepolia|F", "base_sepolia", 12415},
-		{"arb_sepolia|F", "holesky", 12415},
-		{"arb_sepolia|F", "omni_evm", 12415},
-		{"arb_sepolia|F", "op_sepolia", 12415},
-		{"arb_sepolia|L", "base_sepolia", 12416},
-		{"arb_sepolia|L", "holesky", 12416},
-		{"arb_sepolia|L", "omni_evm", 12416},
-		{"arb_sepolia|L", "op_sepolia", 12416},
-		{"base_sepolia|F", "arb_sepolia", 10139},
-		{"base_sepolia|F", "holesky", 10139},
-		{"base_sepolia|F", "omni_evm", 10139},
-		{"base_sepolia|F", "op_sepolia", 10139},
-		{"base_sepolia|L", "arb_sepolia", 10139},
-		{"base_sepolia|L", "holesky", 10139},
-		{"base_sepolia|L", "omni_evm", 10139},
-		{"base_sepolia|L", "op_sepolia", 10139},
-		{"holesky|F", "arb_sepolia", 18388},
-		{"holesky|F", "base_sepolia", 18388},
-		{"holesky|F", "omni_evm", 18388},
-		{"holesky|F", "op_sepolia", 18388},
-		{"holesky|L", "arb_sepolia", 18389},
-		{"holesky|L", "base_sepolia", 18389},
-		{"holesky|L", "omni_evm", 18389},
-		{"holesky|L", "op_sepolia", 18389},
-		{"omni_consensus|F", "arb_sepolia", 40},
-		{"omni_consensus|F", "base_sepolia", 40},
-		{"omni_consensus|F", "holesky", 40},
-		{"omni_consensus|F", "omni_evm", 40},
-		{"omni_consensus|F", "op_sepolia", 40},
-		{"omni_evm|F", "arb_sepolia", 23015},
-		{"omni_evm|F", "base_sepolia", 23015},
-		{"omni_evm|F", "holesky", 23015},
-		{"omni_evm|F", "op_sepolia", 23015},
-		{"op_sepolia|F", "arb_sepolia", 10764},
-		{"op_sepolia|F", "base_sepolia", 10764},
-		{"op_sepolia|F", "holesky", 10764},
-		{"op_sepolia|F", "omni_evm", 10758},
-		{"op_sepolia|L", "arb_sepolia", 10764},
-		{"op_sepolia|L", "base_sepolia", 10764},
-		{"op_sepolia|L", "holesky", 10764},
-		{"op_sepolia|L", "omni_evm", 10754},
-	},
+	return lo, nil
 }
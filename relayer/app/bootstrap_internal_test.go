@@ -0,0 +1,97 @@
+package relayer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/omni-network/omni/lib/cchain"
+	"github.com/omni-network/omni/lib/xchain"
+
+	"github.com/stretchr/testify/require"
+)
+
+type bootstrapMockProvider struct {
+	cchain.Provider
+	latest uint64
+}
+
+func (m bootstrapMockProvider) LatestAttestation(_ context.Context, chainVer xchain.ChainVersion) (xchain.Attestation, bool, error) {
+	if m.latest == 0 {
+		return xchain.Attestation{}, false, nil
+	}
+
+	return m.attestation(chainVer, m.latest), true, nil
+}
+
+func (m bootstrapMockProvider) AttestationsFrom(_ context.Context, chainVer xchain.ChainVersion, offset uint64) ([]xchain.Attestation, error) {
+	var resp []xchain.Attestation
+	for i := offset; i <= m.latest && len(resp) < 3; i++ {
+		resp = append(resp, m.attestation(chainVer, i))
+	}
+
+	return resp, nil
+}
+
+func (bootstrapMockProvider) attestation(chainVer xchain.ChainVersion, offset uint64) xchain.Attestation {
+	return xchain.Attestation{
+		AttestHeader: xchain.AttestHeader{ChainVersion: chainVer, AttestOffset: offset},
+		BlockHeader:  xchain.BlockHeader{ChainID: chainVer.ID, BlockHeight: offset * 10},
+	}
+}
+
+func TestDiscoverBootstrapOffset(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	chainVer := xchain.ChainVersion{ID: 1, ConfLevel: xchain.ConfFinalized}
+	stream := xchain.StreamID{SourceChainID: 1, DestChainID: 2, ShardID: xchain.ShardFinalized0}
+
+	// Messages are emitted in the blocks of attestations 3, 7 and 12.
+	emitted := map[uint64]uint64{3: 1, 7: 2, 12: 3}
+	xProv := &mockXChainClient{
+		GetEmittedCursorFn: func(_ context.Context, ref xchain.Ref, s xchain.StreamID) (xchain.EmitCursor, bool, error) {
+			var offset uint64
+			for attestOffset, msgOffset := range emitted {
+				if attestOffset*10 <= *ref.Height {
+					offset = max(offset, msgOffset)
+				}
+			}
+
+			return xchain.EmitCursor{StreamID: s, MsgOffset: offset}, offset > 0, nil
+		},
+	}
+
+	tests := []struct {
+		name      string
+		latest    uint64
+		from      uint64
+		submitted uint64
+		expected  uint64
+	}{
+		{name: "none submitted", latest: 20, from: 1, submitted: 0, expected: 2},
+		{name: "first submitted", latest: 20, from: 3, submitted: 1, expected: 6},
+		{name: "second submitted", latest: 20, from: 7, submitted: 2, expected: 11},
+		{name: "all submitted", latest: 20, from: 12, submitted: 3, expected: 20},
+		{name: "pending at from", latest: 20, from: 7, submitted: 1, expected: 7},
+		{name: "from latest", latest: 7, from: 7, submitted: 2, expected: 7},
+		{name: "no attestations", latest: 0, from: 1, submitted: 0, expected: 1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			offset, err := discoverBootstrapOffset(
+				ctx,
+				bootstrapMockProvider{latest: test.latest},
+				xProv,
+				chainVer,
+				test.from,
+				[]xchain.StreamID{stream},
+				map[xchain.StreamID]uint64{stream: test.submitted},
+			)
+			require.NoError(t, err)
+			require.Equal(t, test.expected, offset)
+		})
+	}
+}
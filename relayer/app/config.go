@@ -3,11 +3,13 @@ package relayer
 import (
 	"bytes"
 	"text/template"
+	"time"
 
 	"github.com/omni-network/omni/lib/buildinfo"
 	"github.com/omni-network/omni/lib/errors"
 	"github.com/omni-network/omni/lib/log"
 	"github.com/omni-network/omni/lib/netconf"
+	"github.com/omni-network/omni/lib/signer"
 	"github.com/omni-network/omni/lib/xchain"
 
 	cmtos "github.com/cometbft/cometbft/libs/os"
@@ -18,19 +20,29 @@ import (
 type Config struct {
 	RPCEndpoints   xchain.RPCEndpoints
 	PrivateKey     string
+	LaneSigners    []string // Additional signers of parallel send lanes; key paths if local, else addresses
+	Signer         signer.Config
 	HaloURL        string
+	ArchiveURL     string // Optional attestation archive gRPC address
 	Network        netconf.ID
 	MonitoringAddr string
+	AdminAddr      string // Optional admin API address, disabled if empty
 	DBDir          string
+	SubmitPolicy   string            // Default submit policy of all streams
+	SubmitPolicies map[string]string // Stream name to submit policy overrides
+	SubmitMaxDelay time.Duration
 }
 
 func DefaultConfig() Config {
 	return Config{
 		PrivateKey:     "relayer.key",
+		Signer:         signer.DefaultConfig(),
 		HaloURL:        "localhost:26657",
 		Network:        "",
 		MonitoringAddr: ":26660",
 		DBDir:          "./db",
+		SubmitPolicy:   string(policyAlways),
+		SubmitMaxDelay: time.Hour,
 	}
 }
 
@@ -13,6 +13,7 @@ import (
 
 // CreateSubmissions splits the update into multiple submissions that are each small enough (wrt calldata and gas)
 // to be submitted on-chain.
+// Note that the portal only supports a single attestation per submission, so attestations cannot be coalesced.
 func CreateSubmissions(up StreamUpdate) ([]xchain.Submission, error) {
 	// Sanity check on input, should only be for a single stream.
 	for i, msg := range up.Msgs {
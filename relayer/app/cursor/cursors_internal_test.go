@@ -127,6 +127,14 @@ func TestStore(t *testing.T) {
 	assert(t, streamID2, 21, 22)
 	assertCount(t, 2, 2)
 
+	// Find latest cursors before msg offsets
+	for msgOffset, expect := range map[uint64]uint64{203: 22, 202: 21, 201: 0} {
+		attOffset, ok, err := store.AttestOffsetBefore(ctx, streamID2.ChainVersion(), destChainID, streamID2.ShardID, msgOffset)
+		require.NoError(t, err)
+		require.Equal(t, expect != 0, ok)
+		require.Equal(t, expect, attOffset)
+	}
+
 	// Confirm streamID2 attOffset=22
 	require.NoError(t, store.confirmOnce(ctx))
 	assert(t, streamID1, 11, 12) // Unconfirmed
@@ -102,6 +102,58 @@ func (s *Store) WorkerOffsets(
 	return resp, nil
 }
 
+// ListTo returns all stored cursors to the provided destination chain.
+// Results are ordered by SrcChainId-ConfLevel-AttestOffset ascending.
+func (s *Store) ListTo(ctx context.Context, destChain uint64) ([]*Cursor, error) {
+	all, err := listAll(ctx, s.db)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp []*Cursor
+	for _, c := range all {
+		if c.GetDstChainId() == destChain {
+			resp = append(resp, c)
+		}
+	}
+
+	return resp, nil
+}
+
+// AttestOffsetBefore returns the attest offset of the latest stored cursor of the streamer
+// containing messages of the stream shard lower than the provided message offset, or false if none exist.
+// Streaming from the returned offset therefore includes all messages from the provided message offset.
+func (s *Store) AttestOffsetBefore(
+	ctx context.Context,
+	srcVersion xchain.ChainVersion,
+	destChain uint64,
+	shard xchain.ShardID,
+	msgOffset uint64,
+) (uint64, bool, error) {
+	cursors, err := s.ListTo(ctx, destChain)
+	if err != nil {
+		return 0, false, err
+	}
+
+	var resp uint64
+	var found bool
+	for _, c := range cursors {
+		if c.GetSrcChainId() != srcVersion.ID || c.GetConfLevel() != uint32(srcVersion.ConfLevel) {
+			continue
+		}
+
+		offset, ok := c.GetStreamOffsetsByShard()[uint64(shard)]
+		if !ok || offset >= msgOffset {
+			continue
+		}
+
+		resp = c.GetAttestOffset()
+		found = true
+	}
+
+	return resp, found, nil
+}
+
 // Insert cursor for the provided streamer if it doesn't exist, otherwise ignore (keep existing).
 func (s *Store) Insert(
 	ctx context.Context,
@@ -155,6 +155,10 @@ func (m *mockProvider) StreamAsync(ctx context.Context, chainVer xchain.ChainVer
 	m.SubscribeFn(ctx, chainVer, attestOffset, callback)
 }
 
+func (*mockProvider) LatestAttestation(context.Context, xchain.ChainVersion) (xchain.Attestation, bool, error) {
+	return xchain.Attestation{}, false, nil // No bootstrap discovery
+}
+
 func (m *mockProvider) PortalValidatorSet(ctx context.Context, valSetID uint64) ([]cchain.PortalValidator, bool, error) {
 	if valSetID != mockValSetID {
 		return nil, false, errors.New("unknown validator set ID")
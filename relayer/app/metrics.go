@@ -34,6 +34,27 @@ var (
 		Help:      "The total number of submissions to destination chain from a specific source chain",
 	}, []string{"src_chain", "dst_chain"})
 
+	attestSkipped = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "relayer",
+		Subsystem: "worker",
+		Name:      "attestation_skipped_total",
+		Help:      "The total number of attestations not submitted to a destination chain since they contain no applicable messages",
+	}, []string{"src_chain_version", "dst_chain"})
+
+	deferredMsgs = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "relayer",
+		Subsystem: "worker",
+		Name:      "deferred_msgs",
+		Help:      "The number of messages currently deferred by the submit policy per stream. Alert if too high",
+	}, []string{"stream"})
+
+	deferredMsgTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "relayer",
+		Subsystem: "worker",
+		Name:      "deferred_msg_total",
+		Help:      "The total number of messages deferred by the submit policy per stream",
+	}, []string{"stream"})
+
 	msgTotal = promauto.NewCounterVec(prometheus.CounterOpts{
 		Namespace: "relayer",
 		Subsystem: "worker",
@@ -56,6 +77,20 @@ var (
 		Buckets:   prometheus.ExponentialBucketsRange(21_000, 10_000_000, 8),
 	}, []string{"dst_chain"})
 
+	accountNonce = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "relayer",
+		Subsystem: "worker",
+		Name:      "account_nonce",
+		Help:      "The nonce of the latest submission by sender account (lane) per destination chain",
+	}, []string{"chain", "address"})
+
+	accountBalance = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "relayer",
+		Subsystem: "worker",
+		Name:      "account_balance_ether",
+		Help:      "The native token balance of sender account (lane) per destination chain (in ether). Alert if too low",
+	}, []string{"chain", "address"})
+
 	spendTotal = promauto.NewCounterVec(prometheus.CounterOpts{
 		Namespace: "relayer",
 		Subsystem: "worker",
@@ -1,17 +1,25 @@
 package relayer
 
 import (
+	"context"
 	"net/http"
 	"net/http/pprof"
 	"time"
 
 	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/ethclient"
+	"github.com/omni-network/omni/lib/log"
+	"github.com/omni-network/omni/lib/netconf"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
-// serveMonitoring starts a goroutine that serves the monitoring API. It
-// returns a channel that will receive an error if the server fails to start.
+// balancePollPeriod is the period at which sender account balances are monitored.
+const balancePollPeriod = 30 * time.Second
+
+// serveMonitoring starts a goroutine that serves the monitoring API using the provided mux,
+// allowing handlers to be registered later. It returns a channel that will receive an error if the server fails to start.
 func serveMonitoring(address string) <-chan error {
 	errChan := make(chan error)
 	go func() {
@@ -37,3 +45,30 @@ func serveMonitoring(address string) <-chan error {
 
 	return errChan
 }
+
+// monitorBalancesForever blocks until the context is closed and periodically updates
+// the balance metric of each sender account on the chain.
+func monitorBalancesForever(ctx context.Context, chain netconf.Chain, ethCl ethclient.Client, addrs []common.Address) {
+	ticker := time.NewTicker(balancePollPeriod)
+	defer ticker.Stop()
+
+	for {
+		for _, addr := range addrs {
+			balance, err := ethCl.EtherBalanceAt(ctx, addr)
+			if ctx.Err() != nil {
+				return
+			} else if err != nil {
+				log.Warn(ctx, "Failed monitoring sender balance (will retry)", err, "chain", chain.Name, "address", addr)
+				continue
+			}
+
+			accountBalance.WithLabelValues(chain.Name, addr.Hex()).Set(balance)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
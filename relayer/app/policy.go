@@ -0,0 +1,349 @@
+package relayer
+
+import (
+	"context"
+	"math/big"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/ethclient"
+	"github.com/omni-network/omni/lib/evmchain"
+	"github.com/omni-network/omni/lib/log"
+	"github.com/omni-network/omni/lib/netconf"
+	"github.com/omni-network/omni/lib/tokens"
+	"github.com/omni-network/omni/lib/umath"
+	"github.com/omni-network/omni/lib/xchain"
+)
+
+// policyRecheckPeriod is the period at which deferred submissions are re-evaluated.
+const policyRecheckPeriod = 30 * time.Second
+
+// submitPolicy defines when submissions of a stream are submitted.
+type submitPolicy string
+
+const (
+	// policyAlways submits immediately, regardless of cost.
+	policyAlways submitPolicy = "always"
+	// policyProfitable defers each submission until its collected fees cover its estimated cost.
+	policyProfitable submitPolicy = "profitable"
+	// policyBatch defers submissions until the collected fees of all deferred submissions cover their total estimated cost.
+	policyBatch submitPolicy = "batch"
+)
+
+func (p submitPolicy) Verify() error {
+	switch p {
+	case policyAlways, policyProfitable, policyBatch:
+		return nil
+	default:
+		return errors.New("invalid submit policy", "policy", p)
+	}
+}
+
+// submitPolicies configures the pre-submit policies of the streams to a destination chain.
+type submitPolicies struct {
+	// Policy returns the policy of the stream, nil defaults to policyAlways for all streams.
+	Policy func(xchain.StreamID) submitPolicy
+	// Estimate estimates submission cost and fees, only required for non-always policies.
+	Estimate costEstimator
+	// MaxDelay is the maximum duration a submission is deferred before it is submitted regardless of cost.
+	MaxDelay time.Duration
+}
+
+// parseSubmitPolicies returns a function that returns the policy of each stream given the default policy
+// and policy overrides by stream name. Consensus chain streams always submit, since they collect no fees.
+func parseSubmitPolicies(network netconf.Network, defaultPolicy string, policies map[string]string) (func(xchain.StreamID) submitPolicy, error) {
+	def := submitPolicy(defaultPolicy)
+	if err := def.Verify(); err != nil {
+		return nil, err
+	}
+
+	streamsByName := make(map[string]xchain.StreamID)
+	for _, stream := range network.EVMStreams() {
+		streamsByName[strings.ToLower(network.StreamName(stream))] = stream // Config keys are case-insensitive
+	}
+
+	byStream := make(map[xchain.StreamID]submitPolicy)
+	for name, policy := range policies {
+		stream, ok := streamsByName[strings.ToLower(name)]
+		if !ok {
+			return nil, errors.New("unknown submit policy stream", "stream", name)
+		}
+
+		p := submitPolicy(policy)
+		if err := p.Verify(); err != nil {
+			return nil, errors.Wrap(err, "stream policy", "stream", name)
+		}
+
+		byStream[stream] = p
+	}
+
+	return func(stream xchain.StreamID) submitPolicy {
+		if netconf.IsOmniConsensus(network.ID, stream.SourceChainID) {
+			return policyAlways
+		} else if p, ok := byStream[stream]; ok {
+			return p
+		}
+
+		return def
+	}, nil
+}
+
+// costEstimator returns the estimated cost of a submission and the fees collected by its messages, both in nano USD.
+type costEstimator func(ctx context.Context, sub xchain.Submission) (cost float64, fees float64, err error)
+
+// newCostEstimator returns a cost estimator using the naive gas model and the live destination chain gas price.
+func newCostEstimator(network netconf.ID, ethCl ethclient.Client, pricer tokens.Pricer) costEstimator {
+	estimateGas := newGasEstimator(network)
+
+	return func(ctx context.Context, sub xchain.Submission) (float64, float64, error) {
+		dest, ok := evmchain.MetadataByID(sub.DestChainID)
+		if !ok {
+			return 0, 0, errors.New("unknown destination chain ID")
+		}
+
+		src, ok := evmchain.MetadataByID(sub.BlockHeader.ChainID)
+		if !ok {
+			return 0, 0, errors.New("unknown source chain ID")
+		}
+
+		gas := estimateGas(sub.DestChainID, sub.Msgs)
+		if gas == properGasEstimation {
+			gas = naiveSubmissionGas(sub.Msgs)
+		}
+
+		gasPrice, err := ethCl.SuggestGasPrice(ctx)
+		if err != nil {
+			return 0, 0, errors.Wrap(err, "suggest gas price")
+		}
+
+		prices, err := pricer.Price(ctx, tokens.OMNI, tokens.ETH)
+		if err != nil {
+			return 0, 0, errors.Wrap(err, "get prices")
+		}
+
+		spend, err := spendByDenom(dest, toGwei(new(big.Int).Mul(gasPrice, umath.NewBigInt(gas))), prices)
+		if err != nil {
+			return 0, 0, errors.Wrap(err, "get spend")
+		}
+
+		fees, err := feeByDenom(src, sub, prices)
+		if err != nil {
+			return 0, 0, errors.Wrap(err, "get fees")
+		}
+
+		return spend.nUSD, fees.nUSD, nil
+	}
+}
+
+// deferredSub is a submission deferred by its stream policy.
+type deferredSub struct {
+	Sub    xchain.Submission
+	Queued time.Time
+}
+
+// submitGate applies stream policies to submissions before forwarding them to send.
+// Deferred submissions are queued per stream, so ordering of each stream is preserved.
+// Releases of a stream are serialized by a per-stream lock, so estimating and sending
+// submissions of one stream doesn't block other streams.
+type submitGate struct {
+	policies   submitPolicies
+	streamName func(xchain.StreamID) string
+	send       func(context.Context, xchain.Submission) error
+	now        func() time.Time
+
+	mu        sync.Mutex // Protects deferred and releasing, never held during estimation or send.
+	deferred  map[xchain.StreamID][]deferredSub
+	releasing map[xchain.StreamID]*sync.Mutex
+}
+
+func newSubmitGate(
+	policies submitPolicies,
+	streamName func(xchain.StreamID) string,
+	send func(context.Context, xchain.Submission) error,
+) *submitGate {
+	return &submitGate{
+		policies:   policies,
+		streamName: streamName,
+		send:       send,
+		now:        time.Now,
+		deferred:   make(map[xchain.StreamID][]deferredSub),
+		releasing:  make(map[xchain.StreamID]*sync.Mutex),
+	}
+}
+
+// Submit forwards the submission to send if allowed by the stream policy, otherwise it is deferred.
+func (g *submitGate) Submit(ctx context.Context, stream xchain.StreamID, sub xchain.Submission) error {
+	g.mu.Lock()
+	g.deferred[stream] = append(g.deferred[stream], deferredSub{Sub: sub, Queued: g.now()})
+	g.mu.Unlock()
+
+	remaining, err := g.release(ctx, stream)
+	if err != nil {
+		return err
+	}
+
+	if remaining > 0 { // Submission is last in the queue, so it was deferred.
+		deferredMsgTotal.WithLabelValues(g.streamName(stream)).Add(float64(len(sub.Msgs)))
+	}
+
+	return nil
+}
+
+// Run re-evaluates deferred submissions periodically until the context is canceled.
+func (g *submitGate) Run(ctx context.Context) {
+	ticker := time.NewTicker(policyRecheckPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := g.releaseAll(ctx); err != nil {
+				log.Warn(ctx, "Failed releasing deferred submissions", err)
+			}
+		}
+	}
+}
+
+func (g *submitGate) releaseAll(ctx context.Context) error {
+	g.mu.Lock()
+	streams := make([]xchain.StreamID, 0, len(g.deferred))
+	for stream := range g.deferred {
+		streams = append(streams, stream)
+	}
+	g.mu.Unlock()
+
+	for _, stream := range streams {
+		if _, err := g.release(ctx, stream); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// release forwards the releasable deferred submissions of the stream to send.
+// It returns the number of remaining deferred submissions of the stream.
+//
+// Deferred submissions are snapshotted under the gate lock, then estimated and sent
+// while only holding the stream's release lock.
+func (g *submitGate) release(ctx context.Context, stream xchain.StreamID) (int, error) {
+	releasing := g.releaseLock(stream)
+	releasing.Lock()
+	defer releasing.Unlock()
+
+	g.mu.Lock()
+	deferred := slices.Clone(g.deferred[stream])
+	g.mu.Unlock()
+
+	n, err := g.releasable(ctx, g.policy(stream), deferred)
+	if err != nil {
+		// Fail open, rather than stalling the stream if estimation is unavailable.
+		log.Warn(ctx, "Failed estimating submission profitability, submitting", err, "stream", g.streamName(stream))
+		n = len(deferred)
+	}
+
+	var sent int
+	var sendErr error
+	for _, d := range deferred[:n] {
+		if sendErr = g.send(ctx, d.Sub); sendErr != nil {
+			break
+		}
+		sent++
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	// Only releases remove submissions from the head of the queue, and this holds the stream's release lock,
+	// so the sent submissions are still at the head, even if others were appended concurrently.
+	remaining := g.deferred[stream][sent:]
+	if len(remaining) == 0 {
+		delete(g.deferred, stream)
+	} else {
+		g.deferred[stream] = remaining
+	}
+
+	var msgs int
+	for _, d := range remaining {
+		msgs += len(d.Sub.Msgs)
+	}
+	deferredMsgs.WithLabelValues(g.streamName(stream)).Set(float64(msgs))
+
+	return len(remaining), sendErr
+}
+
+// releaseLock returns the lock serializing releases of the stream.
+func (g *submitGate) releaseLock(stream xchain.StreamID) *sync.Mutex {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	lock, ok := g.releasing[stream]
+	if !ok {
+		lock = new(sync.Mutex)
+		g.releasing[stream] = lock
+	}
+
+	return lock
+}
+
+// releasable returns the number of deferred submissions, from the head of the queue, allowed by the policy.
+func (g *submitGate) releasable(ctx context.Context, policy submitPolicy, deferred []deferredSub) (int, error) {
+	if len(deferred) == 0 {
+		return 0, nil
+	} else if policy == policyAlways || g.expired(deferred[0]) {
+		return len(deferred), nil
+	}
+
+	switch policy {
+	case policyProfitable:
+		for i, d := range deferred {
+			if g.expired(d) {
+				continue
+			}
+
+			cost, fees, err := g.policies.Estimate(ctx, d.Sub)
+			if err != nil {
+				return 0, err
+			} else if fees < cost {
+				return i, nil
+			}
+		}
+
+		return len(deferred), nil
+	case policyBatch:
+		var totalCost, totalFees float64
+		for _, d := range deferred {
+			cost, fees, err := g.policies.Estimate(ctx, d.Sub)
+			if err != nil {
+				return 0, err
+			}
+			totalCost += cost
+			totalFees += fees
+		}
+
+		if totalFees < totalCost {
+			return 0, nil
+		}
+
+		return len(deferred), nil
+	default:
+		return 0, errors.New("unknown submit policy [BUG]", "policy", policy)
+	}
+}
+
+func (g *submitGate) expired(d deferredSub) bool {
+	return g.now().Sub(d.Queued) >= g.policies.MaxDelay
+}
+
+func (g *submitGate) policy(stream xchain.StreamID) submitPolicy {
+	if g.policies.Policy == nil {
+		return policyAlways
+	}
+
+	return g.policies.Policy(stream)
+}
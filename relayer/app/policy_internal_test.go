@@ -0,0 +1,129 @@
+package relayer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/omni-network/omni/lib/netconf"
+	"github.com/omni-network/omni/lib/xchain"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSubmitPolicies(t *testing.T) {
+	t.Parallel()
+
+	network := netconf.Network{
+		ID: netconf.Devnet,
+		Chains: []netconf.Chain{
+			{ID: 1, Name: "chain_a", Shards: []xchain.ShardID{xchain.ShardFinalized0, xchain.ShardLatest0}},
+			{ID: 2, Name: "chain_b", Shards: []xchain.ShardID{xchain.ShardFinalized0}},
+		},
+	}
+
+	streamAL := xchain.StreamID{SourceChainID: 1, DestChainID: 2, ShardID: xchain.ShardLatest0}
+	streamAF := xchain.StreamID{SourceChainID: 1, DestChainID: 2, ShardID: xchain.ShardFinalized0}
+	consensus := xchain.StreamID{SourceChainID: netconf.Devnet.Static().OmniConsensusChainIDUint64(), DestChainID: 2}
+
+	policy, err := parseSubmitPolicies(network, "profitable", map[string]string{"chain_a|l|chain_b": "batch"})
+	require.NoError(t, err)
+	require.Equal(t, policyBatch, policy(streamAL))
+	require.Equal(t, policyProfitable, policy(streamAF))
+	require.Equal(t, policyAlways, policy(consensus))
+
+	_, err = parseSubmitPolicies(network, "unknown", nil)
+	require.Error(t, err)
+
+	_, err = parseSubmitPolicies(network, "always", map[string]string{"chain_a|L|chain_c": "batch"})
+	require.ErrorContains(t, err, "unknown submit policy stream")
+
+	_, err = parseSubmitPolicies(network, "always", map[string]string{"chain_a|L|chain_b": "never"})
+	require.ErrorContains(t, err, "invalid submit policy")
+}
+
+func TestSubmitGate(t *testing.T) {
+	t.Parallel()
+
+	const maxDelay = time.Hour
+
+	// Submissions are identified by their valset ID, with fees and costs per submission.
+	type cost struct {
+		cost, fees float64
+	}
+
+	tests := []struct {
+		name     string
+		policy   submitPolicy
+		costs    []cost
+		expected []uint64 // Released submissions
+	}{
+		{
+			name:     "always",
+			policy:   policyAlways,
+			costs:    []cost{{10, 0}, {10, 0}},
+			expected: []uint64{0, 1},
+		},
+		{
+			name:     "profitable",
+			policy:   policyProfitable,
+			costs:    []cost{{10, 20}, {10, 5}, {10, 20}},
+			expected: []uint64{0}, // Second blocks third
+		},
+		{
+			name:     "batch unprofitable",
+			policy:   policyBatch,
+			costs:    []cost{{10, 5}, {10, 0}},
+			expected: nil,
+		},
+		{
+			name:     "batch profitable",
+			policy:   policyBatch,
+			costs:    []cost{{10, 5}, {10, 0}, {10, 30}},
+			expected: []uint64{0, 1, 2},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			stream := xchain.StreamID{SourceChainID: 1, DestChainID: 2}
+
+			var released []uint64
+			gate := newSubmitGate(
+				submitPolicies{
+					Policy: func(xchain.StreamID) submitPolicy { return test.policy },
+					Estimate: func(_ context.Context, sub xchain.Submission) (float64, float64, error) {
+						c := test.costs[sub.ValidatorSetID]
+						return c.cost, c.fees, nil
+					},
+					MaxDelay: maxDelay,
+				},
+				func(xchain.StreamID) string { return "stream" },
+				func(_ context.Context, sub xchain.Submission) error {
+					released = append(released, sub.ValidatorSetID)
+					return nil
+				},
+			)
+
+			now := time.Now()
+			gate.now = func() time.Time { return now }
+
+			for i := range test.costs {
+				require.NoError(t, gate.Submit(ctx, stream, xchain.Submission{ValidatorSetID: uint64(i)}))
+			}
+			require.Equal(t, test.expected, released)
+
+			// All deferred submissions are released in order after max delay.
+			now = now.Add(maxDelay)
+			require.NoError(t, gate.releaseAll(ctx))
+			for i := range test.costs {
+				require.EqualValues(t, i, released[i])
+			}
+			require.Len(t, released, len(test.costs))
+			require.Empty(t, gate.deferred)
+		})
+	}
+}
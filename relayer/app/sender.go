@@ -2,7 +2,6 @@ package relayer
 
 import (
 	"context"
-	"crypto/ecdsa"
 	"math/big"
 	"slices"
 	"strings"
@@ -13,6 +12,7 @@ import (
 	"github.com/omni-network/omni/lib/evmchain"
 	"github.com/omni-network/omni/lib/log"
 	"github.com/omni-network/omni/lib/netconf"
+	"github.com/omni-network/omni/lib/signer"
 	"github.com/omni-network/omni/lib/tokens"
 	"github.com/omni-network/omni/lib/txmgr"
 	"github.com/omni-network/omni/lib/xchain"
@@ -45,18 +45,17 @@ func NewSender(
 	network netconf.ID,
 	chain netconf.Chain,
 	rpcClient ethclient.Client,
-	privateKey ecdsa.PrivateKey,
+	sgnr signer.Signer,
 	chainNames map[xchain.ChainVersion]string,
 	onSubmit onSubmitFunc,
 ) (Sender, error) {
 	const receiptPollFreq = 3 // Query receipts every 1/3 of the block time
-	cfg, err := txmgr.NewConfig(
+	cfg, err := sgnr.TxMgrConfig(
 		txmgr.NewCLIConfig(
 			chain.ID,
 			chain.BlockPeriod/receiptPollFreq,
 			txmgr.DefaultSenderFlagValues,
 		),
-		&privateKey,
 		rpcClient,
 	)
 	if err != nil {
@@ -164,6 +163,7 @@ func (s Sender) SendAsync(ctx context.Context, sub xchain.Submission) <-chan err
 		}
 
 		submissionTotal.WithLabelValues(srcChain, dstChain).Inc()
+		accountNonce.WithLabelValues(dstChain, s.txMgr.From().Hex()).Set(float64(tx.Nonce()))
 		msgTotal.WithLabelValues(srcChain, dstChain).Add(float64(len(sub.Msgs)))
 		gasEstimated.WithLabelValues(dstChain).Observe(float64(estimatedGas))
 
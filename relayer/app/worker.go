@@ -2,6 +2,9 @@ package relayer
 
 import (
 	"context"
+	"encoding/binary"
+	"hash/fnv"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -23,15 +26,23 @@ const (
 	mempoolLimit = 16
 )
 
+// errResetRequested is the cause of worker resets requested via Reset.
+var errResetRequested = errors.NewSentinel("worker reset requested")
+
 type Worker struct {
 	destChain    netconf.Chain // Destination chain
 	network      netconf.Network
 	cProvider    cchain.Provider
 	xProvider    xchain.Provider
 	creator      CreateFunc
-	sendProvider func() (SendAsync, error)
+	sendProvider func() ([]SendAsync, error)
 	awaitValSet  awaitValSet
 	cursors      *cursor.Store
+	policies     submitPolicies
+
+	mu       sync.Mutex
+	cancel   context.CancelCauseFunc        // Cancels the current runOnce
+	restream map[xchain.ChainVersion]uint64 // Attest offsets to restream from on next runOnce
 }
 
 // NewWorker creates a new worker for a single destination chain.
@@ -41,9 +52,10 @@ func NewWorker(
 	cProvider cchain.Provider,
 	xProvider xchain.Provider,
 	creator CreateFunc,
-	sendProvider func() (SendAsync, error),
+	sendProvider func() ([]SendAsync, error),
 	awaitValSet awaitValSet,
 	cursors *cursor.Store,
+	policies submitPolicies,
 ) *Worker {
 	return &Worker{
 		destChain:    destChain,
@@ -54,9 +66,31 @@ func NewWorker(
 		sendProvider: sendProvider,
 		awaitValSet:  awaitValSet,
 		cursors:      cursors,
+		policies:     policies,
+		restream:     make(map[xchain.ChainVersion]uint64),
+	}
+}
+
+// Reset resets the worker, re-initializing it from the on-chain submitted cursors.
+func (w *Worker) Reset() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.cancel != nil {
+		w.cancel(errResetRequested)
 	}
 }
 
+// Restream resets the worker, streaming attestations of the chain version from the provided attest offset.
+// This resubmits any messages of those attestations not yet submitted on-chain.
+func (w *Worker) Restream(chainVer xchain.ChainVersion, attestOffset uint64) {
+	w.mu.Lock()
+	w.restream[chainVer] = attestOffset
+	w.mu.Unlock()
+
+	w.Reset()
+}
+
 func (w *Worker) Run(ctx context.Context) {
 	ctx = log.WithCtx(ctx, "dst_chain", w.destChain.Name)
 	backoff := expbackoff.NewWithAutoReset(ctx)
@@ -64,6 +98,8 @@ func (w *Worker) Run(ctx context.Context) {
 		err := w.runOnce(ctx)
 		if ctx.Err() != nil {
 			return
+		} else if errors.Is(err, errResetRequested) {
+			log.Info(ctx, "Worker reset requested, resetting")
 		} else if errors.Is(err, chaos.ErrChaos) {
 			log.InfoErr(ctx, "Worker failed due to chaos testing, resetting", err)
 		} else {
@@ -78,8 +114,14 @@ func (w *Worker) Run(ctx context.Context) {
 func (w *Worker) runOnce(ctx context.Context) error {
 	log.Info(ctx, "Worker starting")
 
-	ctx, cancel := context.WithCancel(ctx)
-	defer cancel()
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	w.mu.Lock()
+	w.cancel = cancel
+	restream := w.restream
+	w.restream = make(map[xchain.ChainVersion]uint64)
+	w.mu.Unlock()
 
 	cursors, err := getSubmittedCursors(ctx, w.network, w.destChain.ID, w.xProvider)
 	if err != nil {
@@ -94,22 +136,50 @@ func (w *Worker) runOnce(ctx context.Context) error {
 		)
 	}
 
-	sender, err := w.sendProvider()
+	lanes, err := w.sendProvider()
 	if err != nil {
 		return err
+	} else if len(lanes) == 0 {
+		return errors.New("no send lanes [BUG]")
 	}
 
-	buf := newActiveBuffer(w.destChain.Name, mempoolLimit, sender)
+	// Each lane (sender key) has its own buffer and mempool limit, so a stuck lane doesn't block other lanes.
+	bufs := make([]*activeBuffer, 0, len(lanes))
+	for _, sender := range lanes {
+		bufs = append(bufs, newActiveBuffer(w.destChain.Name, mempoolLimit, sender))
+	}
+	sendLane := func(ctx context.Context, sub xchain.Submission) error {
+		return bufs[laneIndex(sub, len(bufs))].AddInput(ctx, sub)
+	}
+
+	gate := newSubmitGate(w.policies, w.network.StreamName, sendLane)
+	go gate.Run(ctx)
 
 	attestOffsets, err := fromChainVersionOffsets(cursors, w.network.ChainVersionsTo(w.destChain.ID))
 	if err != nil {
 		return err
 	}
 
-	// TODO(corver): Remove this bootstrap workaround.
+	// Skip attestations up to which all messages have already been submitted.
+	submitted := make(map[xchain.StreamID]uint64)
+	for _, c := range cursors {
+		submitted[c.StreamID] = c.MsgOffset
+	}
 	for chainVer, offset := range attestOffsets {
-		bootstrapOffset, ok := getBootstrapOffset(w.network, w.destChain, chainVer)
-		if !ok {
+		var streams []xchain.StreamID
+		for _, stream := range w.network.StreamsTo(w.destChain.ID) {
+			if stream.ChainVersion() == chainVer {
+				streams = append(streams, stream)
+			}
+		}
+
+		bootstrapOffset, err := discoverBootstrapOffset(ctx, w.cProvider, w.xProvider, chainVer, offset, streams, submitted)
+		if err != nil {
+			// Not critical, streaming from the on-chain offset is just slower.
+			log.Warn(ctx, "Failed discovering bootstrap attest offset (will stream from on-chain offset)", err,
+				"chain_version", w.network.ChainVersionName(chainVer),
+			)
+
 			continue
 		} else if bootstrapOffset > offset {
 			log.Info(ctx, "Worker using bootstrap attest offset",
@@ -139,6 +209,20 @@ func (w *Worker) runOnce(ctx context.Context) error {
 		}
 	}
 
+	// Restream from requested offsets, even if lower.
+	for chainVer, offset := range restream {
+		if _, ok := attestOffsets[chainVer]; !ok {
+			continue
+		}
+
+		log.Info(ctx, "Worker restreaming from requested attest offset",
+			"chain_version", w.network.ChainVersionName(chainVer),
+			"prev", attestOffsets[chainVer],
+			"restream", offset,
+		)
+		attestOffsets[chainVer] = offset
+	}
+
 	msgFilter, err := newMsgOffsetFilter(cursors)
 	if err != nil {
 		return err
@@ -152,7 +236,7 @@ func (w *Worker) runOnce(ctx context.Context) error {
 
 		callback := w.newCallback(
 			msgFilter,
-			buf.AddInput,
+			gate.Submit,
 			newMsgStreamMapper(w.network),
 			chainVer,
 		)
@@ -164,7 +248,34 @@ func (w *Worker) runOnce(ctx context.Context) error {
 
 	log.Info(ctx, "Worker subscribed to chains", logAttrs...)
 
-	return buf.Run(ctx)
+	errs := make(chan error, len(bufs))
+	for _, buf := range bufs {
+		go func() {
+			errs <- buf.Run(ctx)
+		}()
+	}
+
+	err = <-errs // Return the first error, other buffers are stopped when ctx is canceled.
+	if cause := context.Cause(ctx); errors.Is(cause, errResetRequested) {
+		return cause
+	}
+
+	return err
+}
+
+// laneIndex returns the send lane of the submission's stream.
+// Submissions of a stream are always sent by the same lane, preserving stream ordering.
+func laneIndex(sub xchain.Submission, lanes int) int {
+	var shard xchain.ShardID
+	if len(sub.Msgs) > 0 {
+		shard = sub.Msgs[0].ShardID
+	}
+
+	h := fnv.New64a()
+	_ = binary.Write(h, binary.BigEndian, sub.BlockHeader.ChainID)
+	_ = binary.Write(h, binary.BigEndian, uint64(shard))
+
+	return int(h.Sum64() % uint64(lanes))
 }
 
 // awaitValSet blocks until the portal is aware of this validator set ID.
@@ -237,7 +348,7 @@ func newMsgStreamMapper(network netconf.Network) msgStreamMapper {
 
 func (w *Worker) newCallback(
 	msgFilter *msgCursorFilter,
-	sendBuffer func(context.Context, xchain.Submission) error,
+	submit func(context.Context, xchain.StreamID, xchain.Submission) error,
 	msgStreamMapper msgStreamMapper,
 	streamerChainVer xchain.ChainVersion, // Use streamer chain version for cursors since fuzzy overrides otherwise store latest streamed offsets to finalized streamer.
 ) cchain.ProviderCallback {
@@ -249,6 +360,16 @@ func (w *Worker) newCallback(
 			return w.cursors.Insert(ctx, streamerChainVer, w.destChain.ID, att.AttestOffset, streamMsgs)
 		}
 
+		// skip saves cursors of attestations without submissions, counting them as skipped.
+		skip := func(streamMsgs map[xchain.StreamID][]xchain.Msg) error {
+			if err := saveCursors(streamMsgs); err != nil {
+				return err
+			}
+			attestSkipped.WithLabelValues(w.network.ChainVersionName(att.ChainVersion), w.destChain.Name).Inc()
+
+			return nil
+		}
+
 		block, ok, err := fetchXBlock(ctx, w.xProvider, att)
 		if err != nil {
 			return err
@@ -256,7 +377,7 @@ func (w *Worker) newCallback(
 			return nil // Mismatching fuzzy attestation, skip.
 		} else if len(block.Msgs) == 0 {
 			// No messages, nothing to do, just update cursors
-			return saveCursors(nil)
+			return skip(nil)
 		}
 
 		msgTree, err := xchain.NewMsgTree(block.Msgs)
@@ -276,6 +397,7 @@ func (w *Worker) newCallback(
 
 		// Add all conf-level applicable messages to cursor store
 		applicable := make(map[xchain.StreamID][]xchain.Msg)
+		var submitted bool
 
 		// Split into streams
 		for streamID, msgs := range msgStreamMapper(block.Msgs) {
@@ -313,12 +435,18 @@ func (w *Worker) newCallback(
 			}
 
 			for _, subs := range submissions {
-				if err := sendBuffer(ctx, subs); err != nil {
+				if err := submit(ctx, streamID, subs); err != nil {
 					return err
 				}
+				submitted = true
 			}
 		}
 
+		if !submitted {
+			// No applicable (unsubmitted) messages for this destination.
+			return skip(applicable)
+		}
+
 		return saveCursors(applicable)
 	}
 }
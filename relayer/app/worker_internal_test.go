@@ -162,9 +162,10 @@ func TestWorker_Run(t *testing.T) {
 			mockProvider,
 			mockXClient,
 			mockCreateFunc,
-			func() (SendAsync, error) { return mockSender.SendTransaction, nil },
+			func() ([]SendAsync, error) { return []SendAsync{mockSender.SendTransaction}, nil },
 			noAwait,
-			cursors)
+			cursors,
+			submitPolicies{})
 		go w.Run(ctx)
 	}
 
@@ -225,3 +226,227 @@ func TestWorker_Run(t *testing.T) {
 	require.EqualValues(t, expectChainA, actualChainA)
 	require.EqualValues(t, expectChainB, actualChainB)
 }
+
+func TestWorker_SkipAttestations(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	const (
+		srcChain  = 1
+		destChain = 2
+	)
+
+	stream := xchain.StreamID{SourceChainID: srcChain, DestChainID: destChain, ShardID: xchain.ShardFinalized0}
+	chainVer := stream.ChainVersion()
+
+	// Blocks at even heights have a single message, odd heights are empty.
+	xClient := &mockXChainClient{
+		GetBlockFn: func(_ context.Context, req xchain.ProviderRequest) (xchain.Block, bool, error) {
+			block := xchain.Block{BlockHeader: xchain.BlockHeader{ChainID: req.ChainID, BlockHeight: req.Height}}
+			if req.Height%2 == 0 {
+				block.Msgs = []xchain.Msg{{MsgID: xchain.MsgID{StreamID: stream, StreamOffset: req.Height / 2}}}
+			}
+
+			return block, true, nil
+		},
+		GetSubmittedCursorFn: func(context.Context, xchain.Ref, xchain.StreamID) (xchain.SubmitCursor, bool, error) {
+			return xchain.SubmitCursor{}, false, nil
+		},
+	}
+
+	network := netconf.Network{Chains: []netconf.Chain{
+		{ID: srcChain, Name: "source", Shards: []xchain.ShardID{xchain.ShardFinalized0}},
+		{ID: destChain, Name: "dest"},
+	}}
+
+	cursors, err := cursor.New(db.NewMemDB(), xClient.GetSubmittedCursor, network)
+	require.NoError(t, err)
+
+	noAwait := func(context.Context, uint64) error { return nil }
+	w := NewWorker(network.Chains[1], network, &mockProvider{}, xClient, CreateSubmissions, nil, noAwait, cursors, submitPolicies{})
+
+	filter, err := newMsgOffsetFilter(nil)
+	require.NoError(t, err)
+
+	var submitted []xchain.Submission
+	submit := func(_ context.Context, _ xchain.StreamID, sub xchain.Submission) error {
+		submitted = append(submitted, sub)
+		return nil
+	}
+
+	callback := w.newCallback(filter, submit, newMsgStreamMapper(network), chainVer)
+
+	for height := uint64(1); height <= 4; height++ {
+		block, _, err := xClient.GetBlock(ctx, xchain.ProviderRequest{ChainID: srcChain, Height: height})
+		require.NoError(t, err)
+
+		var msgRoot [32]byte
+		if len(block.Msgs) > 0 {
+			tree, err := xchain.NewMsgTree(block.Msgs)
+			require.NoError(t, err)
+			msgRoot = tree.MsgRoot()
+		}
+
+		require.NoError(t, callback(ctx, xchain.Attestation{
+			AttestHeader:   xchain.AttestHeader{ChainVersion: chainVer, AttestOffset: height},
+			BlockHeader:    block.BlockHeader,
+			MsgRoot:        msgRoot,
+			ValidatorSetID: mockValSetID,
+		}))
+	}
+
+	// Only attestations with messages are submitted.
+	require.Len(t, submitted, 2)
+	require.EqualValues(t, 2, submitted[0].AttHeader.AttestOffset)
+	require.EqualValues(t, 4, submitted[1].AttHeader.AttestOffset)
+
+	// Cursors of skipped empty attestations are stored nonetheless, so restarts resume after them.
+	stored, err := cursors.ListTo(ctx, destChain)
+	require.NoError(t, err)
+	require.Len(t, stored, 4)
+}
+
+// TestWorker_FuzzyOverrides ensures that finalized attestations overriding both latest and safe
+// fuzzy streamers submit each message once, while storing cursors per streamer chain version.
+func TestWorker_FuzzyOverrides(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	const (
+		srcChain  = 1
+		destChain = 2
+	)
+
+	require.Equal(t, []xchain.ConfLevel{xchain.ConfLatest, xchain.ConfSafe}, xchain.FuzzyConfLevels())
+
+	shards := []xchain.ShardID{xchain.ShardFinalized0, xchain.ShardSafe0, xchain.ShardLatest0}
+	streamID := func(shard xchain.ShardID) xchain.StreamID {
+		return xchain.StreamID{SourceChainID: srcChain, DestChainID: destChain, ShardID: shard}
+	}
+
+	// Each block has a single message per shard.
+	xClient := &mockXChainClient{
+		GetBlockFn: func(_ context.Context, req xchain.ProviderRequest) (xchain.Block, bool, error) {
+			block := xchain.Block{BlockHeader: xchain.BlockHeader{ChainID: req.ChainID, BlockHeight: req.Height}}
+			for i, shard := range shards {
+				block.Msgs = append(block.Msgs, xchain.Msg{
+					MsgID:    xchain.MsgID{StreamID: streamID(shard), StreamOffset: req.Height},
+					LogIndex: uint64(i),
+				})
+			}
+
+			return block, true, nil
+		},
+		GetSubmittedCursorFn: func(context.Context, xchain.Ref, xchain.StreamID) (xchain.SubmitCursor, bool, error) {
+			return xchain.SubmitCursor{}, false, nil
+		},
+	}
+
+	network := netconf.Network{Chains: []netconf.Chain{
+		{ID: srcChain, Name: "source", Shards: shards},
+		{ID: destChain, Name: "dest"},
+	}}
+
+	cursors, err := cursor.New(db.NewMemDB(), xClient.GetSubmittedCursor, network)
+	require.NoError(t, err)
+
+	noAwait := func(context.Context, uint64) error { return nil }
+	w := NewWorker(network.Chains[1], network, &mockProvider{}, xClient, CreateSubmissions, nil, noAwait, cursors, submitPolicies{})
+
+	// All streamers share the same filter, as in runOnce.
+	filter, err := newMsgOffsetFilter(nil)
+	require.NoError(t, err)
+
+	submitted := make(map[xchain.StreamID][]uint64)
+	submit := func(_ context.Context, streamID xchain.StreamID, sub xchain.Submission) error {
+		for _, msg := range sub.Msgs {
+			submitted[streamID] = append(submitted[streamID], msg.StreamOffset)
+		}
+
+		return nil
+	}
+
+	newAtt := func(height uint64, confLevel xchain.ConfLevel) xchain.Attestation {
+		block, _, err := xClient.GetBlock(ctx, xchain.ProviderRequest{ChainID: srcChain, Height: height})
+		require.NoError(t, err)
+		tree, err := xchain.NewMsgTree(block.Msgs)
+		require.NoError(t, err)
+
+		return xchain.Attestation{
+			AttestHeader:   xchain.AttestHeader{ChainVersion: xchain.ChainVersion{ID: srcChain, ConfLevel: confLevel}, AttestOffset: height},
+			BlockHeader:    block.BlockHeader,
+			MsgRoot:        tree.MsgRoot(),
+			ValidatorSetID: mockValSetID,
+		}
+	}
+
+	callbacks := make(map[xchain.ConfLevel]cchain.ProviderCallback)
+	for _, shard := range shards {
+		chainVer := xchain.ChainVersion{ID: srcChain, ConfLevel: shard.ConfLevel()}
+		callbacks[chainVer.ConfLevel] = w.newCallback(filter, submit, newMsgStreamMapper(network), chainVer)
+	}
+
+	// Height 1: latest attestation first, then a finalized override of the safe streamer, then the finalized streamer.
+	require.NoError(t, callbacks[xchain.ConfLatest](ctx, newAtt(1, xchain.ConfLatest)))
+	require.NoError(t, callbacks[xchain.ConfSafe](ctx, newAtt(1, xchain.ConfFinalized)))
+	require.NoError(t, callbacks[xchain.ConfFinalized](ctx, newAtt(1, xchain.ConfFinalized)))
+
+	// Height 2: finalized overrides of both fuzzy streamers, then the finalized streamer.
+	require.NoError(t, callbacks[xchain.ConfLatest](ctx, newAtt(2, xchain.ConfFinalized)))
+	require.NoError(t, callbacks[xchain.ConfSafe](ctx, newAtt(2, xchain.ConfFinalized)))
+	require.NoError(t, callbacks[xchain.ConfFinalized](ctx, newAtt(2, xchain.ConfFinalized)))
+
+	// Height 3: safe attestation, which doesn't prove the latest or finalized shards.
+	require.NoError(t, callbacks[xchain.ConfSafe](ctx, newAtt(3, xchain.ConfSafe)))
+
+	// Each message is submitted exactly once, in order.
+	require.Equal(t, map[xchain.StreamID][]uint64{
+		streamID(xchain.ShardFinalized0): {1, 2},
+		streamID(xchain.ShardSafe0):      {1, 2, 3},
+		streamID(xchain.ShardLatest0):    {1, 2},
+	}, submitted)
+
+	// Cursors are stored by streamer chain version, not by the overriding attestation's chain version.
+	stored, err := cursors.ListTo(ctx, destChain)
+	require.NoError(t, err)
+	offsets := make(map[xchain.ConfLevel][]uint64)
+	for _, c := range stored {
+		offsets[xchain.ConfLevel(c.GetConfLevel())] = append(offsets[xchain.ConfLevel(c.GetConfLevel())], c.GetAttestOffset())
+	}
+	require.Equal(t, map[xchain.ConfLevel][]uint64{
+		xchain.ConfFinalized: {1, 2},
+		xchain.ConfSafe:      {1, 2, 3},
+		xchain.ConfLatest:    {1, 2},
+	}, offsets)
+}
+
+func TestLaneIndex(t *testing.T) {
+	t.Parallel()
+
+	const lanes = 4
+
+	newSub := func(srcChainID uint64, shard xchain.ShardID, offset uint64) xchain.Submission {
+		return xchain.Submission{
+			BlockHeader: xchain.BlockHeader{ChainID: srcChainID},
+			Msgs:        []xchain.Msg{{MsgID: xchain.MsgID{StreamID: xchain.StreamID{ShardID: shard}, StreamOffset: offset}}},
+		}
+	}
+
+	used := make(map[int]bool)
+	for src := uint64(1); src <= 32; src++ {
+		for _, shard := range []xchain.ShardID{xchain.ShardFinalized0, xchain.ShardLatest0} {
+			lane := laneIndex(newSub(src, shard, 1), lanes)
+			require.GreaterOrEqual(t, lane, 0)
+			require.Less(t, lane, lanes)
+
+			// Submissions of a stream always use the same lane.
+			require.Equal(t, lane, laneIndex(newSub(src, shard, 2), lanes))
+
+			used[lane] = true
+		}
+	}
+
+	// Streams are sharded across all lanes.
+	require.Len(t, used, lanes)
+	require.Equal(t, 0, laneIndex(newSub(1, xchain.ShardLatest0, 1), 1))
+}
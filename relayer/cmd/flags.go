@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"github.com/omni-network/omni/lib/netconf"
+	"github.com/omni-network/omni/lib/signer"
 	"github.com/omni-network/omni/lib/xchain"
 	relayer "github.com/omni-network/omni/relayer/app"
 
@@ -12,7 +13,14 @@ func bindRunFlags(flags *pflag.FlagSet, cfg *relayer.Config) {
 	netconf.BindFlag(flags, &cfg.Network)
 	xchain.BindFlags(flags, &cfg.RPCEndpoints)
 	flags.StringVar(&cfg.PrivateKey, "private-key", cfg.PrivateKey, "The path to the private key e.g path/private.key")
+	flags.StringSliceVar(&cfg.LaneSigners, "lane-signers", cfg.LaneSigners, "Additional signers of parallel send lanes per destination chain; private key paths for local signers, else account addresses")
+	signer.BindFlags(flags, &cfg.Signer)
 	flags.StringVar(&cfg.HaloURL, "halo-url", cfg.HaloURL, "The URL of the halo node e.g localhost:26657")
+	flags.StringVar(&cfg.ArchiveURL, "archive-url", cfg.ArchiveURL, "Optional gRPC address of an attestation archiver e.g localhost:9090, used to fetch attestations pruned from halo")
 	flags.StringVar(&cfg.MonitoringAddr, "monitoring-addr", cfg.MonitoringAddr, "The address to bind the monitoring server")
+	flags.StringVar(&cfg.AdminAddr, "admin-addr", cfg.AdminAddr, "Optional address to bind the admin API server e.g. localhost:26661; disabled if empty. Note the admin API isn't authenticated, so only bind it to trusted interfaces")
 	flags.StringVar(&cfg.DBDir, "db-dir", cfg.DBDir, "The path to the database directory")
+	flags.StringVar(&cfg.SubmitPolicy, "submit-policy", cfg.SubmitPolicy, "Default submit policy of all streams: always, profitable, or batch")
+	flags.StringToStringVar(&cfg.SubmitPolicies, "submit-policies", cfg.SubmitPolicies, "Submit policy overrides per stream name. e.g. \"base|L|optimism=profitable\"")
+	flags.DurationVar(&cfg.SubmitMaxDelay, "submit-max-delay", cfg.SubmitMaxDelay, "Maximum duration submissions are deferred by the submit policy")
 }
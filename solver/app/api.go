@@ -0,0 +1,256 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/omni-network/omni/contracts/bindings"
+	"github.com/omni-network/omni/lib/cast"
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/log"
+	"github.com/omni-network/omni/solver/types"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// apiDeps abstracts dependencies of the solver API allowing simplified testing.
+type apiDeps struct {
+	Check      func(ctx context.Context, srcChainID uint64, req bindings.SolveRequest) (types.RejectReason, error)
+	Quote      func(ctx context.Context, req types.QuoteRequest) (types.QuoteResponse, error)
+	GetRequest func(ctx context.Context, srcChainID uint64, reqID [32]byte) (*Request, bool, error)
+}
+
+// newAPIHandler returns the solver API http handler.
+func newAPIHandler(deps apiDeps) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /quote", newQuoteHandler(deps.Quote))
+	mux.HandleFunc("POST /check", newCheckHandler(deps.Check))
+	mux.HandleFunc("GET /status/{reqID}", newStatusHandler(deps.GetRequest))
+
+	return mux
+}
+
+// serveAPI starts a goroutine that serves the solver API. It
+// returns a channel that will receive an error if the server fails to start.
+func serveAPI(address string, handler http.Handler) <-chan error {
+	errChan := make(chan error)
+	go func() {
+		srv := &http.Server{
+			Addr:              address,
+			ReadHeaderTimeout: 5 * time.Second,
+			IdleTimeout:       5 * time.Second,
+			WriteTimeout:      15 * time.Second,
+			Handler:           handler,
+		}
+		errChan <- errors.Wrap(srv.ListenAndServe(), "serve api")
+	}()
+
+	return errChan
+}
+
+// newAPICheck returns a Check API dependency that runs the checkFunc without reserving inventory.
+func newAPICheck(check checkFunc) func(ctx context.Context, srcChainID uint64, req bindings.SolveRequest) (types.RejectReason, error) {
+	return func(ctx context.Context, srcChainID uint64, req bindings.SolveRequest) (types.RejectReason, error) {
+		return check(ctx, srcChainID, req, false)
+	}
+}
+
+// newQuoter returns a Quote API dependency that quotes the minimum deposit required for the solver to accept a call.
+// Note that deposits are not verified by the target; use the /check endpoint to verify a request before opening it.
+func newQuoter(
+	targets targets,
+	isAvailable func(chainID uint64) bool,
+	profits profitEstimator,
+	minMargin float64,
+) func(ctx context.Context, req types.QuoteRequest) (types.QuoteResponse, error) {
+	return func(ctx context.Context, req types.QuoteRequest) (types.QuoteResponse, error) {
+		reject := func(reason types.RejectReason, err error) (types.QuoteResponse, error) {
+			return types.QuoteResponse{RejectReason: reason.String(), RejectDescription: err.Error()}, nil
+		}
+
+		call := req.Call.ToBinding()
+		target, err := targets.Get(call)
+		if err != nil {
+			return reject(types.RejectNoTarget, err)
+		}
+
+		if !isAvailable(call.DestChainId) {
+			return types.QuoteResponse{}, errors.Wrap(errChainNotReady, "quote", "dest", call.DestChainId)
+		}
+
+		prereqs, err := target.TokenPrereqs(call)
+		if err != nil {
+			return reject(types.RejectInvalidCall, errors.Wrap(err, "token prereqs"))
+		}
+
+		expense, err := profits.Expense(ctx, req.SourceChainID, call, prereqs)
+		if errors.Is(err, errUnpricedToken) {
+			return reject(types.RejectUnsupportedToken, err)
+		} else if err != nil {
+			return types.QuoteResponse{}, errors.Wrap(err, "estimate expense")
+		}
+
+		depositUSD := expense * (1 + max(minMargin, 0))
+
+		amount, err := profits.Amount(ctx, req.SourceChainID, req.DepositToken, depositUSD)
+		if errors.Is(err, errUnpricedToken) {
+			return reject(types.RejectUnsupportedToken, err)
+		} else if err != nil {
+			return types.QuoteResponse{}, errors.Wrap(err, "price deposit token")
+		}
+
+		return types.QuoteResponse{
+			Deposit: types.Deposit{
+				IsNative: req.DepositToken == nativeToken,
+				Token:    req.DepositToken,
+				Amount:   (*hexutil.Big)(amount),
+			},
+			DepositUSD: depositUSD,
+			ExpenseUSD: expense,
+		}, nil
+	}
+}
+
+func newQuoteHandler(quote func(ctx context.Context, req types.QuoteRequest) (types.QuoteResponse, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		var req types.QuoteRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeAPIError(ctx, w, http.StatusBadRequest, errors.Wrap(err, "decode request"))
+			return
+		}
+
+		resp, err := quote(ctx, req)
+		if errors.Is(err, errChainNotReady) {
+			writeAPIError(ctx, w, http.StatusServiceUnavailable, err)
+			return
+		} else if err != nil {
+			writeAPIError(ctx, w, http.StatusInternalServerError, err)
+			return
+		}
+
+		writeAPIResponse(ctx, w, resp)
+	}
+}
+
+func newCheckHandler(check func(ctx context.Context, srcChainID uint64, req bindings.SolveRequest) (types.RejectReason, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		var req types.CheckRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeAPIError(ctx, w, http.StatusBadRequest, errors.Wrap(err, "decode request"))
+			return
+		}
+
+		deposits := make([]bindings.SolveDeposit, 0, len(req.Deposits))
+		for _, deposit := range req.Deposits {
+			deposits = append(deposits, deposit.ToBinding())
+		}
+
+		reason, err := check(ctx, req.SourceChainID, bindings.SolveRequest{
+			Status:   statusPending,
+			Call:     req.Call.ToBinding(),
+			Deposits: deposits,
+		})
+		if errors.Is(err, errChainNotReady) {
+			writeAPIError(ctx, w, http.StatusServiceUnavailable, err)
+			return
+		} else if reason == types.RejectNone && err != nil {
+			writeAPIError(ctx, w, http.StatusInternalServerError, err)
+			return
+		} else if reason == types.RejectNone {
+			writeAPIResponse(ctx, w, types.CheckResponse{Accepted: true})
+			return
+		}
+
+		writeAPIResponse(ctx, w, types.CheckResponse{
+			RejectReason:      reason.String(),
+			RejectDescription: err.Error(),
+		})
+	}
+}
+
+func newStatusHandler(getRequest func(ctx context.Context, srcChainID uint64, reqID [32]byte) (*Request, bool, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		reqIDBytes, err := hexutil.Decode(r.PathValue("reqID"))
+		if err != nil {
+			writeAPIError(ctx, w, http.StatusBadRequest, errors.Wrap(err, "invalid request id"))
+			return
+		}
+
+		reqID, err := cast.Array32(reqIDBytes)
+		if err != nil {
+			writeAPIError(ctx, w, http.StatusBadRequest, errors.Wrap(err, "invalid request id"))
+			return
+		}
+
+		srcChainID, err := strconv.ParseUint(r.URL.Query().Get("src_chain_id"), 10, 64)
+		if err != nil {
+			writeAPIError(ctx, w, http.StatusBadRequest, errors.Wrap(err, "invalid src_chain_id query parameter"))
+			return
+		}
+
+		request, ok, err := getRequest(ctx, srcChainID, reqID)
+		if err != nil {
+			writeAPIError(ctx, w, http.StatusInternalServerError, err)
+			return
+		} else if !ok {
+			writeAPIError(ctx, w, http.StatusNotFound, errors.New("request not found"))
+			return
+		}
+
+		writeAPIResponse(ctx, w, statusResponse(request))
+	}
+}
+
+// statusResponse returns the API status response of the request.
+func statusResponse(request *Request) types.StatusResponse {
+	resp := types.StatusResponse{
+		SourceChainID: request.GetSrcChainId(),
+		RequestID:     common.BytesToHash(request.GetReqId()),
+		Status:        statusString(uint8(request.GetStatus())),
+		Action:        action(request.GetAction()).String(),
+		Error:         request.GetError(),
+		Height:        request.GetHeight(),
+		CreatedAt:     time.Unix(int64(request.GetCreatedAt()), 0).UTC(),
+		UpdatedAt:     time.Unix(int64(request.GetUpdatedAt()), 0).UTC(),
+	}
+
+	if action(request.GetAction()) == actionReject {
+		resp.RejectReason = types.RejectReason(request.GetRejectReason()).String()
+	}
+
+	if len(request.GetTxHash()) > 0 {
+		txHash := common.BytesToHash(request.GetTxHash())
+		resp.TxHash = &txHash
+	}
+
+	return resp
+}
+
+func writeAPIResponse(ctx context.Context, w http.ResponseWriter, resp any) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		log.Warn(ctx, "Failed writing api response", err)
+	}
+}
+
+func writeAPIError(ctx context.Context, w http.ResponseWriter, status int, err error) {
+	if status >= http.StatusInternalServerError {
+		log.Warn(ctx, "Failed serving api request", err)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(types.ErrorResponse{Error: err.Error()}); err != nil {
+		log.Warn(ctx, "Failed writing api error", err)
+	}
+}
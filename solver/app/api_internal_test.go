@@ -0,0 +1,162 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/omni-network/omni/contracts/bindings"
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/tokens"
+	"github.com/omni-network/omni/lib/tutil"
+	"github.com/omni-network/omni/solver/types"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPI(t *testing.T) {
+	t.Parallel()
+
+	const srcChainID = 1
+	reqID := tutil.RandomHash()
+	txHash := tutil.RandomHash()
+	rejectTarget := tutil.RandomAddress()
+
+	deps := apiDeps{
+		Check: func(_ context.Context, srcChain uint64, req bindings.SolveRequest) (types.RejectReason, error) {
+			require.EqualValues(t, srcChainID, srcChain)
+			require.Equal(t, statusPending, req.Status)
+
+			if req.Call.Target == rejectTarget {
+				return types.RejectNoTarget, errors.New("no target found")
+			}
+
+			return types.RejectNone, nil
+		},
+		Quote: func(_ context.Context, req types.QuoteRequest) (types.QuoteResponse, error) {
+			return types.QuoteResponse{
+				Deposit:    types.Deposit{IsNative: true, Amount: req.Call.Value},
+				ExpenseUSD: 1,
+				DepositUSD: 2,
+			}, nil
+		},
+		GetRequest: func(_ context.Context, srcChain uint64, id [32]byte) (*Request, bool, error) {
+			if srcChain != srcChainID || id != reqID {
+				return nil, false, nil
+			}
+
+			return &Request{
+				SrcChainId:   srcChainID,
+				ReqId:        reqID[:],
+				Status:       uint32(statusRejected),
+				Action:       uint32(actionReject),
+				RejectReason: uint32(types.RejectInsufficientFee),
+				TxHash:       txHash[:],
+				Height:       99,
+			}, true, nil
+		},
+	}
+
+	srv := httptest.NewServer(newAPIHandler(deps))
+	t.Cleanup(srv.Close)
+
+	post := func(t *testing.T, path string, body any, resp any) int {
+		t.Helper()
+		bz, err := json.Marshal(body)
+		require.NoError(t, err)
+
+		r, err := http.Post(srv.URL+path, "application/json", bytes.NewReader(bz)) //nolint:noctx // Test code
+		require.NoError(t, err)
+		defer r.Body.Close()
+		require.NoError(t, json.NewDecoder(r.Body).Decode(resp))
+
+		return r.StatusCode
+	}
+
+	get := func(t *testing.T, path string, resp any) int {
+		t.Helper()
+		r, err := http.Get(srv.URL + path) //nolint:noctx // Test code
+		require.NoError(t, err)
+		defer r.Body.Close()
+		require.NoError(t, json.NewDecoder(r.Body).Decode(resp))
+
+		return r.StatusCode
+	}
+
+	t.Run("check accepted", func(t *testing.T) {
+		t.Parallel()
+		var resp types.CheckResponse
+		code := post(t, "/check", types.CheckRequest{SourceChainID: srcChainID}, &resp)
+		require.Equal(t, http.StatusOK, code)
+		require.True(t, resp.Accepted)
+		require.Empty(t, resp.RejectReason)
+	})
+
+	t.Run("check rejected", func(t *testing.T) {
+		t.Parallel()
+		var resp types.CheckResponse
+		code := post(t, "/check", types.CheckRequest{SourceChainID: srcChainID, Call: types.Call{Target: rejectTarget}}, &resp)
+		require.Equal(t, http.StatusOK, code)
+		require.False(t, resp.Accepted)
+		require.Equal(t, types.RejectNoTarget.String(), resp.RejectReason)
+		require.Equal(t, "no target found", resp.RejectDescription)
+	})
+
+	t.Run("quote", func(t *testing.T) {
+		t.Parallel()
+		var resp types.QuoteResponse
+		code := post(t, "/quote", types.QuoteRequest{SourceChainID: srcChainID, Call: types.Call{Value: (*hexutil.Big)(common.Big2)}}, &resp)
+		require.Equal(t, http.StatusOK, code)
+		require.EqualValues(t, 2, resp.Deposit.Amount.ToInt().Int64())
+		require.InDelta(t, 2, resp.DepositUSD, 0)
+	})
+
+	t.Run("status", func(t *testing.T) {
+		t.Parallel()
+		var resp types.StatusResponse
+		code := get(t, "/status/"+reqID.Hex()+"?src_chain_id="+strconv.Itoa(srcChainID), &resp)
+		require.Equal(t, http.StatusOK, code)
+		require.Equal(t, reqID, resp.RequestID)
+		require.Equal(t, "rejected", resp.Status)
+		require.Equal(t, "reject", resp.Action)
+		require.Equal(t, types.RejectInsufficientFee.String(), resp.RejectReason)
+		require.Equal(t, txHash, *resp.TxHash)
+		require.EqualValues(t, 99, resp.Height)
+	})
+
+	t.Run("status not found", func(t *testing.T) {
+		t.Parallel()
+		var resp types.ErrorResponse
+		code := get(t, "/status/"+tutil.RandomHash().Hex()+"?src_chain_id=1", &resp)
+		require.Equal(t, http.StatusNotFound, code)
+		require.NotEmpty(t, resp.Error)
+	})
+
+	t.Run("status invalid", func(t *testing.T) {
+		t.Parallel()
+		var resp types.ErrorResponse
+		code := get(t, "/status/0x1234?src_chain_id=1", &resp)
+		require.Equal(t, http.StatusBadRequest, code)
+
+		code = get(t, "/status/"+reqID.Hex(), &resp)
+		require.Equal(t, http.StatusBadRequest, code)
+	})
+}
+
+func TestFromUnitsF64(t *testing.T) {
+	t.Parallel()
+
+	require.EqualValues(t, 1_500_000_000_000_000_000, fromUnitsF64(1.5, 18).Uint64())
+	require.EqualValues(t, 1_500_000, fromUnitsF64(1.5, tokens.USDC.Decimals()).Uint64())
+	require.Zero(t, fromUnitsF64(0, 18).Sign())
+	require.InDelta(t, 0.1, toUnitsF64(fromUnitsF64(0.1, 18), 18), 1e-12)
+	require.InDelta(t, 2.5, toUnitsF64(big.NewInt(2_500_000), 6), 1e-12)
+}
@@ -16,12 +16,13 @@ import (
 	"github.com/omni-network/omni/lib/expbackoff"
 	"github.com/omni-network/omni/lib/log"
 	"github.com/omni-network/omni/lib/netconf"
+	"github.com/omni-network/omni/lib/signer"
+	"github.com/omni-network/omni/lib/tokens"
 	"github.com/omni-network/omni/lib/xchain"
 	xprovider "github.com/omni-network/omni/lib/xchain/provider"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
-	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
@@ -40,7 +41,8 @@ func Run(ctx context.Context, cfg Config) error {
 	buildinfo.Instrument(ctx)
 
 	// Start monitoring first, so app is "up"
-	monitorChan := serveMonitoring(cfg.MonitoringAddr)
+	ready := newReadiness()
+	monitorChan := serveMonitoring(cfg.MonitoringAddr, ready)
 
 	portalReg, err := makePortalRegistry(cfg.Network, cfg.RPCEndpoints)
 	if err != nil {
@@ -52,17 +54,14 @@ func Run(ctx context.Context, cfg Config) error {
 		return err
 	}
 
-	if cfg.PrivateKey == "" {
-		return errors.New("private key not set")
-	}
-	privKey, err := ethcrypto.LoadECDSA(cfg.PrivateKey)
+	sgnr, err := signer.New(ctx, network.ID, cfg.Signer, cfg.PrivateKey)
 	if err != nil {
-		return errors.Wrap(err, "load private key")
+		return errors.Wrap(err, "new signer")
 	}
-	solverAddr := ethcrypto.PubkeyToAddress(privKey.PublicKey)
-	log.Debug(ctx, "Using solver address", "address", solverAddr.Hex())
+	solverAddr := sgnr.Address()
+	log.Debug(ctx, "Using solver address", "address", solverAddr.Hex(), "signer", cfg.Signer.Type)
 
-	backends, err := ethbackend.BackendsFromNetwork(network, cfg.RPCEndpoints, privKey)
+	backends, err := newBackends(network, cfg.RPCEndpoints, sgnr)
 	if err != nil {
 		return err
 	}
@@ -74,32 +73,48 @@ func Run(ctx context.Context, cfg Config) error {
 		return err
 	}
 
-	cursors, err := newCursors(db)
+	store, err := newSolverStore(db)
+	if err != nil {
+		return errors.Wrap(err, "create solver store")
+	}
+
+	cursors := newCursors(store)
+	requests := newRequests(store)
+
+	reg, err := loadRegistry(network.ID, cfg.TargetsFile)
 	if err != nil {
-		return errors.Wrap(err, "create cursor store")
+		return errors.Wrap(err, "load registry")
 	}
 
-	err = startEventStreams(ctx, network, xprov, backends, solverAddr, cursors)
+	pricer := newTokenPricer(ctx)
+
+	api, err := startEventStreams(ctx, cfg, network, xprov, backends, solverAddr, store, cursors, requests, reg, pricer, ready)
 	if err != nil {
 		return errors.Wrap(err, "start event streams")
 	}
 
+	log.Info(ctx, "Serving solver API", "address", cfg.APIAddr)
+	apiChan := serveAPI(cfg.APIAddr, newAPIHandler(api))
+
 	select {
 	case <-ctx.Done():
 		log.Info(ctx, "Shutdown detected, stopping...")
 		return nil
 	case err := <-monitorChan:
 		return err
+	case err := <-apiChan:
+		return err
 	}
 }
 
-// serveMonitoring starts a goroutine that serves the monitoring API. It
+// serveMonitoring starts a goroutine that serves the monitoring API, including metrics and chain readiness. It
 // returns a channel that will receive an error if the server fails to start.
-func serveMonitoring(address string) <-chan error {
+func serveMonitoring(address string, ready *readiness) <-chan error {
 	errChan := make(chan error)
 	go func() {
 		mux := http.NewServeMux()
 		mux.Handle("/metrics", promhttp.Handler())
+		mux.Handle("/ready", ready) // Returns 503 if any chain is not ready
 
 		// Copied from net/http/pprof/pprof.go
 		mux.HandleFunc("/debug/pprof/", pprof.Index)
@@ -121,6 +136,15 @@ func serveMonitoring(address string) <-chan error {
 	return errChan
 }
 
+// newBackends returns backends for all EVM chains in the network, signing transactions with the signer.
+func newBackends(network netconf.Network, endpoints xchain.RPCEndpoints, sgnr signer.Signer) (ethbackend.Backends, error) {
+	if privKey, ok := sgnr.PrivateKey(); ok {
+		return ethbackend.BackendsFromNetwork(network, endpoints, privKey)
+	}
+
+	return ethbackend.BackendsFromNetworkWithSigner(network, endpoints, sgnr.Address(), sgnr.Sign)
+}
+
 func makePortalRegistry(network netconf.ID, endpoints xchain.RPCEndpoints) (*bindings.PortalRegistry, error) {
 	meta := netconf.MetadataByID(network, network.Static().OmniExecutionChainID)
 	rpc, err := endpoints.ByNameOrID(meta.Name, meta.ChainID)
@@ -141,105 +165,155 @@ func makePortalRegistry(network netconf.ID, endpoints xchain.RPCEndpoints) (*bin
 	return resp, nil
 }
 
-// startEventStreams starts the event streams for the solver.
-// TODO(corver): Make this robust against chains not be available on startup.
+// startEventStreams starts the event streams for the solver and returns the solver API dependencies.
+// Chains are initialized asynchronously, unavailable chains are retried in the background
+// and their readiness is tracked, so a single unavailable chain doesn't block the others.
 func startEventStreams(
 	ctx context.Context,
+	cfg Config,
 	network netconf.Network,
 	xprov xchain.Provider,
 	backends ethbackend.Backends,
 	solverAddr common.Address,
+	store SolverStore,
 	cursors *cursors,
-) error {
+	requests *requests,
+	reg registry,
+	pricer tokens.Pricer,
+	ready *readiness,
+) (apiDeps, error) {
 	addrs, err := contracts.GetAddresses(ctx, network.ID)
 	if err != nil {
-		return errors.Wrap(err, "get contract addresses")
+		return apiDeps{}, errors.Wrap(err, "get contract addresses")
 	}
 
-	inboxChains, err := detectContractChains(ctx, network, backends, addrs.SolveInbox)
+	inboxContracts := newChainContracts[*bindings.SolveInbox]()
+	outboxContracts := newChainContracts[*bindings.SolveOutbox]()
+
+	cursorSetter := func(ctx context.Context, chainID uint64, height uint64) error {
+		return cursors.Set(ctx, chainVerFromID(chainID), height)
+	}
+
+	isAvailable := func(chainID uint64) bool {
+		_, ok := outboxContracts.Get(chainID)
+		return ok
+	}
+
+	inventory := newInventory(network, newBalancer(backends, solverAddr))
+	profits := newProfitEstimator(pricer, reg.Tokens, backends, outboxContracts)
+	check := newChecker(reg.Targets, isAvailable, inventory, profits, cfg.MinProfitMargin)
+
+	beneficiaries, err := parseClaimBeneficiaries(network, cfg.ClaimBeneficiaries)
 	if err != nil {
-		return errors.Wrap(err, "detect inbox chains")
+		return apiDeps{}, errors.Wrap(err, "parse claim beneficiaries")
+	}
+
+	getRequest := newRequestGetter(inboxContracts)
+	claim := newClaimer(inboxContracts, backends, solverAddr, beneficiaries, profits)
+	if cfg.ClaimBatchSize > 1 {
+		claimer := newClaimQueue(
+			store,
+			claim,
+			getRequest,
+			newClaimRecorder(requests),
+			cfg.ClaimBatchSize,
+			cfg.ClaimBatchPeriod,
+		)
+		go claimer.Run(ctx)
+
+		claim = claimer.Enqueue
 	}
 
-	inboxContracts := make(map[uint64]*bindings.SolveInbox)
-	for _, chain := range inboxChains {
-		name := network.ChainName(chain)
-		chainVer := chainVerFromID(chain)
-		log.Debug(ctx, "Using inbox contract", "chain", name, "address", addrs.SolveInbox.Hex())
+	reorgs := newReorgDetector(backends)
+	deferrer := newFinalityDeferrer(store, reg.Targets, backends)
 
-		backend, err := backends.Backend(chain)
+	deps := procDeps{
+		ParseID:      newIDParser(inboxContracts),
+		GetRequest:   getRequest,
+		ShouldReject: newShouldRejector(check),
+		Accept:       newAcceptor(inboxContracts, backends, solverAddr, inventory),
+		Reject:       newRejector(network, inboxContracts, backends, solverAddr, inventory),
+		Fulfill:      newFulfiller(reg.Targets, inventory, profits, outboxContracts, backends, solverAddr, addrs.SolveOutbox),
+		Claim:        claim,
+		SetCursor:    cursorSetter,
+		Record:       requests.Record,
+		InFlight:     requests.InFlight,
+		CheckReorg:   reorgs.Check,
+		DeferFulfill: deferrer.Defer,
+		Reserve:      newReserver(reg.Targets, inventory),
+	}
+
+	go deferrer.Run(ctx, newFinalizedProcessor(deps))
+
+	initChain := func(ctx context.Context, chain netconf.Chain) error {
+		backend, err := backends.Backend(chain.ID)
 		if err != nil {
 			return err
 		}
 
-		inbox, err := bindings.NewSolveInbox(addrs.SolveInbox, backend)
-		if err != nil {
-			return errors.Wrap(err, "create inbox contract", "chain", name)
+		if ok, err := isDeployed(ctx, backend, addrs.SolveOutbox); err != nil {
+			return errors.Wrap(err, "detect outbox")
+		} else if ok {
+			log.Debug(ctx, "Using outbox contract", "address", addrs.SolveOutbox.Hex())
+
+			outbox, err := bindings.NewSolveOutbox(addrs.SolveOutbox, backend)
+			if err != nil {
+				return errors.Wrap(err, "create outbox contract")
+			}
+			outboxContracts.Set(chain.ID, outbox)
 		}
-		inboxContracts[chain] = inbox
 
-		// Check if cursor store should be initialized with deploy height
-		if _, ok, err := cursors.Get(ctx, chainVer); err != nil {
-			return errors.Wrap(err, "get cursor", "chain", name)
-		} else if ok { // Cursor already set, skip
-			continue
+		if ok, err := isDeployed(ctx, backend, addrs.SolveInbox); err != nil {
+			return errors.Wrap(err, "detect inbox")
+		} else if !ok {
+			return nil
 		}
 
-		height, err := inbox.DeployedAt(&bind.CallOpts{Context: ctx})
+		log.Debug(ctx, "Using inbox contract", "address", addrs.SolveInbox.Hex())
+
+		inbox, err := bindings.NewSolveInbox(addrs.SolveInbox, backend)
 		if err != nil {
-			return errors.New("get inbox deploy height", "chain", name)
+			return errors.Wrap(err, "create inbox contract")
 		}
 
-		log.Info(ctx, "Initializing inbox cursor", "chain", name, "deployed_at", height)
-
-		if err := cursors.Set(ctx, chainVer, height.Uint64()); err != nil {
+		if err := initInboxCursor(ctx, cursors, chain.ID, inbox); err != nil {
 			return err
 		}
-	}
 
-	outboxChains, err := detectContractChains(ctx, network, backends, addrs.SolveOutbox)
-	if err != nil {
-		return errors.Wrap(err, "detect outbox chains")
-	}
+		inboxContracts.Set(chain.ID, inbox)
 
-	outboxContracts := make(map[uint64]*bindings.SolveOutbox)
-	for _, chain := range outboxChains {
-		name := network.ChainName(chain)
-		log.Debug(ctx, "Using outbox contract", "chain", name, "address", addrs.SolveInbox.Hex())
+		log.Info(ctx, "Starting inbox event stream")
+		go streamEventsForever(ctx, chain.ID, xprov, deps, cursors, addrs.SolveInbox)
 
-		backend, err := backends.Backend(chain)
-		if err != nil {
-			return err
-		}
-
-		outbox, err := bindings.NewSolveOutbox(addrs.SolveOutbox, backend)
-		if err != nil {
-			return errors.Wrap(err, "create outbox contract", "chain", name)
-		}
-		outboxContracts[chain] = outbox
+		return nil
 	}
 
-	cursorSetter := func(ctx context.Context, chainID uint64, height uint64) error {
-		return cursors.Set(ctx, chainVerFromID(chainID), height)
-	}
+	initChainsAsync(ctx, network.EVMChains(), ready, initChain, expbackoff.WithPeriodicConfig(time.Second*5))
 
-	deps := procDeps{
-		ParseID:      newIDParser(inboxContracts),
-		GetRequest:   newRequestGetter(inboxContracts),
-		ShouldReject: newShouldRejector(network.ID),
-		Accept:       newAcceptor(inboxContracts, backends, solverAddr),
-		Reject:       newRejector(inboxContracts, backends, solverAddr),
-		Fulfill:      newFulfiller(network.ID, outboxContracts, backends, solverAddr, addrs.SolveOutbox),
-		Claim:        newClaimer(inboxContracts, backends, solverAddr),
-		SetCursor:    cursorSetter,
+	return apiDeps{
+		Check:      newAPICheck(check),
+		Quote:      newQuoter(reg.Targets, isAvailable, profits, cfg.MinProfitMargin),
+		GetRequest: requests.Get,
+	}, nil
+}
+
+// initInboxCursor initializes the inbox cursor of the chain with the inbox deploy height, if not already set.
+func initInboxCursor(ctx context.Context, cursors *cursors, chainID uint64, inbox *bindings.SolveInbox) error {
+	chainVer := chainVerFromID(chainID)
+	if _, ok, err := cursors.Get(ctx, chainVer); err != nil {
+		return errors.Wrap(err, "get cursor")
+	} else if ok { // Cursor already set, skip
+		return nil
 	}
 
-	for _, chain := range inboxChains {
-		log.Info(ctx, "Starting inbox event stream", "chain", network.ChainName(chain))
-		go streamEventsForever(ctx, chain, xprov, deps, cursors, addrs.SolveInbox)
+	height, err := inbox.DeployedAt(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return errors.Wrap(err, "get inbox deploy height")
 	}
 
-	return nil
+	log.Info(ctx, "Initializing inbox cursor", "deployed_at", height)
+
+	return cursors.Set(ctx, chainVer, height.Uint64())
 }
 
 // streamEventsForever streams events from the inbox contract on the given chain.
@@ -251,6 +325,10 @@ func streamEventsForever(
 	cursors *cursors,
 	inboxAddr common.Address,
 ) {
+	if err := resumeInFlight(ctx, deps, chainID); err != nil {
+		log.Warn(ctx, "Failed resuming in-flight requests", err)
+	}
+
 	backoff := expbackoff.New(ctx, expbackoff.WithPeriodicConfig(time.Second*5))
 	for {
 		from, ok, err := cursors.Get(ctx, xchain.ChainVersion{ID: chainID, ConfLevel: confLevel})
@@ -271,6 +349,9 @@ func streamEventsForever(
 		err = xprov.StreamEventLogs(ctx, req, newEventProcessor(deps, chainID))
 		if ctx.Err() != nil {
 			return
+		} else if errors.Is(err, errReorg) {
+			log.Debug(ctx, "Restarting inbox event stream after reorg")
+			continue // Cursor already rewound, restart stream immediately
 		}
 
 		log.Warn(ctx, "Failure streaming inbox events (will retry)", err)
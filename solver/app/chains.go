@@ -0,0 +1,156 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+
+	"github.com/omni-network/omni/lib/expbackoff"
+	"github.com/omni-network/omni/lib/log"
+	"github.com/omni-network/omni/lib/netconf"
+)
+
+// chainContracts is a thread-safe map of contract bindings by chain ID.
+// It is populated as chains are initialized.
+type chainContracts[T any] struct {
+	mu        sync.RWMutex
+	contracts map[uint64]T
+}
+
+func newChainContracts[T any]() *chainContracts[T] {
+	return &chainContracts[T]{
+		contracts: make(map[uint64]T),
+	}
+}
+
+// Get returns the contract of the chain, or false if the chain isn't initialized.
+func (c *chainContracts[T]) Get(chainID uint64) (T, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	contract, ok := c.contracts[chainID]
+
+	return contract, ok
+}
+
+// Set sets the contract of the chain.
+func (c *chainContracts[T]) Set(chainID uint64, contract T) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.contracts[chainID] = contract
+}
+
+// readiness tracks the initialization status of each chain.
+type readiness struct {
+	mu     sync.RWMutex
+	chains map[string]bool
+}
+
+func newReadiness() *readiness {
+	return &readiness{
+		chains: make(map[string]bool),
+	}
+}
+
+// Set sets the readiness of the chain.
+func (r *readiness) Set(chain string, ready bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.chains[chain] = ready
+
+	var val float64
+	if ready {
+		val = 1
+	}
+	chainReady.WithLabelValues(chain).Set(val)
+}
+
+// Ready returns true if all tracked chains are ready. It returns false if no chains are tracked yet.
+func (r *readiness) Ready() bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if len(r.chains) == 0 {
+		return false
+	}
+
+	for _, ready := range r.chains {
+		if !ready {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ServeHTTP serves the readiness status json, returning 503 if not ready.
+func (r *readiness) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	r.mu.RLock()
+	chains := make(map[string]bool, len(r.chains))
+	for chain, ready := range r.chains {
+		chains[chain] = ready
+	}
+	r.mu.RUnlock()
+
+	ready := r.Ready()
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(struct {
+		Ready  bool            `json:"ready"`
+		Chains map[string]bool `json:"chains"`
+	}{Ready: ready, Chains: chains}); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	statusCode := http.StatusOK
+	if !ready {
+		statusCode = http.StatusServiceUnavailable
+	}
+
+	// Do writes in correct order
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_, _ = w.Write(body.Bytes())
+}
+
+// initChainsAsync initializes each chain in a separate goroutine, retrying until it succeeds or the context is canceled.
+// Chains are marked not ready until initialized, so unavailable chains do not block others.
+func initChainsAsync(
+	ctx context.Context,
+	chains []netconf.Chain,
+	ready *readiness,
+	initChain func(ctx context.Context, chain netconf.Chain) error,
+	backoffOpts ...func(*expbackoff.Config),
+) {
+	for _, chain := range chains {
+		ready.Set(chain.Name, false)
+	}
+
+	for _, chain := range chains {
+		go func() {
+			ctx := log.WithCtx(ctx, "chain", chain.Name)
+			backoff := expbackoff.New(ctx, backoffOpts...)
+			for {
+				err := initChain(ctx, chain)
+				if ctx.Err() != nil {
+					return
+				} else if err != nil {
+					log.Warn(ctx, "Failed initializing chain (will retry)", err)
+					backoff()
+
+					continue
+				}
+
+				ready.Set(chain.Name, true)
+				log.Info(ctx, "Chain initialized")
+
+				return
+			}
+		}()
+	}
+}
@@ -0,0 +1,89 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/expbackoff"
+	"github.com/omni-network/omni/lib/netconf"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInitChainsAsync(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	chains := []netconf.Chain{
+		{ID: 1, Name: "healthy"},
+		{ID: 2, Name: "flaky"},
+	}
+
+	ready := newReadiness()
+	require.False(t, ready.Ready())
+
+	contracts := newChainContracts[string]()
+
+	var flaky atomic.Bool
+	flaky.Store(true)
+
+	initChain := func(_ context.Context, chain netconf.Chain) error {
+		if chain.Name == "flaky" && flaky.Load() {
+			return errors.New("rpc down")
+		}
+
+		contracts.Set(chain.ID, chain.Name)
+
+		return nil
+	}
+
+	initChainsAsync(ctx, chains, ready, initChain, expbackoff.WithPeriodicConfig(time.Millisecond))
+
+	// Healthy chain is initialized, while flaky chain is retried.
+	require.Eventually(t, func() bool {
+		ready.mu.RLock()
+		defer ready.mu.RUnlock()
+
+		return ready.chains["healthy"]
+	}, time.Second, time.Millisecond)
+
+	_, ok := contracts.Get(1)
+	require.True(t, ok)
+	_, ok = contracts.Get(2)
+	require.False(t, ok)
+	require.False(t, ready.Ready())
+	requireReadyResponse(t, ready, http.StatusServiceUnavailable, map[string]bool{"healthy": true, "flaky": false})
+
+	// Flaky chain is initialized once it recovers.
+	flaky.Store(false)
+	require.Eventually(t, ready.Ready, time.Second, time.Millisecond)
+
+	name, ok := contracts.Get(2)
+	require.True(t, ok)
+	require.Equal(t, "flaky", name)
+	requireReadyResponse(t, ready, http.StatusOK, map[string]bool{"healthy": true, "flaky": true})
+}
+
+func requireReadyResponse(t *testing.T, ready *readiness, code int, chains map[string]bool) {
+	t.Helper()
+
+	rec := httptest.NewRecorder()
+	ready.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/ready", nil))
+	require.Equal(t, code, rec.Code)
+
+	var resp struct {
+		Ready  bool            `json:"ready"`
+		Chains map[string]bool `json:"chains"`
+	}
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	require.Equal(t, code == http.StatusOK, resp.Ready)
+	require.Equal(t, chains, resp.Chains)
+}
@@ -0,0 +1,267 @@
+package app
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/omni-network/omni/contracts/bindings"
+	"github.com/omni-network/omni/lib/cast"
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/log"
+	"github.com/omni-network/omni/lib/netconf"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// claimBeneficiaries maps source chain IDs to the addresses claimed deposits are sent to.
+type claimBeneficiaries map[uint64]common.Address
+
+// Get returns the claim beneficiary of the chain, or the default address if not configured.
+func (b claimBeneficiaries) Get(chainID uint64, defaultAddr common.Address) common.Address {
+	if addr, ok := b[chainID]; ok {
+		return addr
+	}
+
+	return defaultAddr
+}
+
+// parseClaimBeneficiaries returns the claim beneficiaries from the chain name to address config.
+func parseClaimBeneficiaries(network netconf.Network, cfg map[string]string) (claimBeneficiaries, error) {
+	resp := make(claimBeneficiaries)
+	for name, addr := range cfg {
+		chain, ok := network.ChainByName(name)
+		if !ok {
+			return nil, errors.New("unknown claim beneficiary chain", "chain", name)
+		}
+
+		if !common.IsHexAddress(addr) {
+			return nil, errors.New("invalid claim beneficiary address", "chain", name, "address", addr)
+		}
+
+		beneficiary := common.HexToAddress(addr)
+		if beneficiary == (common.Address{}) {
+			return nil, errors.New("zero claim beneficiary address", "chain", name)
+		}
+
+		resp[chain.ID] = beneficiary
+	}
+
+	return resp, nil
+}
+
+// claimQueue persists claims of fulfilled requests, submitting at most roundSize queued claims
+// when either roundSize claims are queued or roundPeriod elapsed. SolveInbox only supports claiming
+// single requests, so each request is claimed by its own transaction; rounds only aggregate and
+// rate-limit claim submissions. Failed claims remain queued and are retried in the next round.
+type claimQueue struct {
+	claim       func(ctx context.Context, chainID uint64, req bindings.SolveRequest) (common.Hash, error)
+	getRequest  func(ctx context.Context, chainID uint64, id [32]byte) (bindings.SolveRequest, bool, error)
+	onClaimed   func(ctx context.Context, chainID uint64, req bindings.SolveRequest, res actionResult)
+	roundSize   int
+	roundPeriod time.Duration
+
+	mu    sync.Mutex
+	table PendingClaimTable
+	full  chan struct{}
+}
+
+func newClaimQueue(
+	store SolverStore,
+	claim func(ctx context.Context, chainID uint64, req bindings.SolveRequest) (common.Hash, error),
+	getRequest func(ctx context.Context, chainID uint64, id [32]byte) (bindings.SolveRequest, bool, error),
+	onClaimed func(ctx context.Context, chainID uint64, req bindings.SolveRequest, res actionResult),
+	roundSize int,
+	roundPeriod time.Duration,
+) *claimQueue {
+	return &claimQueue{
+		claim:       claim,
+		getRequest:  getRequest,
+		onClaimed:   onClaimed,
+		roundSize:   roundSize,
+		roundPeriod: roundPeriod,
+		table:       store.PendingClaimTable(),
+		full:        make(chan struct{}, 1),
+	}
+}
+
+// Enqueue queues the request for claiming in a subsequent round. It implements procDeps.Claim,
+// returning a zero hash since the claim transaction is submitted asynchronously.
+// The claim action (with its transaction hash) is recorded via onClaimed once submitted.
+func (q *claimQueue) Enqueue(ctx context.Context, chainID uint64, req bindings.SolveRequest) (common.Hash, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	err := q.table.Save(ctx, &PendingClaim{SrcChainId: chainID, ReqId: req.Id[:]})
+	if err != nil {
+		return common.Hash{}, errors.Wrap(err, "save pending claim")
+	}
+
+	if pending, err := q.listUnsafe(ctx, q.roundSize); err != nil {
+		return common.Hash{}, err
+	} else if len(pending) >= q.roundSize {
+		q.signalFull()
+	}
+
+	return common.Hash{}, nil
+}
+
+// signalFull signals that a full round is queued.
+func (q *claimQueue) signalFull() {
+	select {
+	case q.full <- struct{}{}:
+	default: // Already signalled
+	}
+}
+
+// Queued returns the number of queued claims.
+func (q *claimQueue) Queued(ctx context.Context) (int, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	pending, err := q.listUnsafe(ctx, 0)
+	if err != nil {
+		return 0, err
+	}
+
+	return len(pending), nil
+}
+
+// listUnsafe returns at most limit queued claims, or all if limit is zero.
+// It is unsafe since it assumes the lock is held.
+func (q *claimQueue) listUnsafe(ctx context.Context, limit int) ([]*PendingClaim, error) {
+	iter, err := q.table.List(ctx, PendingClaimPrimaryKey{})
+	if err != nil {
+		return nil, errors.Wrap(err, "list pending claims")
+	}
+	defer iter.Close()
+
+	var resp []*PendingClaim
+	for iter.Next() {
+		if limit > 0 && len(resp) >= limit {
+			break
+		}
+
+		pending, err := iter.Value()
+		if err != nil {
+			return nil, errors.Wrap(err, "pending claim value")
+		}
+		resp = append(resp, pending)
+	}
+
+	return resp, nil
+}
+
+// remove deletes the queued claim.
+func (q *claimQueue) remove(ctx context.Context, pending *PendingClaim) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if err := q.table.Delete(ctx, pending); err != nil {
+		return errors.Wrap(err, "delete pending claim")
+	}
+
+	return nil
+}
+
+// Run submits queued claims in rounds until the context is canceled.
+// Claims queued before a restart are submitted in the first round.
+func (q *claimQueue) Run(ctx context.Context) {
+	ticker := time.NewTicker(q.roundPeriod)
+	defer ticker.Stop()
+
+	q.claimRound(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-q.full:
+		}
+
+		q.claimRound(ctx)
+	}
+}
+
+// claimRound concurrently claims at most roundSize queued requests, each with its own transaction.
+func (q *claimQueue) claimRound(ctx context.Context) {
+	q.mu.Lock()
+	round, err := q.listUnsafe(ctx, q.roundSize+1) // One extra to detect more queued claims.
+	q.mu.Unlock()
+	if err != nil {
+		log.Warn(ctx, "Failed listing pending claims (will retry)", err)
+		return
+	}
+
+	more := len(round) > q.roundSize
+	if more {
+		round = round[:q.roundSize]
+	}
+
+	if len(round) == 0 {
+		return
+	}
+
+	log.Debug(ctx, "Claiming queued requests", "count", len(round))
+
+	var wg sync.WaitGroup
+	for _, pending := range round {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			if !q.claimOne(ctx, pending) {
+				return
+			}
+
+			if err := q.remove(ctx, pending); err != nil {
+				log.Warn(ctx, "Failed removing pending claim", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if more {
+		q.signalFull() // Claim the next round immediately.
+	}
+}
+
+// claimOne claims the request, returning true if it should be removed from the queue.
+func (q *claimQueue) claimOne(ctx context.Context, pending *PendingClaim) bool {
+	reqID, err := cast.Array32(pending.GetReqId())
+	if err != nil {
+		log.Error(ctx, "Invalid pending claim request ID [BUG]", err)
+		return true
+	}
+
+	ctx = log.WithCtx(ctx, "req_id", fmtReqID(reqID))
+	chainID := pending.GetSrcChainId()
+
+	req, ok, err := q.getRequest(ctx, chainID, reqID)
+	if err != nil {
+		log.Warn(ctx, "Failed fetching pending claim request (will retry)", err)
+		return false
+	} else if !ok || req.Status != statusFulfilled {
+		log.Debug(ctx, "Dropping unclaimable request", "status", statusString(req.Status))
+		return true
+	}
+
+	txHash, err := q.claim(ctx, chainID, req)
+	q.onClaimed(ctx, chainID, req, actionResult{Action: actionClaim, TxHash: txHash, Err: err})
+	if err != nil {
+		log.Warn(ctx, "Failed claiming request (will retry)", err)
+		return false
+	}
+
+	return true
+}
+
+// newClaimRecorder returns a function that records asynchronous claim results, warning on error.
+func newClaimRecorder(requests *requests) func(ctx context.Context, chainID uint64, req bindings.SolveRequest, res actionResult) {
+	return func(ctx context.Context, chainID uint64, req bindings.SolveRequest, res actionResult) {
+		if err := requests.RecordAction(ctx, chainID, req, res); err != nil {
+			log.Warn(ctx, "Failed recording claim", err)
+		}
+	}
+}
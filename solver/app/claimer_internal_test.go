@@ -0,0 +1,207 @@
+package app
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/omni-network/omni/contracts/bindings"
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/evmchain"
+	"github.com/omni-network/omni/lib/netconf"
+	"github.com/omni-network/omni/lib/tutil"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClaimBeneficiaries(t *testing.T) {
+	t.Parallel()
+
+	network := netconf.Network{
+		ID: netconf.Devnet,
+		Chains: []netconf.Chain{
+			{ID: evmchain.IDMockL1, Name: "mock_l1"},
+			{ID: evmchain.IDMockL2, Name: "mock_l2"},
+		},
+	}
+
+	treasury := tutil.RandomAddress()
+	solver := tutil.RandomAddress()
+
+	beneficiaries, err := parseClaimBeneficiaries(network, map[string]string{"mock_l1": treasury.Hex()})
+	require.NoError(t, err)
+	require.Equal(t, treasury, beneficiaries.Get(evmchain.IDMockL1, solver))
+	require.Equal(t, solver, beneficiaries.Get(evmchain.IDMockL2, solver))
+
+	_, err = parseClaimBeneficiaries(network, map[string]string{"unknown": treasury.Hex()})
+	require.Error(t, err)
+
+	_, err = parseClaimBeneficiaries(network, map[string]string{"mock_l1": "0x1234"})
+	require.Error(t, err)
+
+	_, err = parseClaimBeneficiaries(network, map[string]string{"mock_l1": common.Address{}.Hex()})
+	require.Error(t, err)
+}
+
+func TestClaimQueue(t *testing.T) {
+	t.Parallel()
+
+	const chainID = 1
+	const roundSize = 3
+
+	var mu sync.Mutex
+	claimed := make(map[[32]byte]int)
+	recorded := make(map[[32]byte]actionResult)
+	failing := tutil.RandomHash()     // Fails the first claim
+	unclaimable := tutil.RandomHash() // Already claimed
+
+	claim := func(_ context.Context, _ uint64, req bindings.SolveRequest) (common.Hash, error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		claimed[req.Id]++
+		if req.Id == failing && claimed[req.Id] == 1 {
+			return common.Hash{}, errors.New("claim failed")
+		}
+
+		return tutil.RandomHash(), nil
+	}
+
+	getRequest := func(_ context.Context, _ uint64, reqID [32]byte) (bindings.SolveRequest, bool, error) {
+		if reqID == unclaimable {
+			return bindings.SolveRequest{Id: reqID, Status: statusClaimed}, true, nil
+		}
+
+		return bindings.SolveRequest{Id: reqID, Status: statusFulfilled}, true, nil
+	}
+
+	onClaimed := func(_ context.Context, _ uint64, req bindings.SolveRequest, res actionResult) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		require.Equal(t, actionClaim, res.Action)
+		recorded[req.Id] = res
+	}
+
+	store := newTestStore(t)
+	queue := newClaimQueue(store, claim, getRequest, onClaimed, roundSize, time.Hour)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	requireQueued := func(t *testing.T, expect int) {
+		t.Helper()
+		queued, err := queue.Queued(ctx)
+		require.NoError(t, err)
+		require.Equal(t, expect, queued)
+	}
+
+	// Enqueue one less than round size, nothing claimed.
+	ok := tutil.RandomHash()
+	for _, id := range [][32]byte{ok, failing} {
+		txHash, err := queue.Enqueue(ctx, chainID, bindings.SolveRequest{Id: id, Status: statusFulfilled})
+		require.NoError(t, err)
+		require.Zero(t, txHash)
+	}
+
+	// Enqueuing a duplicate doesn't fill the round.
+	_, err := queue.Enqueue(ctx, chainID, bindings.SolveRequest{Id: ok, Status: statusFulfilled})
+	require.NoError(t, err)
+	requireQueued(t, 2)
+
+	// Queued claims are persisted, and claimed when running.
+	queue = newClaimQueue(store, claim, getRequest, onClaimed, roundSize, time.Hour)
+	requireQueued(t, 2)
+	go queue.Run(ctx)
+
+	// Failed claims remain queued.
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return len(recorded) == 2
+	}, time.Second, time.Millisecond)
+	requireQueued(t, 1)
+
+	mu.Lock()
+	require.Equal(t, 1, claimed[ok])
+	require.Equal(t, 1, claimed[failing])
+	require.NoError(t, recorded[ok].Err)
+	require.NotZero(t, recorded[ok].TxHash)
+	require.Error(t, recorded[failing].Err)
+	mu.Unlock()
+
+	// Filling the round triggers claims; failed claims are retried and unclaimable requests dropped.
+	another := tutil.RandomHash()
+	for _, id := range [][32]byte{unclaimable, another} {
+		_, err = queue.Enqueue(ctx, chainID, bindings.SolveRequest{Id: id, Status: statusFulfilled})
+		require.NoError(t, err)
+	}
+
+	require.Eventually(t, func() bool {
+		queued, err := queue.Queued(ctx)
+		require.NoError(t, err)
+
+		return queued == 0
+	}, time.Second, time.Millisecond)
+
+	mu.Lock()
+	require.Equal(t, 2, claimed[failing])
+	require.Equal(t, 1, claimed[another])
+	require.Zero(t, claimed[unclaimable])
+	require.NoError(t, recorded[failing].Err)
+	mu.Unlock()
+}
+
+func TestClaimQueueRoundSize(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	const roundSize = 2
+
+	var mu sync.Mutex
+	var claimed int
+	claim := func(context.Context, uint64, bindings.SolveRequest) (common.Hash, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		claimed++
+
+		return tutil.RandomHash(), nil
+	}
+	getRequest := func(_ context.Context, _ uint64, reqID [32]byte) (bindings.SolveRequest, bool, error) {
+		return bindings.SolveRequest{Id: reqID, Status: statusFulfilled}, true, nil
+	}
+	onClaimed := func(context.Context, uint64, bindings.SolveRequest, actionResult) {}
+
+	queue := newClaimQueue(newTestStore(t), claim, getRequest, onClaimed, roundSize, time.Hour)
+	for i := 0; i < 5; i++ {
+		_, err := queue.Enqueue(ctx, 1, bindings.SolveRequest{Id: tutil.RandomHash(), Status: statusFulfilled})
+		require.NoError(t, err)
+	}
+
+	// Each round claims at most roundSize requests.
+	for _, expect := range []int{3, 1, 0} {
+		queue.claimRound(ctx)
+		queued, err := queue.Queued(ctx)
+		require.NoError(t, err)
+		require.Equal(t, expect, queued)
+	}
+
+	mu.Lock()
+	require.Equal(t, 5, claimed)
+	mu.Unlock()
+}
+
+func newTestStore(t *testing.T) SolverStore {
+	t.Helper()
+
+	db, err := newSolverDB("")
+	require.NoError(t, err)
+	store, err := newSolverStore(db)
+	require.NoError(t, err)
+
+	return store
+}
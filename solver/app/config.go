@@ -3,11 +3,13 @@ package app
 import (
 	"bytes"
 	"text/template"
+	"time"
 
 	"github.com/omni-network/omni/lib/buildinfo"
 	"github.com/omni-network/omni/lib/errors"
 	"github.com/omni-network/omni/lib/log"
 	"github.com/omni-network/omni/lib/netconf"
+	"github.com/omni-network/omni/lib/signer"
 	"github.com/omni-network/omni/lib/xchain"
 
 	cmtos "github.com/cometbft/cometbft/libs/os"
@@ -16,18 +18,30 @@ import (
 )
 
 type Config struct {
-	RPCEndpoints   xchain.RPCEndpoints
-	Network        netconf.ID
-	MonitoringAddr string
-	PrivateKey     string
-	DBDir          string
+	RPCEndpoints       xchain.RPCEndpoints
+	Network            netconf.ID
+	MonitoringAddr     string
+	APIAddr            string
+	PrivateKey         string
+	Signer             signer.Config
+	DBDir              string
+	TargetsFile        string
+	MinProfitMargin    float64
+	ClaimBeneficiaries map[string]string // Chain name to beneficiary address
+	ClaimBatchSize     int
+	ClaimBatchPeriod   time.Duration
 }
 
 func DefaultConfig() Config {
 	return Config{
-		PrivateKey:     "solver.key",
-		MonitoringAddr: ":26660",
-		DBDir:          "./db",
+		PrivateKey:       "solver.key",
+		Signer:           signer.DefaultConfig(),
+		MonitoringAddr:   ":26660",
+		APIAddr:          ":26661",
+		DBDir:            "./db",
+		MinProfitMargin:  0.01,
+		ClaimBatchSize:   10,
+		ClaimBatchPeriod: time.Minute,
 	}
 }
 
@@ -18,6 +18,62 @@ private-key = "{{ .PrivateKey }}"
 # The address that the solver listens for metric scrape requests.
 monitoring-addr = "{{ .MonitoringAddr }}"
 
+# The address that the solver API (quote, check, status) listens on.
+api-addr = "{{ .APIAddr }}"
+
+# Path to the JSON targets registry file declaring additional solver targets.
+targets-file = "{{ .TargetsFile }}"
+
+# Minimum estimated profit margin of a request as a fraction of its cost (e.g. 0.01 for 1%).
+# Requests below this margin are rejected. Negative values disable the profitability check.
+min-profit-margin = {{ .MinProfitMargin }}
+
+#######################################################################
+###                             Signer                              ###
+#######################################################################
+
+[signer]
+
+# Transaction signer type: local, fireblocks, or remote.
+# Local signs with the private-key file, others keep the key off disk.
+type = "{{ .Signer.Type }}"
+
+# Signer account address, required for fireblocks and remote signers.
+address = "{{ .Signer.Address }}"
+
+# Fireblocks API key and RSA private key path, required for fireblocks signers.
+fireblocks-api-key = "{{ .Signer.FireAPIKey }}"
+fireblocks-key-path = "{{ .Signer.FireKeyPath }}"
+
+# Remote signer base URL, required for remote signers.
+# Digests are signed via POST <url>/sign with {"address":"0x..","digest":"0x.."},
+# responding with {"signature":"0x.."}.
+remote-url = "{{ .Signer.RemoteURL }}"
+
+#######################################################################
+###                             Claims                              ###
+#######################################################################
+
+[claim]
+
+# Maximum number of queued fulfilled requests to claim per round.
+# Each request is claimed by its own transaction; queued claims are persisted across restarts.
+# Values of 1 or less disable queuing, claiming each request immediately.
+batch-size = {{ .ClaimBatchSize }}
+
+# Maximum duration to wait before claiming a non-full round.
+batch-period = "{{ .ClaimBatchPeriod }}"
+
+# Claim beneficiary addresses per source chain; defaults to the solver address.
+[claim.beneficiaries]
+{{- if not .ClaimBeneficiaries }}
+# ethereum = "0x..."
+# optimism = "0x..."
+{{ end -}}
+{{- range $key, $value := .ClaimBeneficiaries }}
+{{ $key }} = "{{ $value }}"
+{{ end }}
+
 #######################################################################
 ###                             X-Chain                             ###
 #######################################################################
@@ -29,7 +29,8 @@ func newSolverDB(dbDir string) (db.DB, error) {
 	return resp, nil
 }
 
-func newCursors(db db.DB) (*cursors, error) {
+// newSolverStore returns the solver ORM store backed by the given DB.
+func newSolverStore(db db.DB) (SolverStore, error) {
 	schema := &ormv1alpha1.ModuleSchemaDescriptor{SchemaFile: []*ormv1alpha1.ModuleSchemaDescriptor_FileEntry{
 		{Id: 1, ProtoFileName: File_solver_app_solver_proto.Path()},
 	}}
@@ -46,9 +47,13 @@ func newCursors(db db.DB) (*cursors, error) {
 		return nil, errors.Wrap(err, "create store")
 	}
 
+	return dbStore, nil
+}
+
+func newCursors(store SolverStore) *cursors {
 	return &cursors{
-		table: dbStore.CursorTable(),
-	}, nil
+		table: store.CursorTable(),
+	}
 }
 
 // cursors provides a thread-safe persisted cursor store.
@@ -0,0 +1,247 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/omni-network/omni/contracts/bindings"
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/log"
+	"github.com/omni-network/omni/lib/xchain"
+	"github.com/omni-network/omni/solver/types"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var _ types.Target = erc20Target{}
+
+// erc20TargetJSON configures a generic ERC20 deposit target.
+type erc20TargetJSON struct {
+	// Name of the target, used for logging only.
+	Name string `json:"name"`
+	// ChainID of the destination chain the target is deployed on.
+	ChainID uint64 `json:"chain_id"`
+	// Address of the target contract.
+	Address common.Address `json:"address"`
+	// Token is the ERC20 token the target pulls from the solver on the destination chain.
+	Token common.Address `json:"token"`
+	// Methods are the allowed deposit method signatures, e.g. "deposit(address,uint256)".
+	// Each method must have exactly one uint256 argument, the deposit amount.
+	Methods []string `json:"methods"`
+	// SrcTokens are the accepted deposit tokens by source chain ID.
+	SrcTokens map[uint64]common.Address `json:"src_tokens"`
+	// FulfillFinalized defers fulfilling requests until their source chain deposits are finalized.
+	FulfillFinalized bool `json:"fulfill_finalized"`
+}
+
+// depositMethod is an allowed target deposit method.
+type depositMethod struct {
+	Method    abi.Method
+	AmountIdx int // Index of the amount argument
+}
+
+// erc20Target is a generic ABI-driven target that deposits ERC20 tokens
+// on behalf of the user in exchange for equal source chain deposits.
+type erc20Target struct {
+	name      string
+	chainID   uint64
+	address   common.Address
+	token     common.Address
+	methods   map[[4]byte]depositMethod
+	srcTokens map[uint64]common.Address
+	confLevel xchain.ConfLevel
+}
+
+func newERC20Target(j erc20TargetJSON) (erc20Target, error) {
+	if j.ChainID == 0 {
+		return erc20Target{}, errors.New("missing chain id")
+	} else if j.Address == (common.Address{}) {
+		return erc20Target{}, errors.New("missing address")
+	} else if j.Token == (common.Address{}) {
+		return erc20Target{}, errors.New("missing token")
+	} else if len(j.Methods) == 0 {
+		return erc20Target{}, errors.New("missing methods")
+	} else if len(j.SrcTokens) == 0 {
+		return erc20Target{}, errors.New("missing source tokens")
+	}
+
+	methods := make(map[[4]byte]depositMethod)
+	for _, sig := range j.Methods {
+		method, err := parseDepositMethod(sig)
+		if err != nil {
+			return erc20Target{}, err
+		}
+
+		selector := [4]byte(method.Method.ID)
+		if _, ok := methods[selector]; ok {
+			return erc20Target{}, errors.New("duplicate method", "method", sig)
+		}
+		methods[selector] = method
+	}
+
+	confLevel := xchain.ConfLatest
+	if j.FulfillFinalized {
+		confLevel = xchain.ConfFinalized
+	}
+
+	return erc20Target{
+		name:      j.Name,
+		chainID:   j.ChainID,
+		address:   j.Address,
+		token:     j.Token,
+		methods:   methods,
+		srcTokens: j.SrcTokens,
+		confLevel: confLevel,
+	}, nil
+}
+
+func (t erc20Target) ChainID() uint64 {
+	return t.chainID
+}
+
+func (t erc20Target) Address() common.Address {
+	return t.address
+}
+
+func (t erc20Target) FulfillConfLevel() xchain.ConfLevel {
+	return t.confLevel
+}
+
+func (t erc20Target) TokenPrereqs(call bindings.SolveCall) ([]bindings.SolveTokenPrereq, error) {
+	_, amount, err := t.unpackDeposit(call.Data)
+	if err != nil {
+		return nil, errors.Wrap(err, "unpack deposit")
+	}
+
+	return []bindings.SolveTokenPrereq{
+		{
+			Token:   t.token,
+			Spender: t.address,
+			Amount:  amount,
+		},
+	}, nil
+}
+
+func (t erc20Target) Verify(srcChainID uint64, call bindings.SolveCall, deposits []bindings.SolveDeposit) (types.RejectReason, error) {
+	srcToken, ok := t.srcTokens[srcChainID]
+	if !ok {
+		return types.RejectUnsupportedSrcChain, errors.New("source chain not supported", "src", srcChainID)
+	}
+
+	if call.Value != nil && call.Value.Sign() != 0 {
+		return types.RejectInvalidCall, errors.New("native call value not supported")
+	}
+
+	_, amount, err := t.unpackDeposit(call.Data)
+	if err != nil {
+		return types.RejectInvalidCall, errors.Wrap(err, "invalid deposit")
+	}
+
+	var srcDeposit *bindings.SolveDeposit
+	for _, deposit := range deposits {
+		if !deposit.IsNative && deposit.Token == srcToken {
+			srcDeposit = &deposit
+		}
+	}
+
+	if srcDeposit == nil {
+		return types.RejectUnsupportedToken, errors.New("no source token deposit")
+	}
+
+	if srcDeposit.Amount.Cmp(amount) < 0 {
+		return types.RejectInsufficientDeposit, errors.New("insufficient source token deposit",
+			"expected", amount,
+			"actual", srcDeposit.Amount,
+		)
+	}
+
+	return types.RejectNone, nil
+}
+
+func (t erc20Target) DebugCall(ctx context.Context, call bindings.SolveCall) error {
+	method, amount, err := t.unpackDeposit(call.Data)
+	if err != nil {
+		return errors.Wrap(err, "unpack deposit")
+	}
+
+	log.Debug(ctx, "ERC20 deposit", "target", t.name, "method", method.Sig, "amount", amount)
+
+	return nil
+}
+
+// unpackDeposit returns the deposit method and amount of the call data.
+func (t erc20Target) unpackDeposit(data []byte) (abi.Method, *big.Int, error) {
+	if len(data) < 4 {
+		return abi.Method{}, nil, errors.New("invalid call data")
+	}
+
+	method, ok := t.methods[[4]byte(data[:4])]
+	if !ok {
+		return abi.Method{}, nil, errors.New("method not allowed")
+	}
+
+	unpacked, err := method.Method.Inputs.Unpack(bytes.Clone(data[4:]))
+	if err != nil {
+		return abi.Method{}, nil, errors.Wrap(err, "unpack data")
+	}
+
+	amount, ok := unpacked[method.AmountIdx].(*big.Int)
+	if !ok {
+		return abi.Method{}, nil, errors.New("invalid amount type [BUG]")
+	}
+
+	return method.Method, amount, nil
+}
+
+// abiAliases maps solidity type aliases to their canonical ABI types.
+var abiAliases = map[string]string{
+	"uint": "uint256",
+	"int":  "int256",
+}
+
+// parseDepositMethod parses a method signature like "deposit(address,uint256)".
+// Only non-tuple argument types are supported, empty arguments are ignored.
+func parseDepositMethod(sig string) (depositMethod, error) {
+	name, args, ok := strings.Cut(strings.ReplaceAll(sig, " ", ""), "(")
+	if !ok || name == "" || !strings.HasSuffix(args, ")") {
+		return depositMethod{}, errors.New("invalid method signature", "sig", sig)
+	}
+
+	amountIdx := -1
+	var inputs abi.Arguments
+	for _, arg := range strings.Split(strings.TrimSuffix(args, ")"), ",") {
+		if arg == "" {
+			continue
+		} else if alias, ok := abiAliases[arg]; ok {
+			arg = alias
+		}
+
+		typ, err := abi.NewType(arg, "", nil)
+		if err != nil {
+			return depositMethod{}, errors.Wrap(err, "invalid argument type", "sig", sig, "type", arg)
+		}
+
+		idx := len(inputs) // Index of the parsed (non-empty) arguments.
+		if arg == "uint256" {
+			if amountIdx >= 0 {
+				return depositMethod{}, errors.New("multiple uint256 arguments", "sig", sig)
+			}
+			amountIdx = idx
+		}
+
+		inputs = append(inputs, abi.Argument{Name: fmt.Sprintf("arg%d", idx), Type: typ})
+	}
+
+	if amountIdx < 0 {
+		return depositMethod{}, errors.New("missing uint256 amount argument", "sig", sig)
+	}
+
+	return depositMethod{
+		Method:    abi.NewMethod(name, name, abi.Function, "nonpayable", false, false, inputs, nil),
+		AmountIdx: amountIdx,
+	}, nil
+}
@@ -0,0 +1,158 @@
+package app
+
+import (
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/omni-network/omni/contracts/bindings"
+	"github.com/omni-network/omni/lib/netconf"
+	"github.com/omni-network/omni/lib/tokens"
+	"github.com/omni-network/omni/lib/tutil"
+	"github.com/omni-network/omni/solver/types"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestERC20Target(t *testing.T) {
+	t.Parallel()
+
+	const (
+		srcChain  = 1
+		destChain = 2
+	)
+
+	srcToken := tutil.RandomAddress()
+	j := erc20TargetJSON{
+		Name:      "test",
+		ChainID:   destChain,
+		Address:   tutil.RandomAddress(),
+		Token:     tutil.RandomAddress(),
+		Methods:   []string{"deposit(address,uint256)"},
+		SrcTokens: map[uint64]common.Address{srcChain: srcToken},
+	}
+
+	target, err := newERC20Target(j)
+	require.NoError(t, err)
+
+	method, err := parseDepositMethod(j.Methods[0])
+	require.NoError(t, err)
+
+	amount := big.NewInt(100)
+	data, err := method.Method.Inputs.Pack(tutil.RandomAddress(), amount)
+	require.NoError(t, err)
+
+	call := bindings.SolveCall{
+		DestChainId: destChain,
+		Target:      j.Address,
+		Data:        append(method.Method.ID, data...),
+	}
+
+	prereqs, err := target.TokenPrereqs(call)
+	require.NoError(t, err)
+	require.Len(t, prereqs, 1)
+	require.Equal(t, j.Token, prereqs[0].Token)
+	require.Equal(t, j.Address, prereqs[0].Spender)
+	require.Equal(t, amount, prereqs[0].Amount)
+
+	deposit := func(token common.Address, amt int64) []bindings.SolveDeposit {
+		return []bindings.SolveDeposit{{Token: token, Amount: big.NewInt(amt)}}
+	}
+
+	verify := func(t *testing.T, srcChainID uint64, call bindings.SolveCall, deposits []bindings.SolveDeposit, expect types.RejectReason) {
+		t.Helper()
+		reason, err := target.Verify(srcChainID, call, deposits)
+		require.Equal(t, expect, reason)
+		if expect == types.RejectNone {
+			require.NoError(t, err)
+		} else {
+			require.Error(t, err)
+		}
+	}
+
+	verify(t, srcChain, call, deposit(srcToken, 100), types.RejectNone)
+	verify(t, srcChain, call, deposit(srcToken, 101), types.RejectNone)
+	verify(t, srcChain, call, deposit(srcToken, 99), types.RejectInsufficientDeposit)
+	verify(t, srcChain, call, deposit(tutil.RandomAddress(), 100), types.RejectUnsupportedToken)
+	verify(t, destChain, call, deposit(srcToken, 100), types.RejectUnsupportedSrcChain)
+
+	badCall := call
+	badCall.Data = append([]byte{0, 1, 2, 3}, data...)
+	verify(t, srcChain, badCall, deposit(srcToken, 100), types.RejectInvalidCall)
+}
+
+func TestParseDepositMethod(t *testing.T) {
+	t.Parallel()
+
+	m, err := parseDepositMethod("deposit(address, uint256)")
+	require.NoError(t, err)
+	require.Equal(t, "deposit(address,uint256)", m.Method.Sig)
+	require.Equal(t, 1, m.AmountIdx)
+
+	// Empty arguments don't shift the amount index, and uint aliases uint256.
+	m, err = parseDepositMethod("deposit(,address,uint)")
+	require.NoError(t, err)
+	require.Equal(t, "deposit(address,uint256)", m.Method.Sig)
+	require.Equal(t, 1, m.AmountIdx)
+
+	_, err = parseDepositMethod("deposit(uint,uint256)")
+	require.Error(t, err)
+
+	_, err = parseDepositMethod("deposit(address)")
+	require.Error(t, err)
+
+	_, err = parseDepositMethod("deposit(uint256,uint256)")
+	require.Error(t, err)
+
+	_, err = parseDepositMethod("deposit")
+	require.Error(t, err)
+}
+
+func TestLoadRegistry(t *testing.T) {
+	t.Parallel()
+
+	j := erc20TargetJSON{
+		Name:      "test",
+		ChainID:   2,
+		Address:   tutil.RandomAddress(),
+		Token:     tutil.RandomAddress(),
+		Methods:   []string{"deposit(uint256)"},
+		SrcTokens: map[uint64]common.Address{1: tutil.RandomAddress()},
+	}
+
+	token := tokenJSON{ChainID: 1, Address: tutil.RandomAddress(), Symbol: tokens.WSTETH}
+
+	bz, err := json.Marshal(targetsJSON{ERC20Deposits: []erc20TargetJSON{j}, Tokens: []tokenJSON{token}})
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "targets.json")
+	require.NoError(t, os.WriteFile(path, bz, 0o644))
+
+	reg, err := loadRegistry(netconf.Simnet, path)
+	require.NoError(t, err)
+	require.Len(t, reg.Targets, 1)
+	targets := reg.Targets
+
+	symbol, ok := reg.Tokens.Token(token.ChainID, token.Address)
+	require.True(t, ok)
+	require.Equal(t, tokens.WSTETH, symbol)
+
+	target, err := targets.Get(bindings.SolveCall{DestChainId: j.ChainID, Target: j.Address})
+	require.NoError(t, err)
+	require.Equal(t, j.Address, target.Address())
+
+	_, err = targets.Get(bindings.SolveCall{DestChainId: 1, Target: j.Address})
+	require.Error(t, err)
+
+	// Duplicate targets are not allowed
+	bz, err = json.Marshal(targetsJSON{ERC20Deposits: []erc20TargetJSON{j, j}})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, bz, 0o644))
+
+	_, err = loadRegistry(netconf.Simnet, path)
+	require.Error(t, err)
+}
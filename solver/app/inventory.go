@@ -0,0 +1,247 @@
+package app
+
+import (
+	"context"
+	"math/big"
+	"sync"
+
+	"github.com/omni-network/omni/contracts/bindings"
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/ethclient/ethbackend"
+	"github.com/omni-network/omni/lib/netconf"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// nativeToken is the zero address used to denote the native token of a chain.
+var nativeToken = common.Address{}
+
+// tokenAmount is an amount of a token, the zero address denotes the native token.
+type tokenAmount struct {
+	Token  common.Address
+	Amount *big.Int
+}
+
+// balanceFunc returns the solver balance of a token on a chain, the zero address denotes the native token.
+type balanceFunc func(ctx context.Context, chainID uint64, token common.Address) (*big.Int, error)
+
+// reservation is a set of token amounts reserved on a chain for a request.
+type reservation struct {
+	ChainID uint64
+	Amounts []tokenAmount
+}
+
+// inventory tracks solver balances per chain and reserves amounts for accepted requests
+// so concurrent requests do not double-spend the same balance.
+type inventory struct {
+	network   netconf.Network
+	balanceOf balanceFunc
+
+	mu           sync.Mutex
+	reservations map[[32]byte]reservation
+}
+
+func newInventory(network netconf.Network, balanceOf balanceFunc) *inventory {
+	return &inventory{
+		network:      network,
+		balanceOf:    balanceOf,
+		reservations: make(map[[32]byte]reservation),
+	}
+}
+
+// Reserve reserves the amounts on the chain for the request. It returns false if the available balance
+// (balance minus existing reservations) of any token is insufficient.
+// Reserving an already reserved request replaces the previous reservation.
+func (i *inventory) Reserve(ctx context.Context, reqID [32]byte, chainID uint64, amounts []tokenAmount) (bool, error) {
+	// Lock for the duration of balance queries, so concurrent reservations are serialized.
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	prev, hasPrev := i.reservations[reqID]
+	delete(i.reservations, reqID)
+
+	amounts = mergeAmounts(amounts)
+	if ok, err := i.sufficientUnsafe(ctx, chainID, amounts); err != nil || !ok {
+		if hasPrev {
+			i.reservations[reqID] = prev
+		}
+
+		return false, err
+	}
+
+	i.reservations[reqID] = reservation{ChainID: chainID, Amounts: amounts}
+	i.instrumentUnsafe(chainID, amounts)
+
+	return true, nil
+}
+
+// Restore reserves the amounts on the chain for an already accepted request, regardless of the available balance,
+// since the solver is committed to fulfilling it. It is used to rebuild reservations of in-flight requests after restarts.
+// Restoring an already reserved request replaces the previous reservation.
+func (i *inventory) Restore(reqID [32]byte, chainID uint64, amounts []tokenAmount) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	amounts = mergeAmounts(amounts)
+	i.reservations[reqID] = reservation{ChainID: chainID, Amounts: amounts}
+	i.instrumentUnsafe(chainID, amounts)
+}
+
+// Sufficient returns true if the available balance (balance minus existing reservations)
+// of all tokens on the chain is sufficient for the amounts, without reserving them.
+func (i *inventory) Sufficient(ctx context.Context, chainID uint64, amounts []tokenAmount) (bool, error) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	return i.sufficientUnsafe(ctx, chainID, mergeAmounts(amounts))
+}
+
+// sufficientUnsafe returns true if the available balance of all (merged) amounts on the chain is sufficient.
+// It assumes the lock is held.
+func (i *inventory) sufficientUnsafe(ctx context.Context, chainID uint64, amounts []tokenAmount) (bool, error) {
+	for _, amt := range amounts {
+		balance, err := i.balanceOf(ctx, chainID, amt.Token)
+		if err != nil {
+			return false, errors.Wrap(err, "get balance", "token", amt.Token)
+		}
+
+		reserved := i.reservedUnsafe(chainID, amt.Token)
+		available := new(big.Int).Sub(balance, reserved)
+
+		chainName := i.network.ChainName(chainID)
+		inventoryBalance.WithLabelValues(chainName, amt.Token.Hex()).Set(toGweiF64(balance))
+
+		if available.Cmp(amt.Amount) < 0 {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// Release releases the reservation of the request, if any.
+func (i *inventory) Release(reqID [32]byte) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	res, ok := i.reservations[reqID]
+	if !ok {
+		return
+	}
+
+	delete(i.reservations, reqID)
+	i.instrumentUnsafe(res.ChainID, res.Amounts)
+}
+
+// Reserved returns the total reserved amount of the token on the chain.
+func (i *inventory) Reserved(chainID uint64, token common.Address) *big.Int {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	return i.reservedUnsafe(chainID, token)
+}
+
+// reservedUnsafe returns the total reserved amount of the token on the chain.
+// It assumes the lock is held.
+func (i *inventory) reservedUnsafe(chainID uint64, token common.Address) *big.Int {
+	resp := new(big.Int)
+	for _, res := range i.reservations {
+		if res.ChainID != chainID {
+			continue
+		}
+
+		for _, amt := range res.Amounts {
+			if amt.Token == token {
+				resp.Add(resp, amt.Amount)
+			}
+		}
+	}
+
+	return resp
+}
+
+// instrumentUnsafe updates the reserved metrics of the tokens on the chain.
+// It assumes the lock is held.
+func (i *inventory) instrumentUnsafe(chainID uint64, amounts []tokenAmount) {
+	chainName := i.network.ChainName(chainID)
+	for _, amt := range amounts {
+		reserved := i.reservedUnsafe(chainID, amt.Token)
+		inventoryReserved.WithLabelValues(chainName, amt.Token.Hex()).Set(toGweiF64(reserved))
+	}
+}
+
+// mergeAmounts returns the amounts with duplicate tokens summed.
+func mergeAmounts(amounts []tokenAmount) []tokenAmount {
+	var resp []tokenAmount
+	indexes := make(map[common.Address]int)
+	for _, amt := range amounts {
+		if amt.Amount == nil || amt.Amount.Sign() == 0 {
+			continue
+		}
+
+		idx, ok := indexes[amt.Token]
+		if !ok {
+			indexes[amt.Token] = len(resp)
+			resp = append(resp, tokenAmount{Token: amt.Token, Amount: new(big.Int).Set(amt.Amount)})
+
+			continue
+		}
+
+		resp[idx].Amount.Add(resp[idx].Amount, amt.Amount)
+	}
+
+	return resp
+}
+
+// requiredAmounts returns the token and native amounts required to fulfill the call.
+func requiredAmounts(call bindings.SolveCall, prereqs []bindings.SolveTokenPrereq) []tokenAmount {
+	resp := make([]tokenAmount, 0, len(prereqs)+1)
+	for _, prereq := range prereqs {
+		resp = append(resp, tokenAmount{Token: prereq.Token, Amount: prereq.Amount})
+	}
+
+	if call.Value != nil {
+		resp = append(resp, tokenAmount{Token: nativeToken, Amount: call.Value})
+	}
+
+	return mergeAmounts(resp)
+}
+
+// newBalancer returns a balanceFunc that queries the solver balances via the backends.
+func newBalancer(backends ethbackend.Backends, solverAddr common.Address) balanceFunc {
+	return func(ctx context.Context, chainID uint64, token common.Address) (*big.Int, error) {
+		backend, err := backends.Backend(chainID)
+		if err != nil {
+			return nil, err
+		}
+
+		if token == nativeToken {
+			balance, err := backend.BalanceAt(ctx, solverAddr, nil)
+			if err != nil {
+				return nil, errors.Wrap(err, "get native balance")
+			}
+
+			return balance, nil
+		}
+
+		// TODO(kevin): make erc20 bindings and use here
+		contract, err := bindings.NewMockToken(token, backend)
+		if err != nil {
+			return nil, errors.Wrap(err, "new token")
+		}
+
+		balance, err := contract.BalanceOf(&bind.CallOpts{Context: ctx}, solverAddr)
+		if err != nil {
+			return nil, errors.Wrap(err, "get token balance")
+		}
+
+		return balance, nil
+	}
+}
+
+// toGweiF64 converts a big.Int wei amount to gwei float64.
+func toGweiF64(b *big.Int) float64 {
+	f, _ := new(big.Float).Quo(new(big.Float).SetInt(b), big.NewFloat(1e9)).Float64()
+	return f
+}
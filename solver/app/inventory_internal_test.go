@@ -0,0 +1,110 @@
+package app
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/omni-network/omni/lib/netconf"
+	"github.com/omni-network/omni/lib/tutil"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInventory(t *testing.T) {
+	t.Parallel()
+
+	const chainID = 1
+	ctx := context.Background()
+	token := tutil.RandomAddress()
+
+	balances := map[common.Address]int64{
+		token:       100,
+		nativeToken: 10,
+	}
+
+	inv := newInventory(netconf.Network{}, func(_ context.Context, _ uint64, token common.Address) (*big.Int, error) {
+		return big.NewInt(balances[token]), nil
+	})
+
+	amounts := func(tkn, native int64) []tokenAmount {
+		return []tokenAmount{
+			{Token: token, Amount: big.NewInt(tkn)},
+			{Token: nativeToken, Amount: big.NewInt(native)},
+		}
+	}
+
+	req1, req2, req3 := tutil.RandomHash(), tutil.RandomHash(), tutil.RandomHash()
+
+	// Reserve 60 of 100 tokens
+	ok, err := inv.Reserve(ctx, req1, chainID, amounts(60, 5))
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.EqualValues(t, 60, inv.Reserved(chainID, token).Int64())
+	require.EqualValues(t, 5, inv.Reserved(chainID, nativeToken).Int64())
+
+	// Reserving another 60 tokens fails, nothing reserved
+	ok, err = inv.Reserve(ctx, req2, chainID, amounts(60, 1))
+	require.NoError(t, err)
+	require.False(t, ok)
+	require.EqualValues(t, 5, inv.Reserved(chainID, nativeToken).Int64())
+
+	// Reserving 40 tokens succeeds
+	ok, err = inv.Reserve(ctx, req2, chainID, amounts(40, 5))
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.EqualValues(t, 100, inv.Reserved(chainID, token).Int64())
+
+	// Other chains are not affected
+	require.Zero(t, inv.Reserved(chainID+1, token).Int64())
+
+	// Native balance exhausted
+	ok, err = inv.Reserve(ctx, req3, chainID, amounts(0, 1))
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// Re-reserving replaces the previous reservation
+	ok, err = inv.Reserve(ctx, req1, chainID, amounts(50, 5))
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.EqualValues(t, 90, inv.Reserved(chainID, token).Int64())
+
+	// Release frees the reservation
+	inv.Release(req1)
+	require.EqualValues(t, 40, inv.Reserved(chainID, token).Int64())
+	require.EqualValues(t, 5, inv.Reserved(chainID, nativeToken).Int64())
+
+	ok, err = inv.Reserve(ctx, req3, chainID, amounts(60, 5))
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// Restoring an accepted request reserves regardless of the available balance
+	inv.Restore(req1, chainID, amounts(50, 5))
+	require.EqualValues(t, 150, inv.Reserved(chainID, token).Int64())
+	require.EqualValues(t, 15, inv.Reserved(chainID, nativeToken).Int64())
+
+	ok, err = inv.Reserve(ctx, tutil.RandomHash(), chainID, amounts(1, 0))
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// Restoring again doesn't double count
+	inv.Restore(req1, chainID, amounts(50, 5))
+	require.EqualValues(t, 150, inv.Reserved(chainID, token).Int64())
+}
+
+func TestMergeAmounts(t *testing.T) {
+	t.Parallel()
+
+	token := tutil.RandomAddress()
+	merged := mergeAmounts([]tokenAmount{
+		{Token: token, Amount: big.NewInt(1)},
+		{Token: nativeToken, Amount: big.NewInt(0)},
+		{Token: token, Amount: big.NewInt(2)},
+	})
+
+	require.Len(t, merged, 1)
+	require.Equal(t, token, merged[0].Token)
+	require.EqualValues(t, 3, merged[0].Amount.Int64())
+}
@@ -0,0 +1,36 @@
+package app
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	rejectedTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "solver",
+		Subsystem: "processor",
+		Name:      "rejected_total",
+		Help:      "Total number of rejected requests by source chain, destination chain and reason",
+	}, []string{"src_chain", "dst_chain", "reason"})
+
+	inventoryBalance = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "solver",
+		Subsystem: "inventory",
+		Name:      "balance_gwei",
+		Help:      "Solver balance by chain and token (zero address is native) in gwei",
+	}, []string{"chain", "token"})
+
+	inventoryReserved = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "solver",
+		Subsystem: "inventory",
+		Name:      "reserved_gwei",
+		Help:      "Solver balance reserved for accepted requests by chain and token (zero address is native) in gwei",
+	}, []string{"chain", "token"})
+
+	chainReady = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "solver",
+		Subsystem: "chain",
+		Name:      "ready",
+		Help:      "Whether the chain is initialized and ready (1) or not (0) by chain",
+	}, []string{"chain"})
+)
@@ -12,135 +12,167 @@ import (
 	"github.com/omni-network/omni/lib/log"
 	"github.com/omni-network/omni/lib/netconf"
 	"github.com/omni-network/omni/lib/umath"
+	"github.com/omni-network/omni/solver/types"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/core/types"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
 // procDeps abstracts dependencies for the event processor allowed simplified testing.
 type procDeps struct {
-	ParseID      func(chainID uint64, log types.Log) ([32]byte, error)
+	ParseID      func(chainID uint64, log ethtypes.Log) ([32]byte, error)
 	GetRequest   func(ctx context.Context, chainID uint64, id [32]byte) (bindings.SolveRequest, bool, error)
-	ShouldReject func(ctx context.Context, chainID uint64, req bindings.SolveRequest) (rejectReason, bool, error)
+	ShouldReject func(ctx context.Context, chainID uint64, req bindings.SolveRequest) (types.RejectReason, bool, error)
 	SetCursor    func(ctx context.Context, chainID uint64, height uint64) error
 
-	Accept  func(ctx context.Context, chainID uint64, req bindings.SolveRequest) error
-	Reject  func(ctx context.Context, chainID uint64, req bindings.SolveRequest, reason rejectReason) error
-	Fulfill func(ctx context.Context, chainID uint64, req bindings.SolveRequest) error
-	Claim   func(ctx context.Context, chainID uint64, req bindings.SolveRequest) error
+	// Record records the processing of a request event and resulting solver action in the DB.
+	Record func(ctx context.Context, chainID uint64, height uint64, eventStatus uint8, req bindings.SolveRequest, res actionResult) error
+	// InFlight returns the requests recorded in the DB that may still require solver action.
+	InFlight func(ctx context.Context, chainID uint64) ([]*Request, error)
+
+	// CheckReorg returns the height to rewind the cursor to and true if a source chain reorg is detected at the height.
+	CheckReorg func(ctx context.Context, chainID uint64, height uint64, elogs []ethtypes.Log) (uint64, bool, error)
+	// DeferFulfill returns true if fulfilling the request is deferred until its source chain height is finalized.
+	DeferFulfill func(ctx context.Context, chainID uint64, height uint64, req bindings.SolveRequest) (bool, error)
+	// Reserve (re)reserves the inventory required to fulfill the accepted request, e.g. after a restart.
+	Reserve func(ctx context.Context, chainID uint64, req bindings.SolveRequest) error
+
+	// Actions return the hash of the submitted transaction, if any.
+	// Claim returns a zero hash if the claim is queued and submitted asynchronously.
+	Accept  func(ctx context.Context, chainID uint64, req bindings.SolveRequest) (common.Hash, error)
+	Reject  func(ctx context.Context, chainID uint64, req bindings.SolveRequest, reason types.RejectReason) (common.Hash, error)
+	Fulfill func(ctx context.Context, chainID uint64, req bindings.SolveRequest) (common.Hash, error)
+	Claim   func(ctx context.Context, chainID uint64, req bindings.SolveRequest) (common.Hash, error)
 }
 
 func newClaimer(
-	inboxContracts map[uint64]*bindings.SolveInbox,
+	inboxContracts *chainContracts[*bindings.SolveInbox],
 	backends ethbackend.Backends,
 	solverAddr common.Address,
-) func(ctx context.Context, chainID uint64, req bindings.SolveRequest) error {
-	return func(ctx context.Context, chainID uint64, req bindings.SolveRequest) error {
-		inbox, ok := inboxContracts[chainID]
+	beneficiaries claimBeneficiaries,
+	profits profitEstimator,
+) func(ctx context.Context, chainID uint64, req bindings.SolveRequest) (common.Hash, error) {
+	return func(ctx context.Context, chainID uint64, req bindings.SolveRequest) (common.Hash, error) {
+		inbox, ok := inboxContracts.Get(chainID)
 		if !ok {
-			return errors.New("unknown chain")
+			return common.Hash{}, errors.New("unknown chain")
 		}
 
 		backend, err := backends.Backend(chainID)
 		if err != nil {
-			return err
+			return common.Hash{}, err
 		}
 
 		txOpts, err := backend.BindOpts(ctx, solverAddr)
 		if err != nil {
-			return err
+			return common.Hash{}, err
 		}
 
-		// Claim to solver address for now
-		// TODO: consider claiming to hot / cold funding wallet
-		tx, err := inbox.Claim(txOpts, req.Id, solverAddr)
+		to := beneficiaries.Get(chainID, solverAddr)
+		tx, err := inbox.Claim(txOpts, req.Id, to)
 		if err != nil {
-			return errors.Wrap(err, "claim request")
-		} else if _, err := backend.WaitMined(ctx, tx); err != nil {
-			return errors.Wrap(err, "wait mined")
+			return common.Hash{}, errors.Wrap(err, "claim request")
 		}
 
-		return nil
+		rec, err := backend.WaitMined(ctx, tx)
+		if err != nil {
+			return tx.Hash(), errors.Wrap(err, "wait mined")
+		}
+
+		profits.logClaimPnL(ctx, chainID, req, to, tx, rec)
+
+		return tx.Hash(), nil
 	}
 }
 
 func newFulfiller(
-	network netconf.ID,
-	outboxContracts map[uint64]*bindings.SolveOutbox,
+	targets targets,
+	inventory *inventory,
+	profits profitEstimator,
+	outboxContracts *chainContracts[*bindings.SolveOutbox],
 	backends ethbackend.Backends,
 	solverAddr, outboxAddr common.Address,
-) func(ctx context.Context, srcChainID uint64, req bindings.SolveRequest) error {
-	return func(ctx context.Context, srcChainID uint64, req bindings.SolveRequest) error {
+) func(ctx context.Context, srcChainID uint64, req bindings.SolveRequest) (common.Hash, error) {
+	return func(ctx context.Context, srcChainID uint64, req bindings.SolveRequest) (common.Hash, error) {
 		destChainID := req.Call.DestChainId // Fulfilling happens on destination chain
-		outbox, ok := outboxContracts[destChainID]
+		outbox, ok := outboxContracts.Get(destChainID)
 		if !ok {
-			return errors.New("unknown chain")
+			return common.Hash{}, errors.New("unknown chain")
 		}
 
 		backend, err := backends.Backend(destChainID)
 		if err != nil {
-			return err
+			return common.Hash{}, err
 		}
 
 		callOpts := &bind.CallOpts{Context: ctx}
 		txOpts, err := backend.BindOpts(ctx, solverAddr)
 		if err != nil {
-			return err
+			return common.Hash{}, err
 		}
 
 		if ok, err := outbox.DidFulfill(callOpts, req.Id, srcChainID, req.Call); err != nil {
-			return errors.Wrap(err, "did fulfill")
+			return common.Hash{}, errors.Wrap(err, "did fulfill")
 		} else if ok {
 			log.Info(ctx, "Skipping already fulfilled request", "req_id", req.Id)
-			return nil
+			inventory.Release(req.Id)
+
+			return common.Hash{}, nil
 		}
 
-		target, err := getTarget(network, req.Call)
+		target, err := targets.Get(req.Call)
 		if err != nil {
-			return errors.Wrap(err, "get target [BUG]")
+			return common.Hash{}, errors.Wrap(err, "get target [BUG]")
 		}
 
 		prereqs, err := target.TokenPrereqs(req.Call)
 		if err != nil {
-			return errors.Wrap(err, "get token prereqs")
+			return common.Hash{}, errors.Wrap(err, "get token prereqs")
 		}
 
 		for _, prereq := range prereqs {
 			if err := approveOutboxSpend(ctx, prereq, backend, solverAddr, outboxAddr); err != nil {
-				return errors.Wrap(err, "approve outbox spend")
+				return common.Hash{}, errors.Wrap(err, "approve outbox spend")
 			}
 
 			if err := checkAllowedCall(ctx, outbox, req.Call); err != nil {
-				return errors.Wrap(err, "check allowed call")
+				return common.Hash{}, errors.Wrap(err, "check allowed call")
 			}
 		}
 
 		if err := target.DebugCall(ctx, req.Call); err != nil {
-			return errors.Wrap(err, "debug call")
+			return common.Hash{}, errors.Wrap(err, "debug call")
 		}
 
 		// xcall fee
 		fee, err := outbox.FulfillFee(callOpts, srcChainID)
 		if err != nil {
-			return errors.Wrap(err, "get fulfill fee")
+			return common.Hash{}, errors.Wrap(err, "get fulfill fee")
 		}
 
 		txOpts.Value = fee
 		tx, err := outbox.Fulfill(txOpts, req.Id, srcChainID, req.Call, prereqs)
 		if err != nil {
-			return errors.Wrap(err, "fulfill request", "custom", detectCustomError(err))
-		} else if _, err := backend.WaitMined(ctx, tx); err != nil {
-			return errors.Wrap(err, "wait mined")
+			return common.Hash{}, errors.Wrap(err, "fulfill request", "custom", detectCustomError(err))
 		}
 
+		rec, err := backend.WaitMined(ctx, tx)
+		if err != nil {
+			return tx.Hash(), errors.Wrap(err, "wait mined")
+		}
+
+		profits.logPnL(ctx, srcChainID, req, prereqs, tx, rec)
+
 		if ok, err := outbox.DidFulfill(callOpts, req.Id, srcChainID, req.Call); err != nil {
-			return errors.Wrap(err, "did fulfill")
+			return tx.Hash(), errors.Wrap(err, "did fulfill")
 		} else if !ok {
-			return errors.New("fulfill failed [BUG]")
+			return tx.Hash(), errors.New("fulfill failed [BUG]")
 		}
 
-		return nil
+		inventory.Release(req.Id)
+
+		return tx.Hash(), nil
 	}
 }
 
@@ -233,72 +265,114 @@ func approveOutboxSpend(ctx context.Context, prereq bindings.SolveTokenPrereq, b
 }
 
 func newRejector(
-	inboxContracts map[uint64]*bindings.SolveInbox,
+	network netconf.Network,
+	inboxContracts *chainContracts[*bindings.SolveInbox],
 	backends ethbackend.Backends,
 	solverAddr common.Address,
-) func(ctx context.Context, chainID uint64, req bindings.SolveRequest, reason rejectReason) error {
-	return func(ctx context.Context, chainID uint64, req bindings.SolveRequest, reason rejectReason) error {
-		inbox, ok := inboxContracts[chainID]
+	inventory *inventory,
+) func(ctx context.Context, chainID uint64, req bindings.SolveRequest, reason types.RejectReason) (common.Hash, error) {
+	return func(ctx context.Context, chainID uint64, req bindings.SolveRequest, reason types.RejectReason) (common.Hash, error) {
+		inbox, ok := inboxContracts.Get(chainID)
 		if !ok {
-			return errors.New("unknown chain")
+			return common.Hash{}, errors.New("unknown chain")
 		}
 
 		backend, err := backends.Backend(chainID)
 		if err != nil {
-			return err
+			return common.Hash{}, err
 		}
 
 		txOpts, err := backend.BindOpts(ctx, solverAddr)
 		if err != nil {
-			return err
+			return common.Hash{}, err
 		}
 
 		tx, err := inbox.Reject(txOpts, req.Id, uint8(reason))
 		if err != nil {
-			return errors.Wrap(err, "reject request")
+			return common.Hash{}, errors.Wrap(err, "reject request")
 		} else if _, err := backend.WaitMined(ctx, tx); err != nil {
-			return errors.Wrap(err, "wait mined")
+			return tx.Hash(), errors.Wrap(err, "wait mined")
 		}
 
-		return nil
+		rejectedTotal.WithLabelValues(
+			network.ChainName(chainID),
+			network.ChainName(req.Call.DestChainId),
+			reason.String(),
+		).Inc()
+
+		inventory.Release(req.Id)
+
+		return tx.Hash(), nil
 	}
 }
 
+// newAcceptor returns a function that accepts requests.
+// The inventory reserved for the request is released if accepting fails.
+// If the request was accepted nonetheless (e.g. waiting for the receipt failed), its Accepted event reserves it again.
 func newAcceptor(
-	inboxContracts map[uint64]*bindings.SolveInbox,
+	inboxContracts *chainContracts[*bindings.SolveInbox],
 	backends ethbackend.Backends,
 	solverAddr common.Address,
-) func(ctx context.Context, chainID uint64, req bindings.SolveRequest) error {
-	return func(ctx context.Context, chainID uint64, req bindings.SolveRequest) error {
-		inbox, ok := inboxContracts[chainID]
+	inventory *inventory,
+) func(ctx context.Context, chainID uint64, req bindings.SolveRequest) (common.Hash, error) {
+	accept := func(ctx context.Context, chainID uint64, req bindings.SolveRequest) (common.Hash, error) {
+		inbox, ok := inboxContracts.Get(chainID)
 		if !ok {
-			return errors.New("unknown chain")
+			return common.Hash{}, errors.New("unknown chain")
 		}
 
 		backend, err := backends.Backend(chainID)
 		if err != nil {
-			return err
+			return common.Hash{}, err
 		}
 
 		txOpts, err := backend.BindOpts(ctx, solverAddr)
 		if err != nil {
-			return err
+			return common.Hash{}, err
 		}
 
 		tx, err := inbox.Accept(txOpts, req.Id)
 		if err != nil {
-			return errors.Wrap(err, "accept request")
+			return common.Hash{}, errors.Wrap(err, "accept request")
 		} else if _, err := backend.WaitMined(ctx, tx); err != nil {
-			return errors.Wrap(err, "wait mined")
+			return tx.Hash(), errors.Wrap(err, "wait mined")
 		}
 
+		return tx.Hash(), nil
+	}
+
+	return func(ctx context.Context, chainID uint64, req bindings.SolveRequest) (common.Hash, error) {
+		txHash, err := accept(ctx, chainID, req)
+		if err != nil {
+			inventory.Release(req.Id)
+		}
+
+		return txHash, err
+	}
+}
+
+// newReserver returns a function that reserves the inventory required to fulfill accepted requests.
+func newReserver(targets targets, inventory *inventory) func(ctx context.Context, chainID uint64, req bindings.SolveRequest) error {
+	return func(_ context.Context, _ uint64, req bindings.SolveRequest) error {
+		target, err := targets.Get(req.Call)
+		if err != nil {
+			return errors.Wrap(err, "get target")
+		}
+
+		prereqs, err := target.TokenPrereqs(req.Call)
+		if err != nil {
+			return errors.Wrap(err, "get token prereqs")
+		}
+
+		inventory.Restore(req.Id, req.Call.DestChainId, requiredAmounts(req.Call, prereqs))
+
 		return nil
 	}
 }
 
-func newIDParser(inboxContracts map[uint64]*bindings.SolveInbox) func(chainID uint64, log types.Log) ([32]byte, error) {
-	return func(chainID uint64, log types.Log) ([32]byte, error) {
-		inbox, ok := inboxContracts[chainID]
+func newIDParser(inboxContracts *chainContracts[*bindings.SolveInbox]) func(chainID uint64, log ethtypes.Log) ([32]byte, error) {
+	return func(chainID uint64, log ethtypes.Log) ([32]byte, error) {
+		inbox, ok := inboxContracts.Get(chainID)
 		if !ok {
 			return [32]byte{}, errors.New("unknown chain")
 		}
@@ -312,9 +386,9 @@ func newIDParser(inboxContracts map[uint64]*bindings.SolveInbox) func(chainID ui
 	}
 }
 
-func newRequestGetter(inboxContracts map[uint64]*bindings.SolveInbox) func(ctx context.Context, chainID uint64, id [32]byte) (bindings.SolveRequest, bool, error) {
+func newRequestGetter(inboxContracts *chainContracts[*bindings.SolveInbox]) func(ctx context.Context, chainID uint64, id [32]byte) (bindings.SolveRequest, bool, error) {
 	return func(ctx context.Context, chainID uint64, id [32]byte) (bindings.SolveRequest, bool, error) {
-		inbox, ok := inboxContracts[chainID]
+		inbox, ok := inboxContracts.Get(chainID)
 		if !ok {
 			return bindings.SolveRequest{}, false, errors.New("unknown chain")
 		}
@@ -3,10 +3,13 @@ package app
 import (
 	"context"
 
+	"github.com/omni-network/omni/contracts/bindings"
+	"github.com/omni-network/omni/lib/cast"
 	"github.com/omni-network/omni/lib/errors"
 	"github.com/omni-network/omni/lib/log"
 	"github.com/omni-network/omni/lib/xchain"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 )
 
@@ -14,6 +17,17 @@ import (
 // all inbox contract events and driving request lifecycle.
 func newEventProcessor(deps procDeps, chainID uint64) xchain.EventLogsCallback {
 	return func(ctx context.Context, height uint64, elogs []types.Log) error {
+		if rewind, ok, err := deps.CheckReorg(ctx, chainID, height, elogs); err != nil {
+			return errors.Wrap(err, "check reorg")
+		} else if ok {
+			log.Warn(ctx, "Source chain reorg detected, rewinding cursor", nil, "height", height, "rewind", rewind)
+			if err := deps.SetCursor(ctx, chainID, rewind); err != nil {
+				return errors.Wrap(err, "rewind cursor")
+			}
+
+			return errors.Wrap(errReorg, "rewound cursor", "height", height, "rewind", rewind)
+		}
+
 		for _, elog := range elogs {
 			event, ok := eventsByTopic[elog.Topics[0]]
 			if !ok {
@@ -32,40 +46,120 @@ func newEventProcessor(deps procDeps, chainID uint64) xchain.EventLogsCallback {
 			req, _, err := deps.GetRequest(ctx, chainID, reqID)
 			if err != nil {
 				return errors.Wrap(err, "current status")
-			} else if event.Status != req.Status {
-				// TODO(corver): Detect unexpected on-chain status.
-				log.Info(ctx, "Ignoring mismatching old event", "actual", statusString(req.Status))
-				continue
 			}
 
-			switch event.Status {
-			case statusPending:
-				if reason, reject, err := deps.ShouldReject(ctx, chainID, req); err != nil {
-					return errors.Wrap(err, "should reject")
-				} else if reject {
-					if err := deps.Reject(ctx, chainID, req, reason); err != nil {
-						return errors.Wrap(err, "reject request")
-					}
-				} else {
-					if err := deps.Accept(ctx, chainID, req); err != nil {
-						return errors.Wrap(err, "accept request")
-					}
-				}
-			case statusAccepted:
-				if err := deps.Fulfill(ctx, chainID, req); err != nil {
-					return errors.Wrap(err, "fulfill request")
-				}
-			case statusFulfilled:
-				if err := deps.Claim(ctx, chainID, req); err != nil {
-					return errors.Wrap(err, "claim request")
-				}
-			case statusRejected, statusReverted, statusClaimed:
-			// Ignore for now
-			default:
-				return errors.New("unknown status [BUG]")
+			if err := processRequest(ctx, deps, chainID, height, event.Status, req); err != nil {
+				return err
 			}
 		}
 
 		return deps.SetCursor(ctx, chainID, height)
 	}
 }
+
+// resumeInFlight processes all in-flight requests recorded in the DB using their current on-chain status.
+// This resumes work interrupted by a restart, independently of the event stream cursor.
+// Inventory of accepted requests is reserved first, so it isn't committed to pending requests again.
+func resumeInFlight(ctx context.Context, deps procDeps, chainID uint64) error {
+	inFlight, err := deps.InFlight(ctx, chainID)
+	if err != nil {
+		return errors.Wrap(err, "get in-flight requests")
+	}
+
+	type resumable struct {
+		Height uint64
+		Req    bindings.SolveRequest
+	}
+
+	var resume []resumable
+	for _, request := range inFlight {
+		reqID, err := cast.Array32(request.GetReqId())
+		if err != nil {
+			return err
+		}
+
+		req, _, err := deps.GetRequest(ctx, chainID, reqID)
+		if err != nil {
+			return errors.Wrap(err, "current status")
+		}
+
+		if req.Status == statusAccepted {
+			if err := deps.Reserve(ctx, chainID, req); err != nil {
+				return errors.Wrap(err, "reserve inventory", "req_id", fmtReqID(reqID))
+			}
+		}
+
+		resume = append(resume, resumable{Height: request.GetHeight(), Req: req})
+	}
+
+	for _, r := range resume {
+		req := r.Req
+		ctx := log.WithCtx(ctx, "status", statusString(req.Status), "req_id", fmtReqID(req.Id))
+
+		log.Debug(ctx, "Resuming in-flight request")
+
+		if err := processRequest(ctx, deps, chainID, r.Height, req.Status, req); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// processRequest drives the lifecycle of the request given the status of the processed event,
+// recording the result in the DB.
+func processRequest(ctx context.Context, deps procDeps, chainID uint64, height uint64, eventStatus uint8, req bindings.SolveRequest) error {
+	if eventStatus != req.Status {
+		log.Info(ctx, "Ignoring mismatching old event", "actual", statusString(req.Status))
+		return deps.Record(ctx, chainID, height, eventStatus, req, actionResult{})
+	}
+
+	var res actionResult
+	switch eventStatus {
+	case statusPending:
+		if reason, reject, err := deps.ShouldReject(ctx, chainID, req); err != nil {
+			return errors.Wrap(err, "should reject")
+		} else if reject {
+			res = actionResult{Action: actionReject, Reason: reason}
+			res.TxHash, res.Err = deps.Reject(ctx, chainID, req, reason)
+		} else {
+			res = actionResult{Action: actionAccept}
+			res.TxHash, res.Err = deps.Accept(ctx, chainID, req)
+		}
+	case statusAccepted:
+		// Ensure the inventory is reserved, even if accepting it appeared to fail.
+		if err := deps.Reserve(ctx, chainID, req); err != nil {
+			return errors.Wrap(err, "reserve inventory")
+		}
+
+		if ok, err := deps.DeferFulfill(ctx, chainID, height, req); err != nil {
+			return errors.Wrap(err, "defer fulfill")
+		} else if ok {
+			log.Info(ctx, "Deferring fulfill until source chain finalized", "height", height)
+			return deps.Record(ctx, chainID, height, eventStatus, req, actionResult{})
+		}
+
+		res = actionResult{Action: actionFulfill}
+		res.TxHash, res.Err = deps.Fulfill(ctx, chainID, req)
+	case statusFulfilled:
+		res = actionResult{Action: actionClaim}
+		res.TxHash, res.Err = deps.Claim(ctx, chainID, req)
+		if res.Err == nil && res.TxHash == (common.Hash{}) {
+			res = actionResult{} // Claim queued, the action is recorded once submitted.
+		}
+	case statusRejected, statusReverted, statusClaimed:
+	// Ignore for now
+	default:
+		return errors.New("unknown status [BUG]")
+	}
+
+	if err := deps.Record(ctx, chainID, height, eventStatus, req, res); err != nil {
+		return errors.Wrap(err, "record request")
+	}
+
+	if res.Err != nil {
+		return errors.Wrap(res.Err, res.Action.String()+" request")
+	}
+
+	return nil
+}
@@ -6,9 +6,10 @@ import (
 
 	"github.com/omni-network/omni/contracts/bindings"
 	"github.com/omni-network/omni/lib/tutil"
+	"github.com/omni-network/omni/solver/types"
 
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/core/types"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
 	"github.com/stretchr/testify/require"
 )
@@ -28,7 +29,8 @@ func TestEventProcessor(t *testing.T) {
 		name         string
 		event        common.Hash
 		getStatus    uint8
-		rejectReason rejectReason
+		rejectReason types.RejectReason
+		deferFulfill bool
 		expect       string
 	}{
 		{
@@ -51,6 +53,13 @@ func TestEventProcessor(t *testing.T) {
 			getStatus: statusAccepted,
 			expect:    fulfill,
 		},
+		{
+			name:         "defer fulfill",
+			event:        topicAccepted,
+			getStatus:    statusAccepted,
+			deferFulfill: true,
+			expect:       ignored,
+		},
 		{
 			name:      "claim",
 			event:     topicFulfilled,
@@ -95,10 +104,12 @@ func TestEventProcessor(t *testing.T) {
 			const chainID = 321
 			const height = 123
 			reqID := tutil.RandomHash()
+			txHash := tutil.RandomHash()
 			actual := ignored
+			var recorded bool
 
 			deps := procDeps{
-				ParseID: func(_ uint64, log types.Log) ([32]byte, error) {
+				ParseID: func(_ uint64, log ethtypes.Log) ([32]byte, error) {
 					return log.Topics[1], nil // Return second topic as req ID
 				},
 				GetRequest: func(ctx context.Context, _ uint64, id [32]byte) (bindings.SolveRequest, bool, error) {
@@ -107,36 +118,65 @@ func TestEventProcessor(t *testing.T) {
 						Status: test.getStatus,
 					}, true, nil
 				},
-				ShouldReject: func(ctx context.Context, _ uint64, req bindings.SolveRequest) (rejectReason, bool, error) {
+				CheckReorg: func(ctx context.Context, _ uint64, h uint64, _ []ethtypes.Log) (uint64, bool, error) {
+					require.EqualValues(t, height, h)
+
+					return 0, false, nil
+				},
+				Reserve: func(ctx context.Context, _ uint64, req bindings.SolveRequest) error {
+					require.Equal(t, statusAccepted, req.Status)
+					require.EqualValues(t, reqID, req.Id)
+
+					return nil
+				},
+				DeferFulfill: func(ctx context.Context, _ uint64, h uint64, req bindings.SolveRequest) (bool, error) {
+					require.EqualValues(t, height, h)
+					require.Equal(t, test.getStatus, req.Status)
+
+					return test.deferFulfill, nil
+				},
+				ShouldReject: func(ctx context.Context, _ uint64, req bindings.SolveRequest) (types.RejectReason, bool, error) {
 					return test.rejectReason, test.rejectReason != 0, nil
 				},
-				Accept: func(ctx context.Context, _ uint64, req bindings.SolveRequest) error {
+				Accept: func(ctx context.Context, _ uint64, req bindings.SolveRequest) (common.Hash, error) {
 					actual = accept
 					require.Equal(t, test.getStatus, req.Status)
 					require.EqualValues(t, reqID, req.Id)
 
-					return nil
+					return txHash, nil
 				},
-				Reject: func(ctx context.Context, _ uint64, req bindings.SolveRequest, reason rejectReason) error {
+				Reject: func(ctx context.Context, _ uint64, req bindings.SolveRequest, reason types.RejectReason) (common.Hash, error) {
 					actual = reject
 					require.Equal(t, test.getStatus, req.Status)
 					require.Equal(t, test.rejectReason, reason)
 					require.EqualValues(t, reqID, req.Id)
 
-					return nil
+					return txHash, nil
 				},
-				Fulfill: func(ctx context.Context, _ uint64, req bindings.SolveRequest) error {
+				Fulfill: func(ctx context.Context, _ uint64, req bindings.SolveRequest) (common.Hash, error) {
 					actual = fulfill
 					require.Equal(t, test.getStatus, req.Status)
 					require.EqualValues(t, reqID, req.Id)
 
-					return nil
+					return txHash, nil
 				},
-				Claim: func(ctx context.Context, _ uint64, req bindings.SolveRequest) error {
+				Claim: func(ctx context.Context, _ uint64, req bindings.SolveRequest) (common.Hash, error) {
 					actual = claim
 					require.Equal(t, test.getStatus, req.Status)
 					require.EqualValues(t, reqID, req.Id)
 
+					return txHash, nil
+				},
+				Record: func(ctx context.Context, c uint64, h uint64, eventStatus uint8, req bindings.SolveRequest, res actionResult) error {
+					require.EqualValues(t, chainID, c)
+					require.EqualValues(t, height, h)
+					require.Equal(t, test.getStatus, req.Status)
+					require.Equal(t, test.expect, actionName(res.Action))
+					if res.Action != actionNone {
+						require.Equal(t, txHash, res.TxHash)
+					}
+					recorded = true
+
 					return nil
 				},
 				SetCursor: func(ctx context.Context, c uint64, h uint64) error {
@@ -149,9 +189,76 @@ func TestEventProcessor(t *testing.T) {
 
 			processor := newEventProcessor(deps, chainID)
 
-			err := processor(context.Background(), height, []types.Log{{Topics: []common.Hash{test.event, reqID}}})
+			err := processor(context.Background(), height, []ethtypes.Log{{Topics: []common.Hash{test.event, reqID}}})
 			require.NoError(t, err)
 			require.Equal(t, test.expect, actual)
+			require.True(t, recorded)
 		})
 	}
 }
+
+func TestResumeInFlight(t *testing.T) {
+	t.Parallel()
+
+	const chainID = 321
+	pending, accepted := tutil.RandomHash(), tutil.RandomHash()
+	statuses := map[[32]byte]uint8{
+		pending:  statusPending,
+		accepted: statusAccepted,
+	}
+
+	var actions []string
+	deps := procDeps{
+		InFlight: func(context.Context, uint64) ([]*Request, error) {
+			// Pending requests are returned first.
+			return []*Request{
+				{SrcChainId: chainID, ReqId: pending[:], Status: uint32(statusPending)},
+				{SrcChainId: chainID, ReqId: accepted[:], Status: uint32(statusAccepted)},
+			}, nil
+		},
+		GetRequest: func(_ context.Context, _ uint64, id [32]byte) (bindings.SolveRequest, bool, error) {
+			return bindings.SolveRequest{Id: id, Status: statuses[id]}, true, nil
+		},
+		Reserve: func(_ context.Context, _ uint64, req bindings.SolveRequest) error {
+			require.EqualValues(t, accepted, req.Id)
+			actions = append(actions, "reserve")
+
+			return nil
+		},
+		ShouldReject: func(context.Context, uint64, bindings.SolveRequest) (types.RejectReason, bool, error) {
+			return types.RejectNone, false, nil
+		},
+		DeferFulfill: func(context.Context, uint64, uint64, bindings.SolveRequest) (bool, error) {
+			return false, nil
+		},
+		Accept: func(_ context.Context, _ uint64, req bindings.SolveRequest) (common.Hash, error) {
+			require.EqualValues(t, pending, req.Id)
+			actions = append(actions, accept)
+
+			return tutil.RandomHash(), nil
+		},
+		Fulfill: func(_ context.Context, _ uint64, req bindings.SolveRequest) (common.Hash, error) {
+			require.EqualValues(t, accepted, req.Id)
+			actions = append(actions, fulfill)
+
+			return tutil.RandomHash(), nil
+		},
+		Record: func(context.Context, uint64, uint64, uint8, bindings.SolveRequest, actionResult) error {
+			return nil
+		},
+	}
+
+	require.NoError(t, resumeInFlight(context.Background(), deps, chainID))
+
+	// Accepted requests are reserved before pending requests are accepted.
+	require.Equal(t, []string{"reserve", accept, "reserve", fulfill}, actions)
+}
+
+// actionName returns the action name as used by TestEventProcessor expectations.
+func actionName(a action) string {
+	if a == actionNone {
+		return ignored
+	}
+
+	return a.String()
+}
@@ -0,0 +1,419 @@
+package app
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/omni-network/omni/contracts/bindings"
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/ethclient/ethbackend"
+	"github.com/omni-network/omni/lib/evmchain"
+	"github.com/omni-network/omni/lib/log"
+	"github.com/omni-network/omni/lib/pnl"
+	"github.com/omni-network/omni/lib/tokens"
+	"github.com/omni-network/omni/lib/tokens/coingecko"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+const (
+	// fulfillGasEstimate is the estimated gas used by a fulfill transaction.
+	fulfillGasEstimate = 500_000
+
+	priceCacheEvictInterval = time.Minute
+)
+
+// newTokenPricer creates a new cached pricer with priceCacheEvictInterval.
+func newTokenPricer(ctx context.Context) *tokens.CachedPricer {
+	pricer := tokens.NewCachedPricer(coingecko.New())
+
+	// use cached pricer avoid spamming coingecko public api
+	go pricer.ClearCacheForever(ctx, priceCacheEvictInterval)
+
+	return pricer
+}
+
+// chainToken identifies a token on a chain.
+type chainToken struct {
+	ChainID uint64
+	Address common.Address
+}
+
+// pricedTokens maps ERC20 chain tokens to pricer tokens.
+type pricedTokens map[chainToken]tokens.Token
+
+// Token returns the pricer token of the chain token, the zero address denotes the native token.
+func (p pricedTokens) Token(chainID uint64, addr common.Address) (tokens.Token, bool) {
+	if addr == nativeToken {
+		meta, ok := evmchain.MetadataByID(chainID)
+		return meta.NativeToken, ok
+	}
+
+	t, ok := p[chainToken{ChainID: chainID, Address: addr}]
+
+	return t, ok
+}
+
+// profit is the estimated USD value of fulfilling a request.
+type profit struct {
+	IncomeUSD  float64 // Value of the deposits
+	ExpenseUSD float64 // Value of the token outlay, call value, fulfill fee and gas
+}
+
+// Margin returns the profit margin as a fraction of expenses.
+func (p profit) Margin() float64 {
+	if p.ExpenseUSD == 0 {
+		return 0
+	}
+
+	return (p.IncomeUSD - p.ExpenseUSD) / p.ExpenseUSD
+}
+
+// errUnpricedToken is returned when a token cannot be priced.
+var errUnpricedToken = errors.NewSentinel("unpriced token")
+
+// profitEstimator estimates the profit of fulfilling requests.
+type profitEstimator struct {
+	pricer     tokens.Pricer
+	tokens     pricedTokens
+	gasPrice   func(ctx context.Context, chainID uint64) (*big.Int, error)
+	fulfillFee func(ctx context.Context, srcChainID, destChainID uint64) (*big.Int, error)
+}
+
+// newProfitEstimator returns a profit estimator querying gas prices and fulfill fees via the backends and outbox contracts.
+func newProfitEstimator(
+	pricer tokens.Pricer,
+	priced pricedTokens,
+	backends ethbackend.Backends,
+	outboxContracts *chainContracts[*bindings.SolveOutbox],
+) profitEstimator {
+	return profitEstimator{
+		pricer: pricer,
+		tokens: priced,
+		gasPrice: func(ctx context.Context, chainID uint64) (*big.Int, error) {
+			backend, err := backends.Backend(chainID)
+			if err != nil {
+				return nil, err
+			}
+
+			price, err := backend.SuggestGasPrice(ctx)
+			if err != nil {
+				return nil, errors.Wrap(err, "suggest gas price")
+			}
+
+			return price, nil
+		},
+		fulfillFee: func(ctx context.Context, srcChainID, destChainID uint64) (*big.Int, error) {
+			outbox, ok := outboxContracts.Get(destChainID)
+			if !ok {
+				return nil, errors.New("unknown chain")
+			}
+
+			fee, err := outbox.FulfillFee(&bind.CallOpts{Context: ctx}, srcChainID)
+			if err != nil {
+				return nil, errors.Wrap(err, "get fulfill fee")
+			}
+
+			return fee, nil
+		},
+	}
+}
+
+// Estimate returns the estimated profit of fulfilling the request with the given token prerequisites.
+func (e profitEstimator) Estimate(ctx context.Context, srcChainID uint64, req bindings.SolveRequest, prereqs []bindings.SolveTokenPrereq) (profit, error) {
+	income, err := e.usdValue(ctx, srcChainID, depositAmounts(req.Deposits))
+	if err != nil {
+		return profit{}, errors.Wrap(err, "value deposits")
+	}
+
+	expense, err := e.Expense(ctx, srcChainID, req.Call, prereqs)
+	if err != nil {
+		return profit{}, err
+	}
+
+	return profit{IncomeUSD: income, ExpenseUSD: expense}, nil
+}
+
+// Expense returns the estimated USD expense of fulfilling the call with the given token prerequisites.
+// It includes the token outlay, call value, fulfill fee and gas.
+func (e profitEstimator) Expense(ctx context.Context, srcChainID uint64, call bindings.SolveCall, prereqs []bindings.SolveTokenPrereq) (float64, error) {
+	destChainID := call.DestChainId
+
+	gasPrice, err := e.gasPrice(ctx, destChainID)
+	if err != nil {
+		return 0, err
+	}
+
+	fee, err := e.fulfillFee(ctx, srcChainID, destChainID)
+	if err != nil {
+		return 0, err
+	}
+
+	gas := new(big.Int).Mul(gasPrice, big.NewInt(fulfillGasEstimate))
+	outlay := append(
+		requiredAmounts(call, prereqs),
+		tokenAmount{Token: nativeToken, Amount: gas},
+		tokenAmount{Token: nativeToken, Amount: fee},
+	)
+
+	expense, err := e.usdValue(ctx, destChainID, outlay)
+	if err != nil {
+		return 0, errors.Wrap(err, "value outlay")
+	}
+
+	return expense, nil
+}
+
+// Amount returns the amount (in smallest units) of the token on the chain worth the USD value, rounding up.
+// The zero address denotes the native token.
+func (e profitEstimator) Amount(ctx context.Context, chainID uint64, token common.Address, usd float64) (*big.Int, error) {
+	symbol, ok := e.tokens.Token(chainID, token)
+	if !ok {
+		return nil, errors.Wrap(errUnpricedToken, "lookup token", "chain", chainID, "token", token)
+	}
+
+	prices, err := e.pricer.Price(ctx, symbol)
+	if err != nil {
+		return nil, errors.Wrap(err, "get price")
+	}
+
+	price := prices[symbol]
+	if price <= 0 {
+		return nil, errors.New("invalid token price", "token", symbol, "price", price)
+	}
+
+	return fromUnitsF64(usd/price, symbol.Decimals()), nil
+}
+
+// usdValue returns the total USD value of the token amounts on the chain.
+func (e profitEstimator) usdValue(ctx context.Context, chainID uint64, amounts []tokenAmount) (float64, error) {
+	amounts = mergeAmounts(amounts)
+	if len(amounts) == 0 {
+		return 0, nil
+	}
+
+	symbols := make([]tokens.Token, 0, len(amounts))
+	for _, amt := range amounts {
+		symbol, ok := e.tokens.Token(chainID, amt.Token)
+		if !ok {
+			return 0, errors.Wrap(errUnpricedToken, "lookup token", "chain", chainID, "token", amt.Token)
+		}
+		symbols = append(symbols, symbol)
+	}
+
+	prices, err := e.pricer.Price(ctx, symbols...)
+	if err != nil {
+		return 0, errors.Wrap(err, "get prices")
+	}
+
+	var resp float64
+	for i, amt := range amounts {
+		resp += toUnitsF64(amt.Amount, symbols[i].Decimals()) * prices[symbols[i]]
+	}
+
+	return resp, nil
+}
+
+// logPnL logs the income and expenses of a fulfilled request, warning on error.
+func (e profitEstimator) logPnL(
+	ctx context.Context,
+	srcChainID uint64,
+	req bindings.SolveRequest,
+	prereqs []bindings.SolveTokenPrereq,
+	tx *ethtypes.Transaction,
+	rec *ethtypes.Receipt,
+) {
+	if err := e.logPnLE(ctx, srcChainID, req, prereqs, tx, rec); err != nil {
+		log.Warn(ctx, "Failed to log pnl", err)
+	}
+}
+
+// logPnLE logs the income and expenses of a fulfilled request, returning any errors.
+func (e profitEstimator) logPnLE(
+	ctx context.Context,
+	srcChainID uint64,
+	req bindings.SolveRequest,
+	prereqs []bindings.SolveTokenPrereq,
+	tx *ethtypes.Transaction,
+	rec *ethtypes.Receipt,
+) error {
+	destChainID := req.Call.DestChainId
+
+	src, ok := evmchain.MetadataByID(srcChainID)
+	if !ok {
+		return errors.New("unknown source chain ID")
+	}
+
+	dest, ok := evmchain.MetadataByID(destChainID)
+	if !ok {
+		return errors.New("unknown destination chain ID")
+	}
+
+	// Spend includes gas and tx value (fulfill fee and call value)
+	spend := new(big.Int).Mul(rec.EffectiveGasPrice, new(big.Int).SetUint64(rec.GasUsed))
+	spend.Add(spend, tx.Value())
+
+	spendUSD, err := e.usdValue(ctx, destChainID, []tokenAmount{{Token: nativeToken, Amount: spend}})
+	if err != nil {
+		return errors.Wrap(err, "value spend")
+	}
+
+	outlayUSD, err := e.usdValue(ctx, destChainID, requiredAmounts(bindings.SolveCall{}, prereqs))
+	if err != nil {
+		return errors.Wrap(err, "value outlay")
+	}
+
+	depositsUSD, err := e.usdValue(ctx, srcChainID, depositAmounts(req.Deposits))
+	if err != nil {
+		return errors.Wrap(err, "value deposits")
+	}
+
+	md := map[string]any{
+		"tx":       tx.Hash().Hex(),
+		"gas_used": rec.GasUsed,
+		"src":      src.Name,
+	}
+
+	id := fmtReqID(req.Id)
+
+	pnl.Log(ctx,
+		pnl.LogP{
+			Type: pnl.Expense, AmountGwei: toGweiF64(spend), Currency: pnl.Currency(dest.NativeToken),
+			Category: "gas", Subcategory: "fulfill",
+			Chain: dest.Name, ID: id, Metadata: md,
+		},
+		pnl.LogP{
+			Type: pnl.Expense, AmountGwei: spendUSD * 1e9, Currency: pnl.USD,
+			Category: "gas", Subcategory: "fulfill",
+			Chain: dest.Name, ID: id, Metadata: md,
+		},
+		pnl.LogP{
+			Type: pnl.Expense, AmountGwei: outlayUSD * 1e9, Currency: pnl.USD,
+			Category: "inventory", Subcategory: "fulfill",
+			Chain: dest.Name, ID: id, Metadata: md,
+		},
+		pnl.LogP{
+			Type: pnl.Income, AmountGwei: depositsUSD * 1e9, Currency: pnl.USD,
+			Category: "deposits", Subcategory: "request",
+			Chain: src.Name, ID: id, Metadata: md,
+		},
+	)
+
+	return nil
+}
+
+// logClaimPnL logs the claim gas expense and the claimed deposits of a request, warning on error.
+func (e profitEstimator) logClaimPnL(
+	ctx context.Context,
+	srcChainID uint64,
+	req bindings.SolveRequest,
+	to common.Address,
+	tx *ethtypes.Transaction,
+	rec *ethtypes.Receipt,
+) {
+	if err := e.logClaimPnLE(ctx, srcChainID, req, to, tx, rec); err != nil {
+		log.Warn(ctx, "Failed to log claim pnl", err)
+	}
+}
+
+// logClaimPnLE logs the claim gas expense and the claimed deposits of a request, returning any errors.
+// Claimed deposits are logged in token units, allowing the beneficiary treasury to reconcile balances.
+func (e profitEstimator) logClaimPnLE(
+	ctx context.Context,
+	srcChainID uint64,
+	req bindings.SolveRequest,
+	to common.Address,
+	tx *ethtypes.Transaction,
+	rec *ethtypes.Receipt,
+) error {
+	src, ok := evmchain.MetadataByID(srcChainID)
+	if !ok {
+		return errors.New("unknown source chain ID")
+	}
+
+	gas := new(big.Int).Mul(rec.EffectiveGasPrice, new(big.Int).SetUint64(rec.GasUsed))
+
+	gasUSD, err := e.usdValue(ctx, srcChainID, []tokenAmount{{Token: nativeToken, Amount: gas}})
+	if err != nil {
+		return errors.Wrap(err, "value gas")
+	}
+
+	md := map[string]any{
+		"tx":       tx.Hash().Hex(),
+		"gas_used": rec.GasUsed,
+		"to":       to.Hex(),
+	}
+
+	id := fmtReqID(req.Id)
+
+	logs := []pnl.LogP{
+		{
+			Type: pnl.Expense, AmountGwei: toGweiF64(gas), Currency: pnl.Currency(src.NativeToken),
+			Category: "gas", Subcategory: "claim",
+			Chain: src.Name, ID: id, Metadata: md,
+		},
+		{
+			Type: pnl.Expense, AmountGwei: gasUSD * 1e9, Currency: pnl.USD,
+			Category: "gas", Subcategory: "claim",
+			Chain: src.Name, ID: id, Metadata: md,
+		},
+	}
+
+	for _, amt := range mergeAmounts(depositAmounts(req.Deposits)) {
+		currency := pnl.Currency(amt.Token.Hex())
+		amountGwei := toGweiF64(amt.Amount)
+		if token, ok := e.tokens.Token(srcChainID, amt.Token); ok {
+			currency = pnl.Currency(token)
+			amountGwei = toUnitsF64(amt.Amount, token.Decimals()) * 1e9
+		}
+
+		logs = append(logs, pnl.LogP{
+			Type: pnl.Income, AmountGwei: amountGwei, Currency: currency,
+			Category: "claim", Subcategory: "deposit",
+			Chain: src.Name, ID: id, Metadata: md,
+		})
+	}
+
+	pnl.Log(ctx, logs...)
+
+	return nil
+}
+
+// depositAmounts returns the deposits as token amounts.
+func depositAmounts(deposits []bindings.SolveDeposit) []tokenAmount {
+	resp := make([]tokenAmount, 0, len(deposits))
+	for _, deposit := range deposits {
+		token := deposit.Token
+		if deposit.IsNative {
+			token = nativeToken
+		}
+		resp = append(resp, tokenAmount{Token: token, Amount: deposit.Amount})
+	}
+
+	return resp
+}
+
+// toUnitsF64 converts a big.Int amount of smallest token units to whole tokens float64 given the token decimals.
+func toUnitsF64(b *big.Int, decimals uint) float64 {
+	f, _ := new(big.Float).Quo(new(big.Float).SetInt(b), unitScale(decimals)).Float64()
+	return f
+}
+
+// fromUnitsF64 converts a whole tokens float64 amount to a big.Int amount of smallest token units
+// given the token decimals, rounding up.
+func fromUnitsF64(f float64, decimals uint) *big.Int {
+	resp, acc := new(big.Float).Mul(big.NewFloat(f), unitScale(decimals)).Int(nil)
+	if acc == big.Below {
+		resp.Add(resp, big.NewInt(1))
+	}
+
+	return resp
+}
+
+// unitScale returns 10^decimals.
+func unitScale(decimals uint) *big.Float {
+	return new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil))
+}
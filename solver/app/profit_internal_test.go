@@ -0,0 +1,90 @@
+package app
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/omni-network/omni/contracts/bindings"
+	"github.com/omni-network/omni/lib/evmchain"
+	"github.com/omni-network/omni/lib/tokens"
+	"github.com/omni-network/omni/lib/tutil"
+
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProfitEstimator(t *testing.T) {
+	t.Parallel()
+
+	const (
+		srcChain  = evmchain.IDMockL2
+		destChain = evmchain.IDMockL1
+	)
+
+	srcToken := tutil.RandomAddress()
+	destToken := tutil.RandomAddress()
+	usdcToken := tutil.RandomAddress()
+
+	estimator := profitEstimator{
+		pricer: tokens.NewMockPricer(map[tokens.Token]float64{
+			tokens.ETH:    1000,
+			tokens.WSTETH: 1200,
+			tokens.USDC:   1,
+		}),
+		tokens: pricedTokens{
+			{ChainID: srcChain, Address: srcToken}:   tokens.WSTETH,
+			{ChainID: destChain, Address: destToken}: tokens.WSTETH,
+			{ChainID: srcChain, Address: usdcToken}:  tokens.USDC,
+		},
+		gasPrice: func(context.Context, uint64) (*big.Int, error) {
+			return big.NewInt(params.GWei), nil // 500k gas * 1 gwei = 0.0005 ETH
+		},
+		fulfillFee: func(context.Context, uint64, uint64) (*big.Int, error) {
+			return big.NewInt(params.GWei * 500_000), nil // 0.0005 ETH
+		},
+	}
+
+	ether := func(f float64) *big.Int {
+		b, _ := new(big.Float).Mul(big.NewFloat(f), big.NewFloat(params.Ether)).Int(nil)
+		return b
+	}
+
+	req := bindings.SolveRequest{
+		Call: bindings.SolveCall{DestChainId: destChain},
+		Deposits: []bindings.SolveDeposit{
+			{Token: srcToken, Amount: ether(1)},
+			{IsNative: true, Amount: ether(0.01)},
+		},
+	}
+	prereqs := []bindings.SolveTokenPrereq{{Token: destToken, Amount: ether(1)}}
+
+	p, err := estimator.Estimate(context.Background(), srcChain, req, prereqs)
+	require.NoError(t, err)
+	require.InDelta(t, 1200+10, p.IncomeUSD, 1e-6)
+	require.InDelta(t, 1200+0.5+0.5, p.ExpenseUSD, 1e-6)
+	require.Positive(t, p.Margin())
+
+	// Without native deposit, the request is not profitable
+	req.Deposits = req.Deposits[:1]
+	p, err = estimator.Estimate(context.Background(), srcChain, req, prereqs)
+	require.NoError(t, err)
+	require.Negative(t, p.Margin())
+
+	// Token amounts are valued using their decimals
+	req.Deposits = []bindings.SolveDeposit{{Token: usdcToken, Amount: big.NewInt(1500_000_000)}} // 1500 USDC
+	p, err = estimator.Estimate(context.Background(), srcChain, req, prereqs)
+	require.NoError(t, err)
+	require.InDelta(t, 1500, p.IncomeUSD, 1e-6)
+	require.Positive(t, p.Margin())
+
+	amount, err := estimator.Amount(context.Background(), srcChain, usdcToken, 1500)
+	require.NoError(t, err)
+	require.EqualValues(t, 1500_000_000, amount.Uint64())
+
+	// Unknown tokens cannot be priced
+	req.Deposits = []bindings.SolveDeposit{{Token: tutil.RandomAddress(), Amount: ether(1)}}
+	_, err = estimator.Estimate(context.Background(), srcChain, req, prereqs)
+	require.ErrorIs(t, err, errUnpricedToken)
+}
@@ -2,40 +2,111 @@ package app
 
 import (
 	"context"
+	"time"
 
 	"github.com/omni-network/omni/contracts/bindings"
+	"github.com/omni-network/omni/lib/errors"
 	"github.com/omni-network/omni/lib/log"
-	"github.com/omni-network/omni/lib/netconf"
+	"github.com/omni-network/omni/solver/types"
 )
 
-//go:generate stringer -type=rejectReason -trimprefix=reject
-type rejectReason uint8
+// maxRequestAge is the maximum age of a pending request before it is rejected as expired.
+// This prevents accepting stale requests when catching up after downtime.
+const maxRequestAge = time.Hour
 
-const (
-	rejectNone                  rejectReason = 0
-	rejectDestCallReverts       rejectReason = 1
-	rejectInsufficientFee       rejectReason = 2
-	rejectInsufficientInventory rejectReason = 3
-	rejectNoTarget              rejectReason = 4
-)
+// errChainNotReady is returned when the destination chain isn't initialized yet.
+// Requests are not rejected for this, but retried once the chain is ready.
+var errChainNotReady = errors.NewSentinel("destination chain not ready")
+
+// checkFunc checks if a request should be rejected. It returns the reject reason and an error describing it,
+// or RejectNone and nil if the request can be fulfilled. An error with RejectNone indicates the check failed.
+// If reserve is true, the required inventory is reserved for requests that can be fulfilled.
+type checkFunc func(ctx context.Context, srcChainID uint64, req bindings.SolveRequest, reserve bool) (types.RejectReason, error)
+
+// newChecker returns a checkFunc for the given targets.
+// The isAvailable function returns true if the solver can fulfill requests on the given chain,
+// i.e., the chain is initialized. Requests to chains not initialized yet fail with errChainNotReady.
+// Requests with an estimated profit margin below minMargin are rejected, a negative minMargin disables this check.
+func newChecker(
+	targets targets,
+	isAvailable func(chainID uint64) bool,
+	inventory *inventory,
+	profits profitEstimator,
+	minMargin float64,
+) checkFunc {
+	return func(ctx context.Context, srcChainID uint64, req bindings.SolveRequest, reserve bool) (types.RejectReason, error) {
+		if req.UpdatedAt != nil && time.Since(time.Unix(req.UpdatedAt.Int64(), 0)) > maxRequestAge {
+			return types.RejectExpired, errors.New("request expired", "updated_at", req.UpdatedAt)
+		}
+
+		target, err := targets.Get(req.Call)
+		if err != nil {
+			return types.RejectNoTarget, err
+		}
+
+		if !isAvailable(req.Call.DestChainId) {
+			return types.RejectNone, errors.Wrap(errChainNotReady, "check", "dest", req.Call.DestChainId)
+		}
+
+		if reason, err := target.Verify(srcChainID, req.Call, req.Deposits); err != nil && reason == types.RejectNone {
+			return types.RejectNone, errors.Wrap(err, "verify without reject reason [BUG]")
+		} else if err != nil {
+			return reason, err
+		}
 
-// newShouldRejector returns as ShouldReject function for the given network.
-func newShouldRejector(network netconf.ID) func(ctx context.Context, chainID uint64, req bindings.SolveRequest) (rejectReason, bool, error) {
-	return func(ctx context.Context, srcChainID uint64, req bindings.SolveRequest) (rejectReason, bool, error) {
-		reject := func(reason rejectReason, err error) (rejectReason, bool, error) {
-			log.Warn(ctx, "Rejecting request", err, "reason", reason, "call", req.Call)
-			return reason, true, err
+		prereqs, err := target.TokenPrereqs(req.Call)
+		if err != nil {
+			return types.RejectInvalidCall, errors.Wrap(err, "token prereqs")
 		}
 
-		target, err := getTarget(network, req.Call)
+		if minMargin >= 0 {
+			p, err := profits.Estimate(ctx, srcChainID, req, prereqs)
+			if errors.Is(err, errUnpricedToken) {
+				return types.RejectUnsupportedToken, err
+			} else if err != nil {
+				return types.RejectNone, errors.Wrap(err, "estimate profit")
+			} else if p.Margin() < minMargin {
+				return types.RejectInsufficientFee, errors.New("insufficient profit margin",
+					"income_usd", p.IncomeUSD,
+					"expense_usd", p.ExpenseUSD,
+					"margin", p.Margin(),
+				)
+			}
+		}
+
+		destChainID := req.Call.DestChainId
+		amounts := requiredAmounts(req.Call, prereqs)
+
+		var ok bool
+		if reserve {
+			ok, err = inventory.Reserve(ctx, req.Id, destChainID, amounts)
+		} else {
+			ok, err = inventory.Sufficient(ctx, destChainID, amounts)
+		}
 		if err != nil {
-			return reject(rejectNoTarget, err)
+			return types.RejectNone, errors.Wrap(err, "check inventory")
+		} else if !ok {
+			return types.RejectInsufficientInventory, errors.New("insufficient inventory")
 		}
 
-		if err := target.Verify(srcChainID, req.Call, req.Deposits); err != nil {
-			return reject(rejectInsufficientInventory, err) // TODO(corver): Fix reason
+		return types.RejectNone, nil
+	}
+}
+
+// newShouldRejector returns a ShouldReject function using the given check function.
+// Required inventory is reserved for requests that should not be rejected.
+func newShouldRejector(check checkFunc) func(ctx context.Context, chainID uint64, req bindings.SolveRequest) (types.RejectReason, bool, error) {
+	return func(ctx context.Context, srcChainID uint64, req bindings.SolveRequest) (types.RejectReason, bool, error) {
+		reason, err := check(ctx, srcChainID, req, true)
+		if reason == types.RejectNone && err != nil {
+			return types.RejectNone, false, err
+		} else if reason == types.RejectNone {
+			return types.RejectNone, false, nil
 		}
 
-		return rejectNone, false, nil
+		// Errors are expected rejections, so they are logged, not returned.
+		log.Warn(ctx, "Rejecting request", err, "reason", reason, "call", req.Call)
+
+		return reason, true, nil
 	}
 }
@@ -0,0 +1,26 @@
+package app
+
+import (
+	"context"
+	"testing"
+
+	"github.com/omni-network/omni/contracts/bindings"
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/solver/types"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestShouldRejectChainNotReady(t *testing.T) {
+	t.Parallel()
+
+	check := func(context.Context, uint64, bindings.SolveRequest, bool) (types.RejectReason, error) {
+		return types.RejectNone, errors.Wrap(errChainNotReady, "check")
+	}
+
+	// Requests to chains not initialized yet are retried, not rejected.
+	reason, reject, err := newShouldRejector(check)(context.Background(), 1, bindings.SolveRequest{})
+	require.ErrorIs(t, err, errChainNotReady)
+	require.False(t, reject)
+	require.Equal(t, types.RejectNone, reason)
+}
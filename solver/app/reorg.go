@@ -0,0 +1,319 @@
+package app
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/omni-network/omni/contracts/bindings"
+	"github.com/omni-network/omni/lib/cast"
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/ethclient"
+	"github.com/omni-network/omni/lib/ethclient/ethbackend"
+	"github.com/omni-network/omni/lib/log"
+	"github.com/omni-network/omni/lib/umath"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+const (
+	// reorgWindow is the number of processed block hashes tracked per chain for reorg detection.
+	reorgWindow = 128
+
+	// finalityPollPeriod is the period at which deferred fulfills are checked for source chain finality.
+	finalityPollPeriod = 15 * time.Second
+)
+
+// errReorg is returned by the event processor when a source chain reorg is detected and the cursor was rewound.
+var errReorg = errors.NewSentinel("source chain reorg")
+
+// reorgDetector tracks the block hashes of processed heights per chain, detecting source chain reorgs.
+// Hashes are tracked in-memory only, so reorgs spanning a restart are not detected.
+type reorgDetector struct {
+	mu     sync.Mutex
+	hashes map[uint64]map[uint64]common.Hash // Block hashes by height by chain
+	header func(ctx context.Context, chainID uint64, height uint64) (*ethtypes.Header, error)
+}
+
+func newReorgDetector(backends ethbackend.Backends) *reorgDetector {
+	return &reorgDetector{
+		hashes: make(map[uint64]map[uint64]common.Hash),
+		header: func(ctx context.Context, chainID uint64, height uint64) (*ethtypes.Header, error) {
+			backend, err := backends.Backend(chainID)
+			if err != nil {
+				return nil, err
+			}
+
+			header, err := backend.HeaderByNumber(ctx, umath.NewBigInt(height))
+			if err != nil {
+				return nil, errors.Wrap(err, "header by number")
+			}
+
+			return header, nil
+		},
+	}
+}
+
+// Check tracks the block hash of the height being processed and checks it against previously processed heights.
+// It returns the height to rewind the cursor to and true if a reorg was detected.
+// It returns an error if the logs are not from the canonical block, since a reorg is then still in progress.
+func (d *reorgDetector) Check(ctx context.Context, chainID uint64, height uint64, elogs []ethtypes.Log) (uint64, bool, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	header, err := d.header(ctx, chainID, height)
+	if err != nil {
+		return 0, false, err
+	}
+
+	for _, elog := range elogs {
+		if elog.BlockHash != header.Hash() {
+			return 0, false, errors.New("log block hash mismatch", "height", height, "log_hash", elog.BlockHash, "header_hash", header.Hash())
+		}
+	}
+
+	hashes, ok := d.hashes[chainID]
+	if !ok {
+		hashes = make(map[uint64]common.Hash)
+		d.hashes[chainID] = hashes
+	}
+
+	prev, prevOK := hashes[height-1]
+	curr, currOK := hashes[height]
+	if (prevOK && prev != header.ParentHash) || (currOK && curr != header.Hash()) {
+		rewind, err := d.commonAncestor(ctx, chainID, height)
+		if err != nil {
+			return 0, false, errors.Wrap(err, "find common ancestor")
+		}
+
+		return rewind, true, nil
+	}
+
+	hashes[height] = header.Hash()
+	if height > reorgWindow {
+		delete(hashes, height-reorgWindow)
+	}
+
+	return 0, false, nil
+}
+
+// commonAncestor returns the highest tracked height below the given height that is still canonical.
+// If none is, it returns the lowest tracked height. It untracks all heights above the returned height.
+func (d *reorgDetector) commonAncestor(ctx context.Context, chainID uint64, height uint64) (uint64, error) {
+	hashes := d.hashes[chainID]
+
+	lowest := height
+	for h := range hashes {
+		lowest = min(lowest, h)
+	}
+
+	var ancestor uint64
+	for h := height - 1; h >= lowest && h > 0; h-- {
+		hash, ok := hashes[h]
+		if !ok {
+			continue
+		}
+
+		header, err := d.header(ctx, chainID, h)
+		if err != nil {
+			return 0, err
+		} else if header.Hash() == hash {
+			ancestor = h
+			break
+		}
+	}
+
+	if ancestor == 0 { // No canonical height tracked, rewind to the lowest and start tracking afresh.
+		delete(d.hashes, chainID)
+		return lowest, nil
+	}
+
+	for h := range hashes {
+		if h > ancestor {
+			delete(hashes, h)
+		}
+	}
+
+	return ancestor, nil
+}
+
+// finalityDeferrer defers fulfilling requests to targets that require source chain finality
+// until the request's source chain height is finalized. Deferred requests are persisted, so they survive restarts.
+type finalityDeferrer struct {
+	targets   targets
+	finalized func(ctx context.Context, chainID uint64) (uint64, error)
+
+	mu    sync.Mutex
+	table DeferredFulfillTable
+}
+
+func newFinalityDeferrer(store SolverStore, targets targets, backends ethbackend.Backends) *finalityDeferrer {
+	return &finalityDeferrer{
+		targets: targets,
+		table:   store.DeferredFulfillTable(),
+		finalized: func(ctx context.Context, chainID uint64) (uint64, error) {
+			backend, err := backends.Backend(chainID)
+			if err != nil {
+				return 0, err
+			}
+
+			header, err := backend.HeaderByType(ctx, ethclient.HeadFinalized)
+			if err != nil {
+				return 0, errors.Wrap(err, "finalized header")
+			}
+
+			return header.Number.Uint64(), nil
+		},
+	}
+}
+
+// Defer returns true if fulfilling the request must be deferred until the source chain height is finalized,
+// in which case the request is queued and processed by Run once finalized.
+func (d *finalityDeferrer) Defer(ctx context.Context, chainID uint64, height uint64, req bindings.SolveRequest) (bool, error) {
+	target, err := d.targets.Get(req.Call)
+	if err != nil || !target.FulfillConfLevel().IsFinalized() {
+		return false, nil //nolint:nilerr // Unknown targets fail in fulfill.
+	}
+
+	finalized, err := d.finalized(ctx, chainID)
+	if err != nil {
+		return false, err
+	} else if height <= finalized {
+		return false, nil
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	err = d.table.Save(ctx, &DeferredFulfill{
+		SrcChainId: chainID,
+		ReqId:      req.Id[:],
+		Height:     height,
+	})
+	if err != nil {
+		return false, errors.Wrap(err, "save deferred fulfill")
+	}
+
+	return true, nil
+}
+
+// list returns all deferred requests.
+func (d *finalityDeferrer) list(ctx context.Context) ([]*DeferredFulfill, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	iter, err := d.table.List(ctx, DeferredFulfillPrimaryKey{})
+	if err != nil {
+		return nil, errors.Wrap(err, "list deferred fulfills")
+	}
+	defer iter.Close()
+
+	var resp []*DeferredFulfill
+	for iter.Next() {
+		deferred, err := iter.Value()
+		if err != nil {
+			return nil, errors.Wrap(err, "deferred fulfill value")
+		}
+		resp = append(resp, deferred)
+	}
+
+	return resp, nil
+}
+
+// remove deletes the deferred request.
+func (d *finalityDeferrer) remove(ctx context.Context, deferred *DeferredFulfill) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if err := d.table.Delete(ctx, deferred); err != nil {
+		return errors.Wrap(err, "delete deferred fulfill")
+	}
+
+	return nil
+}
+
+// Run processes deferred requests once their source chain height is finalized, until the context is canceled.
+func (d *finalityDeferrer) Run(ctx context.Context, process func(ctx context.Context, chainID uint64, height uint64, reqID [32]byte) error) {
+	ticker := time.NewTicker(finalityPollPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			d.processFinalized(ctx, process)
+		}
+	}
+}
+
+// processFinalized processes all deferred requests with finalized source chain heights.
+// Requests that fail processing remain deferred and are retried.
+func (d *finalityDeferrer) processFinalized(ctx context.Context, process func(ctx context.Context, chainID uint64, height uint64, reqID [32]byte) error) {
+	deferred, err := d.list(ctx)
+	if err != nil {
+		log.Warn(ctx, "Failed listing deferred requests (will retry)", err)
+		return
+	}
+
+	finalized := make(map[uint64]uint64)
+	for _, fulfill := range deferred {
+		reqID, err := cast.Array32(fulfill.GetReqId())
+		if err != nil {
+			log.Error(ctx, "Invalid deferred request ID [BUG]", err)
+			continue
+		}
+
+		ctx := log.WithCtx(ctx, "req_id", fmtReqID(reqID))
+		chainID := fulfill.GetSrcChainId()
+
+		height, ok := finalized[chainID]
+		if !ok {
+			var err error
+			height, err = d.finalized(ctx, chainID)
+			if err != nil {
+				log.Warn(ctx, "Failed fetching finalized height (will retry)", err)
+				continue
+			}
+			finalized[chainID] = height
+		}
+
+		if fulfill.GetHeight() > height {
+			continue
+		}
+
+		if err := process(ctx, chainID, fulfill.GetHeight(), reqID); err != nil {
+			log.Warn(ctx, "Failed processing finalized request (will retry)", err)
+			continue
+		}
+
+		if err := d.remove(ctx, fulfill); err != nil {
+			log.Warn(ctx, "Failed removing processed deferred request (will retry)", err)
+		}
+	}
+}
+
+// newFinalizedProcessor returns a function that processes finalized deferred requests using their current on-chain status.
+// Requests that are no longer accepted (e.g. reorged away, or already fulfilled) are dropped,
+// since their current status is processed via the event stream.
+func newFinalizedProcessor(deps procDeps) func(ctx context.Context, chainID uint64, height uint64, reqID [32]byte) error {
+	return func(ctx context.Context, chainID uint64, height uint64, reqID [32]byte) error {
+		current, _, err := deps.GetRequest(ctx, chainID, reqID)
+		if err != nil {
+			return errors.Wrap(err, "current status")
+		} else if current.Status != statusAccepted {
+			log.Info(ctx, "Dropping deferred request no longer accepted", "status", statusString(current.Status))
+			return nil
+		}
+
+		log.Debug(ctx, "Processing finalized request")
+
+		// Finality was reached, so fulfill without deferring again.
+		deps.DeferFulfill = func(context.Context, uint64, uint64, bindings.SolveRequest) (bool, error) {
+			return false, nil
+		}
+
+		return processRequest(ctx, deps, chainID, height, current.Status, current)
+	}
+}
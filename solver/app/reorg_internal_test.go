@@ -0,0 +1,249 @@
+package app
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"testing"
+
+	"github.com/omni-network/omni/contracts/bindings"
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/ethclient/ethbackend"
+	"github.com/omni-network/omni/lib/tutil"
+	"github.com/omni-network/omni/lib/xchain"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReorgDetector(t *testing.T) {
+	t.Parallel()
+
+	const chainID = 1
+	ctx := context.Background()
+
+	chain := newTestChain(10)
+	d := &reorgDetector{
+		hashes: make(map[uint64]map[uint64]common.Hash),
+		header: func(_ context.Context, _ uint64, height uint64) (*ethtypes.Header, error) {
+			return chain.Header(height), nil
+		},
+	}
+
+	// Process heights 1-10 without reorgs.
+	for h := uint64(1); h <= 10; h++ {
+		_, reorged, err := d.Check(ctx, chainID, h, []ethtypes.Log{{BlockHash: chain.Header(h).Hash()}})
+		require.NoError(t, err)
+		require.False(t, reorged)
+	}
+
+	// Re-processing a height is not a reorg.
+	_, reorged, err := d.Check(ctx, chainID, 10, nil)
+	require.NoError(t, err)
+	require.False(t, reorged)
+
+	// Logs from a non-canonical block are an error.
+	_, _, err = d.Check(ctx, chainID, 10, []ethtypes.Log{{BlockHash: tutil.RandomHash()}})
+	require.ErrorContains(t, err, "log block hash mismatch")
+
+	// Reorg heights 8+, detected at height 11, rewinds to the common ancestor.
+	chain.Reorg(8, 11)
+	rewind, reorged, err := d.Check(ctx, chainID, 11, nil)
+	require.NoError(t, err)
+	require.True(t, reorged)
+	require.EqualValues(t, 7, rewind)
+
+	// Re-processing from the common ancestor continues without reorgs.
+	for h := uint64(7); h <= 11; h++ {
+		_, reorged, err := d.Check(ctx, chainID, h, nil)
+		require.NoError(t, err)
+		require.False(t, reorged)
+	}
+
+	// Reorg of all tracked heights rewinds to the lowest tracked height.
+	chain.Reorg(1, 11)
+	rewind, reorged, err = d.Check(ctx, chainID, 11, nil)
+	require.NoError(t, err)
+	require.True(t, reorged)
+	require.EqualValues(t, 1, rewind)
+	require.Empty(t, d.hashes[chainID])
+}
+
+func TestFinalityDeferrer(t *testing.T) {
+	t.Parallel()
+
+	const chainID = 1
+	ctx := context.Background()
+
+	latest := erc20Target{chainID: 2, address: tutil.RandomAddress(), confLevel: xchain.ConfLatest}
+	finalized := erc20Target{chainID: 2, address: tutil.RandomAddress(), confLevel: xchain.ConfFinalized}
+
+	db, err := newSolverDB("")
+	require.NoError(t, err)
+	store, err := newSolverStore(db)
+	require.NoError(t, err)
+
+	var finalizedHeight uint64 = 10
+	newDeferrer := func() *finalityDeferrer {
+		d := newFinalityDeferrer(store, targets{latest, finalized}, ethbackend.Backends{})
+		d.finalized = func(context.Context, uint64) (uint64, error) {
+			return finalizedHeight, nil
+		}
+
+		return d
+	}
+	d := newDeferrer()
+
+	newReq := func(target erc20Target) bindings.SolveRequest {
+		return bindings.SolveRequest{
+			Id:   tutil.RandomHash(),
+			Call: bindings.SolveCall{DestChainId: target.ChainID(), Target: target.Address()},
+		}
+	}
+
+	requireDeferred := func(t *testing.T, n int) {
+		t.Helper()
+		deferred, err := d.list(ctx)
+		require.NoError(t, err)
+		require.Len(t, deferred, n)
+	}
+
+	// Targets fulfilling at latest are not deferred.
+	ok, err := d.Defer(ctx, chainID, 20, newReq(latest))
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// Finalized heights are not deferred.
+	ok, err = d.Defer(ctx, chainID, 10, newReq(finalized))
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// Unfinalized heights are deferred.
+	req := newReq(finalized)
+	ok, err = d.Defer(ctx, chainID, 11, req)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	var processed [][32]byte
+	process := func(_ context.Context, _ uint64, height uint64, reqID [32]byte) error {
+		if len(processed) == 0 {
+			processed = append(processed, reqID)
+			return errors.New("transient")
+		}
+
+		require.EqualValues(t, 11, height)
+		processed = append(processed, reqID)
+
+		return nil
+	}
+
+	// Not processed until finalized.
+	d.processFinalized(ctx, process)
+	require.Empty(t, processed)
+	requireDeferred(t, 1)
+
+	// Deferred requests survive restarts.
+	d = newDeferrer()
+	requireDeferred(t, 1)
+
+	// Failures are retried.
+	finalizedHeight = 11
+	d.processFinalized(ctx, process)
+	require.Len(t, processed, 1)
+	requireDeferred(t, 1)
+
+	d.processFinalized(ctx, process)
+	require.Len(t, processed, 2)
+	require.Equal(t, req.Id, processed[1])
+	requireDeferred(t, 0)
+}
+
+func TestFinalizedProcessor(t *testing.T) {
+	t.Parallel()
+
+	const chainID = 1
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		status  uint8
+		fulfill bool
+	}{
+		{name: "accepted", status: statusAccepted, fulfill: true},
+		{name: "reorged", status: statusInvalid},
+		{name: "reverted", status: statusReverted},
+		{name: "fulfilled", status: statusFulfilled},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			var fulfilled bool
+			deps := procDeps{
+				GetRequest: func(_ context.Context, _ uint64, id [32]byte) (bindings.SolveRequest, bool, error) {
+					return bindings.SolveRequest{Id: id, Status: test.status}, test.status != statusInvalid, nil
+				},
+				Reserve: func(context.Context, uint64, bindings.SolveRequest) error {
+					return nil
+				},
+				DeferFulfill: func(context.Context, uint64, uint64, bindings.SolveRequest) (bool, error) {
+					return true, nil // Overridden by the processor.
+				},
+				Fulfill: func(context.Context, uint64, bindings.SolveRequest) (common.Hash, error) {
+					fulfilled = true
+					return tutil.RandomHash(), nil
+				},
+				Claim: func(context.Context, uint64, bindings.SolveRequest) (common.Hash, error) {
+					require.Fail(t, "unexpected claim")
+					return common.Hash{}, nil
+				},
+				Record: func(context.Context, uint64, uint64, uint8, bindings.SolveRequest, actionResult) error {
+					return nil
+				},
+			}
+
+			err := newFinalizedProcessor(deps)(ctx, chainID, 11, tutil.RandomHash())
+			require.NoError(t, err)
+			require.Equal(t, test.fulfill, fulfilled)
+		})
+	}
+}
+
+// testChain is a mock chain of headers supporting reorgs.
+type testChain struct {
+	mu      sync.Mutex
+	headers map[uint64]*ethtypes.Header
+}
+
+func newTestChain(height uint64) *testChain {
+	c := &testChain{headers: make(map[uint64]*ethtypes.Header)}
+	c.Reorg(0, height)
+
+	return c
+}
+
+// Reorg replaces the headers from the given height up to and including the head.
+func (c *testChain) Reorg(from uint64, head uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for h := from; h <= head; h++ {
+		header := &ethtypes.Header{
+			Number: new(big.Int).SetUint64(h),
+			Extra:  tutil.RandomBytes(32),
+		}
+		if parent, ok := c.headers[h-1]; ok && h > 0 {
+			header.ParentHash = parent.Hash()
+		}
+		c.headers[h] = header
+	}
+}
+
+func (c *testChain) Header(height uint64) *ethtypes.Header {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.headers[height]
+}
@@ -0,0 +1,209 @@
+package app
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/omni-network/omni/contracts/bindings"
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/solver/types"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"cosmossdk.io/orm/types/ormerrors"
+)
+
+// action is a solver action taken on a request.
+type action uint32
+
+const (
+	actionNone action = iota
+	actionAccept
+	actionReject
+	actionFulfill
+	actionClaim
+)
+
+func (a action) String() string {
+	switch a {
+	case actionNone:
+		return "none"
+	case actionAccept:
+		return "accept"
+	case actionReject:
+		return "reject"
+	case actionFulfill:
+		return "fulfill"
+	case actionClaim:
+		return "claim"
+	default:
+		return "unknown"
+	}
+}
+
+// actionResult is the result of a solver action taken on a request.
+type actionResult struct {
+	Action action
+	Reason types.RejectReason // Only set for actionReject
+	TxHash common.Hash        // Zero if no transaction was submitted
+	Err    error
+}
+
+// inFlightStatuses are the non-terminal request statuses that may still require solver action.
+var inFlightStatuses = []uint8{statusPending, statusAccepted, statusFulfilled}
+
+// requests provides a thread-safe persisted store of request lifecycles.
+type requests struct {
+	mu     sync.Mutex
+	table  RequestTable
+	events RequestEventTable
+	now    func() time.Time
+}
+
+func newRequests(store SolverStore) *requests {
+	return &requests{
+		table:  store.RequestTable(),
+		events: store.RequestEventTable(),
+		now:    time.Now,
+	}
+}
+
+// Record records the processing of an event of the request at the given height, including the solver action taken, if any.
+// It updates the latest request state and appends an audit log entry.
+func (r *requests) Record(ctx context.Context, chainID uint64, height uint64, eventStatus uint8, req bindings.SolveRequest, res actionResult) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := uint64(r.now().Unix())
+
+	var txHash []byte
+	if res.TxHash != (common.Hash{}) {
+		txHash = res.TxHash.Bytes()
+	}
+
+	var errStr string
+	if res.Err != nil {
+		errStr = res.Err.Error()
+	}
+
+	request, err := r.table.Get(ctx, chainID, req.Id[:])
+	if ormerrors.IsNotFound(err) {
+		request = &Request{
+			SrcChainId: chainID,
+			ReqId:      req.Id[:],
+			CreatedAt:  now,
+		}
+	} else if err != nil {
+		return errors.Wrap(err, "get request")
+	}
+
+	request.Status = uint32(req.Status)
+	request.Height = height
+	request.UpdatedAt = now
+	if res.Action != actionNone {
+		request.Action = uint32(res.Action)
+		request.RejectReason = uint32(res.Reason)
+		request.TxHash = txHash
+		request.Error = errStr
+	}
+
+	if err := r.table.Save(ctx, request); err != nil {
+		return errors.Wrap(err, "save request")
+	}
+
+	err = r.events.Insert(ctx, &RequestEvent{
+		SrcChainId:   chainID,
+		ReqId:        req.Id[:],
+		EventStatus:  uint32(eventStatus),
+		Status:       uint32(req.Status),
+		Height:       height,
+		Action:       uint32(res.Action),
+		RejectReason: uint32(res.Reason),
+		TxHash:       txHash,
+		Error:        errStr,
+		CreatedAt:    now,
+	})
+	if err != nil {
+		return errors.Wrap(err, "insert request event")
+	}
+
+	return nil
+}
+
+// RecordAction records a solver action taken on the request outside of event processing (e.g. queued claims).
+// It uses the height of the latest processed event of the request.
+func (r *requests) RecordAction(ctx context.Context, chainID uint64, req bindings.SolveRequest, res actionResult) error {
+	var height uint64
+	if request, ok, err := r.Get(ctx, chainID, req.Id); err != nil {
+		return err
+	} else if ok {
+		height = request.GetHeight()
+	}
+
+	return r.Record(ctx, chainID, height, req.Status, req, res)
+}
+
+// Get returns the request from the source chain, or false if not found.
+func (r *requests) Get(ctx context.Context, chainID uint64, reqID [32]byte) (*Request, bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	request, err := r.table.Get(ctx, chainID, reqID[:])
+	if ormerrors.IsNotFound(err) {
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, errors.Wrap(err, "get request")
+	}
+
+	return request, true, nil
+}
+
+// InFlight returns the requests from the source chain with a non-terminal latest observed status.
+func (r *requests) InFlight(ctx context.Context, chainID uint64) ([]*Request, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var resp []*Request
+	for _, status := range inFlightStatuses {
+		iter, err := r.table.List(ctx, RequestSrcChainIdStatusIndexKey{}.WithSrcChainIdStatus(chainID, uint32(status)))
+		if err != nil {
+			return nil, errors.Wrap(err, "list requests")
+		}
+
+		for iter.Next() {
+			request, err := iter.Value()
+			if err != nil {
+				iter.Close()
+				return nil, errors.Wrap(err, "request value")
+			}
+			resp = append(resp, request)
+		}
+		iter.Close()
+	}
+
+	return resp, nil
+}
+
+// Events returns the audit log of the request, ordered by insertion.
+func (r *requests) Events(ctx context.Context, chainID uint64, reqID [32]byte) ([]*RequestEvent, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	iter, err := r.events.List(ctx, RequestEventSrcChainIdReqIdIndexKey{}.WithSrcChainIdReqId(chainID, reqID[:]))
+	if err != nil {
+		return nil, errors.Wrap(err, "list request events")
+	}
+	defer iter.Close()
+
+	var resp []*RequestEvent
+	for iter.Next() {
+		event, err := iter.Value()
+		if err != nil {
+			return nil, errors.Wrap(err, "request event value")
+		}
+		resp = append(resp, event)
+	}
+
+	return resp, nil
+}
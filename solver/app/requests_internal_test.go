@@ -0,0 +1,90 @@
+package app
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/omni-network/omni/contracts/bindings"
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/tutil"
+	"github.com/omni-network/omni/solver/types"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequests(t *testing.T) {
+	t.Parallel()
+
+	const chainID = 1
+	ctx := context.Background()
+
+	db, err := newSolverDB("")
+	require.NoError(t, err)
+	store, err := newSolverStore(db)
+	require.NoError(t, err)
+
+	reqs := newRequests(store)
+	now := time.Unix(1000, 0)
+	reqs.now = func() time.Time { return now }
+
+	req1 := bindings.SolveRequest{Id: tutil.RandomHash(), Status: statusPending}
+	req2 := bindings.SolveRequest{Id: tutil.RandomHash(), Status: statusPending}
+	tx1, tx2 := tutil.RandomHash(), tutil.RandomHash()
+
+	// Accept req1, reject req2
+	require.NoError(t, reqs.Record(ctx, chainID, 10, statusPending, req1, actionResult{Action: actionAccept, TxHash: tx1}))
+	require.NoError(t, reqs.Record(ctx, chainID, 10, statusPending, req2, actionResult{Action: actionReject, Reason: types.RejectInsufficientFee, TxHash: tx2}))
+
+	// Failed fulfill of req1
+	now = now.Add(time.Minute)
+	req1.Status = statusAccepted
+	require.NoError(t, reqs.Record(ctx, chainID, 11, statusAccepted, req1, actionResult{Action: actionFulfill, Err: errors.New("boom")}))
+
+	// Mismatching old event of req2
+	req2.Status = statusRejected
+	require.NoError(t, reqs.Record(ctx, chainID, 11, statusPending, req2, actionResult{}))
+
+	// Only req1 is in-flight
+	inFlight, err := reqs.InFlight(ctx, chainID)
+	require.NoError(t, err)
+	require.Len(t, inFlight, 1)
+	require.EqualValues(t, req1.Id[:], inFlight[0].GetReqId())
+	require.EqualValues(t, statusAccepted, inFlight[0].GetStatus())
+	require.EqualValues(t, actionFulfill, inFlight[0].GetAction())
+	require.EqualValues(t, 11, inFlight[0].GetHeight())
+	require.Empty(t, inFlight[0].GetTxHash())
+	require.Contains(t, inFlight[0].GetError(), "boom")
+	require.EqualValues(t, 1000, inFlight[0].GetCreatedAt())
+	require.EqualValues(t, 1060, inFlight[0].GetUpdatedAt())
+
+	// Other chains are not affected
+	inFlight, err = reqs.InFlight(ctx, chainID+1)
+	require.NoError(t, err)
+	require.Empty(t, inFlight)
+
+	// Mismatching event doesn't override the latest action
+	request, err := store.RequestTable().Get(ctx, chainID, req2.Id[:])
+	require.NoError(t, err)
+	require.EqualValues(t, statusRejected, request.GetStatus())
+	require.EqualValues(t, actionReject, request.GetAction())
+	require.EqualValues(t, types.RejectInsufficientFee, request.GetRejectReason())
+	require.Equal(t, tx2, common.BytesToHash(request.GetTxHash()))
+
+	// Audit log contains all events
+	events, err := reqs.Events(ctx, chainID, req1.Id)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	require.EqualValues(t, actionAccept, events[0].GetAction())
+	require.Equal(t, tx1, common.BytesToHash(events[0].GetTxHash()))
+	require.EqualValues(t, actionFulfill, events[1].GetAction())
+
+	events, err = reqs.Events(ctx, chainID, req2.Id)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	require.EqualValues(t, statusPending, events[1].GetEventStatus())
+	require.EqualValues(t, statusRejected, events[1].GetStatus())
+	require.EqualValues(t, actionNone, events[1].GetAction())
+}
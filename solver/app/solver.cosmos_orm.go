@@ -128,20 +128,561 @@ func NewCursorTable(db ormtable.Schema) (CursorTable, error) {
 	return cursorTable{table}, nil
 }
 
+type RequestTable interface {
+	Insert(ctx context.Context, request *Request) error
+	Update(ctx context.Context, request *Request) error
+	Save(ctx context.Context, request *Request) error
+	Delete(ctx context.Context, request *Request) error
+	Has(ctx context.Context, src_chain_id uint64, req_id []byte) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, src_chain_id uint64, req_id []byte) (*Request, error)
+	List(ctx context.Context, prefixKey RequestIndexKey, opts ...ormlist.Option) (RequestIterator, error)
+	ListRange(ctx context.Context, from, to RequestIndexKey, opts ...ormlist.Option) (RequestIterator, error)
+	DeleteBy(ctx context.Context, prefixKey RequestIndexKey) error
+	DeleteRange(ctx context.Context, from, to RequestIndexKey) error
+
+	doNotImplement()
+}
+
+type RequestIterator struct {
+	ormtable.Iterator
+}
+
+func (i RequestIterator) Value() (*Request, error) {
+	var request Request
+	err := i.UnmarshalMessage(&request)
+	return &request, err
+}
+
+type RequestIndexKey interface {
+	id() uint32
+	values() []interface{}
+	requestIndexKey()
+}
+
+// primary key starting index..
+type RequestPrimaryKey = RequestSrcChainIdReqIdIndexKey
+
+type RequestSrcChainIdReqIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x RequestSrcChainIdReqIdIndexKey) id() uint32            { return 0 }
+func (x RequestSrcChainIdReqIdIndexKey) values() []interface{} { return x.vs }
+func (x RequestSrcChainIdReqIdIndexKey) requestIndexKey()      {}
+
+func (this RequestSrcChainIdReqIdIndexKey) WithSrcChainId(src_chain_id uint64) RequestSrcChainIdReqIdIndexKey {
+	this.vs = []interface{}{src_chain_id}
+	return this
+}
+
+func (this RequestSrcChainIdReqIdIndexKey) WithSrcChainIdReqId(src_chain_id uint64, req_id []byte) RequestSrcChainIdReqIdIndexKey {
+	this.vs = []interface{}{src_chain_id, req_id}
+	return this
+}
+
+type RequestSrcChainIdStatusIndexKey struct {
+	vs []interface{}
+}
+
+func (x RequestSrcChainIdStatusIndexKey) id() uint32            { return 1 }
+func (x RequestSrcChainIdStatusIndexKey) values() []interface{} { return x.vs }
+func (x RequestSrcChainIdStatusIndexKey) requestIndexKey()      {}
+
+func (this RequestSrcChainIdStatusIndexKey) WithSrcChainId(src_chain_id uint64) RequestSrcChainIdStatusIndexKey {
+	this.vs = []interface{}{src_chain_id}
+	return this
+}
+
+func (this RequestSrcChainIdStatusIndexKey) WithSrcChainIdStatus(src_chain_id uint64, status uint32) RequestSrcChainIdStatusIndexKey {
+	this.vs = []interface{}{src_chain_id, status}
+	return this
+}
+
+type requestTable struct {
+	table ormtable.Table
+}
+
+func (this requestTable) Insert(ctx context.Context, request *Request) error {
+	return this.table.Insert(ctx, request)
+}
+
+func (this requestTable) Update(ctx context.Context, request *Request) error {
+	return this.table.Update(ctx, request)
+}
+
+func (this requestTable) Save(ctx context.Context, request *Request) error {
+	return this.table.Save(ctx, request)
+}
+
+func (this requestTable) Delete(ctx context.Context, request *Request) error {
+	return this.table.Delete(ctx, request)
+}
+
+func (this requestTable) Has(ctx context.Context, src_chain_id uint64, req_id []byte) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, src_chain_id, req_id)
+}
+
+func (this requestTable) Get(ctx context.Context, src_chain_id uint64, req_id []byte) (*Request, error) {
+	var request Request
+	found, err := this.table.PrimaryKey().Get(ctx, &request, src_chain_id, req_id)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &request, nil
+}
+
+func (this requestTable) List(ctx context.Context, prefixKey RequestIndexKey, opts ...ormlist.Option) (RequestIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return RequestIterator{it}, err
+}
+
+func (this requestTable) ListRange(ctx context.Context, from, to RequestIndexKey, opts ...ormlist.Option) (RequestIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return RequestIterator{it}, err
+}
+
+func (this requestTable) DeleteBy(ctx context.Context, prefixKey RequestIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this requestTable) DeleteRange(ctx context.Context, from, to RequestIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this requestTable) doNotImplement() {}
+
+var _ RequestTable = requestTable{}
+
+func NewRequestTable(db ormtable.Schema) (RequestTable, error) {
+	table := db.GetTable(&Request{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&Request{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return requestTable{table}, nil
+}
+
+type RequestEventTable interface {
+	Insert(ctx context.Context, requestEvent *RequestEvent) error
+	InsertReturningId(ctx context.Context, requestEvent *RequestEvent) (uint64, error)
+	LastInsertedSequence(ctx context.Context) (uint64, error)
+	Update(ctx context.Context, requestEvent *RequestEvent) error
+	Save(ctx context.Context, requestEvent *RequestEvent) error
+	Delete(ctx context.Context, requestEvent *RequestEvent) error
+	Has(ctx context.Context, id uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, id uint64) (*RequestEvent, error)
+	List(ctx context.Context, prefixKey RequestEventIndexKey, opts ...ormlist.Option) (RequestEventIterator, error)
+	ListRange(ctx context.Context, from, to RequestEventIndexKey, opts ...ormlist.Option) (RequestEventIterator, error)
+	DeleteBy(ctx context.Context, prefixKey RequestEventIndexKey) error
+	DeleteRange(ctx context.Context, from, to RequestEventIndexKey) error
+
+	doNotImplement()
+}
+
+type RequestEventIterator struct {
+	ormtable.Iterator
+}
+
+func (i RequestEventIterator) Value() (*RequestEvent, error) {
+	var requestEvent RequestEvent
+	err := i.UnmarshalMessage(&requestEvent)
+	return &requestEvent, err
+}
+
+type RequestEventIndexKey interface {
+	id() uint32
+	values() []interface{}
+	requestEventIndexKey()
+}
+
+// primary key starting index..
+type RequestEventPrimaryKey = RequestEventIdIndexKey
+
+type RequestEventIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x RequestEventIdIndexKey) id() uint32            { return 0 }
+func (x RequestEventIdIndexKey) values() []interface{} { return x.vs }
+func (x RequestEventIdIndexKey) requestEventIndexKey() {}
+
+func (this RequestEventIdIndexKey) WithId(id uint64) RequestEventIdIndexKey {
+	this.vs = []interface{}{id}
+	return this
+}
+
+type RequestEventSrcChainIdReqIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x RequestEventSrcChainIdReqIdIndexKey) id() uint32            { return 1 }
+func (x RequestEventSrcChainIdReqIdIndexKey) values() []interface{} { return x.vs }
+func (x RequestEventSrcChainIdReqIdIndexKey) requestEventIndexKey() {}
+
+func (this RequestEventSrcChainIdReqIdIndexKey) WithSrcChainId(src_chain_id uint64) RequestEventSrcChainIdReqIdIndexKey {
+	this.vs = []interface{}{src_chain_id}
+	return this
+}
+
+func (this RequestEventSrcChainIdReqIdIndexKey) WithSrcChainIdReqId(src_chain_id uint64, req_id []byte) RequestEventSrcChainIdReqIdIndexKey {
+	this.vs = []interface{}{src_chain_id, req_id}
+	return this
+}
+
+type requestEventTable struct {
+	table ormtable.AutoIncrementTable
+}
+
+func (this requestEventTable) Insert(ctx context.Context, requestEvent *RequestEvent) error {
+	return this.table.Insert(ctx, requestEvent)
+}
+
+func (this requestEventTable) Update(ctx context.Context, requestEvent *RequestEvent) error {
+	return this.table.Update(ctx, requestEvent)
+}
+
+func (this requestEventTable) Save(ctx context.Context, requestEvent *RequestEvent) error {
+	return this.table.Save(ctx, requestEvent)
+}
+
+func (this requestEventTable) Delete(ctx context.Context, requestEvent *RequestEvent) error {
+	return this.table.Delete(ctx, requestEvent)
+}
+
+func (this requestEventTable) InsertReturningId(ctx context.Context, requestEvent *RequestEvent) (uint64, error) {
+	return this.table.InsertReturningPKey(ctx, requestEvent)
+}
+
+func (this requestEventTable) LastInsertedSequence(ctx context.Context) (uint64, error) {
+	return this.table.LastInsertedSequence(ctx)
+}
+
+func (this requestEventTable) Has(ctx context.Context, id uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, id)
+}
+
+func (this requestEventTable) Get(ctx context.Context, id uint64) (*RequestEvent, error) {
+	var requestEvent RequestEvent
+	found, err := this.table.PrimaryKey().Get(ctx, &requestEvent, id)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &requestEvent, nil
+}
+
+func (this requestEventTable) List(ctx context.Context, prefixKey RequestEventIndexKey, opts ...ormlist.Option) (RequestEventIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return RequestEventIterator{it}, err
+}
+
+func (this requestEventTable) ListRange(ctx context.Context, from, to RequestEventIndexKey, opts ...ormlist.Option) (RequestEventIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return RequestEventIterator{it}, err
+}
+
+func (this requestEventTable) DeleteBy(ctx context.Context, prefixKey RequestEventIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this requestEventTable) DeleteRange(ctx context.Context, from, to RequestEventIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this requestEventTable) doNotImplement() {}
+
+var _ RequestEventTable = requestEventTable{}
+
+func NewRequestEventTable(db ormtable.Schema) (RequestEventTable, error) {
+	table := db.GetTable(&RequestEvent{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&RequestEvent{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return requestEventTable{table.(ormtable.AutoIncrementTable)}, nil
+}
+
+type DeferredFulfillTable interface {
+	Insert(ctx context.Context, deferredFulfill *DeferredFulfill) error
+	Update(ctx context.Context, deferredFulfill *DeferredFulfill) error
+	Save(ctx context.Context, deferredFulfill *DeferredFulfill) error
+	Delete(ctx context.Context, deferredFulfill *DeferredFulfill) error
+	Has(ctx context.Context, src_chain_id uint64, req_id []byte) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, src_chain_id uint64, req_id []byte) (*DeferredFulfill, error)
+	List(ctx context.Context, prefixKey DeferredFulfillIndexKey, opts ...ormlist.Option) (DeferredFulfillIterator, error)
+	ListRange(ctx context.Context, from, to DeferredFulfillIndexKey, opts ...ormlist.Option) (DeferredFulfillIterator, error)
+	DeleteBy(ctx context.Context, prefixKey DeferredFulfillIndexKey) error
+	DeleteRange(ctx context.Context, from, to DeferredFulfillIndexKey) error
+
+	doNotImplement()
+}
+
+type DeferredFulfillIterator struct {
+	ormtable.Iterator
+}
+
+func (i DeferredFulfillIterator) Value() (*DeferredFulfill, error) {
+	var deferredFulfill DeferredFulfill
+	err := i.UnmarshalMessage(&deferredFulfill)
+	return &deferredFulfill, err
+}
+
+type DeferredFulfillIndexKey interface {
+	id() uint32
+	values() []interface{}
+	deferredFulfillIndexKey()
+}
+
+// primary key starting index..
+type DeferredFulfillPrimaryKey = DeferredFulfillSrcChainIdReqIdIndexKey
+
+type DeferredFulfillSrcChainIdReqIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x DeferredFulfillSrcChainIdReqIdIndexKey) id() uint32               { return 0 }
+func (x DeferredFulfillSrcChainIdReqIdIndexKey) values() []interface{}    { return x.vs }
+func (x DeferredFulfillSrcChainIdReqIdIndexKey) deferredFulfillIndexKey() {}
+
+func (this DeferredFulfillSrcChainIdReqIdIndexKey) WithSrcChainId(src_chain_id uint64) DeferredFulfillSrcChainIdReqIdIndexKey {
+	this.vs = []interface{}{src_chain_id}
+	return this
+}
+
+func (this DeferredFulfillSrcChainIdReqIdIndexKey) WithSrcChainIdReqId(src_chain_id uint64, req_id []byte) DeferredFulfillSrcChainIdReqIdIndexKey {
+	this.vs = []interface{}{src_chain_id, req_id}
+	return this
+}
+
+type deferredFulfillTable struct {
+	table ormtable.Table
+}
+
+func (this deferredFulfillTable) Insert(ctx context.Context, deferredFulfill *DeferredFulfill) error {
+	return this.table.Insert(ctx, deferredFulfill)
+}
+
+func (this deferredFulfillTable) Update(ctx context.Context, deferredFulfill *DeferredFulfill) error {
+	return this.table.Update(ctx, deferredFulfill)
+}
+
+func (this deferredFulfillTable) Save(ctx context.Context, deferredFulfill *DeferredFulfill) error {
+	return this.table.Save(ctx, deferredFulfill)
+}
+
+func (this deferredFulfillTable) Delete(ctx context.Context, deferredFulfill *DeferredFulfill) error {
+	return this.table.Delete(ctx, deferredFulfill)
+}
+
+func (this deferredFulfillTable) Has(ctx context.Context, src_chain_id uint64, req_id []byte) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, src_chain_id, req_id)
+}
+
+func (this deferredFulfillTable) Get(ctx context.Context, src_chain_id uint64, req_id []byte) (*DeferredFulfill, error) {
+	var deferredFulfill DeferredFulfill
+	found, err := this.table.PrimaryKey().Get(ctx, &deferredFulfill, src_chain_id, req_id)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &deferredFulfill, nil
+}
+
+func (this deferredFulfillTable) List(ctx context.Context, prefixKey DeferredFulfillIndexKey, opts ...ormlist.Option) (DeferredFulfillIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return DeferredFulfillIterator{it}, err
+}
+
+func (this deferredFulfillTable) ListRange(ctx context.Context, from, to DeferredFulfillIndexKey, opts ...ormlist.Option) (DeferredFulfillIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return DeferredFulfillIterator{it}, err
+}
+
+func (this deferredFulfillTable) DeleteBy(ctx context.Context, prefixKey DeferredFulfillIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this deferredFulfillTable) DeleteRange(ctx context.Context, from, to DeferredFulfillIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this deferredFulfillTable) doNotImplement() {}
+
+var _ DeferredFulfillTable = deferredFulfillTable{}
+
+func NewDeferredFulfillTable(db ormtable.Schema) (DeferredFulfillTable, error) {
+	table := db.GetTable(&DeferredFulfill{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&DeferredFulfill{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return deferredFulfillTable{table}, nil
+}
+
+type PendingClaimTable interface {
+	Insert(ctx context.Context, pendingClaim *PendingClaim) error
+	Update(ctx context.Context, pendingClaim *PendingClaim) error
+	Save(ctx context.Context, pendingClaim *PendingClaim) error
+	Delete(ctx context.Context, pendingClaim *PendingClaim) error
+	Has(ctx context.Context, src_chain_id uint64, req_id []byte) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, src_chain_id uint64, req_id []byte) (*PendingClaim, error)
+	List(ctx context.Context, prefixKey PendingClaimIndexKey, opts ...ormlist.Option) (PendingClaimIterator, error)
+	ListRange(ctx context.Context, from, to PendingClaimIndexKey, opts ...ormlist.Option) (PendingClaimIterator, error)
+	DeleteBy(ctx context.Context, prefixKey PendingClaimIndexKey) error
+	DeleteRange(ctx context.Context, from, to PendingClaimIndexKey) error
+
+	doNotImplement()
+}
+
+type PendingClaimIterator struct {
+	ormtable.Iterator
+}
+
+func (i PendingClaimIterator) Value() (*PendingClaim, error) {
+	var pendingClaim PendingClaim
+	err := i.UnmarshalMessage(&pendingClaim)
+	return &pendingClaim, err
+}
+
+type PendingClaimIndexKey interface {
+	id() uint32
+	values() []interface{}
+	pendingClaimIndexKey()
+}
+
+// primary key starting index..
+type PendingClaimPrimaryKey = PendingClaimSrcChainIdReqIdIndexKey
+
+type PendingClaimSrcChainIdReqIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x PendingClaimSrcChainIdReqIdIndexKey) id() uint32            { return 0 }
+func (x PendingClaimSrcChainIdReqIdIndexKey) values() []interface{} { return x.vs }
+func (x PendingClaimSrcChainIdReqIdIndexKey) pendingClaimIndexKey() {}
+
+func (this PendingClaimSrcChainIdReqIdIndexKey) WithSrcChainId(src_chain_id uint64) PendingClaimSrcChainIdReqIdIndexKey {
+	this.vs = []interface{}{src_chain_id}
+	return this
+}
+
+func (this PendingClaimSrcChainIdReqIdIndexKey) WithSrcChainIdReqId(src_chain_id uint64, req_id []byte) PendingClaimSrcChainIdReqIdIndexKey {
+	this.vs = []interface{}{src_chain_id, req_id}
+	return this
+}
+
+type pendingClaimTable struct {
+	table ormtable.Table
+}
+
+func (this pendingClaimTable) Insert(ctx context.Context, pendingClaim *PendingClaim) error {
+	return this.table.Insert(ctx, pendingClaim)
+}
+
+func (this pendingClaimTable) Update(ctx context.Context, pendingClaim *PendingClaim) error {
+	return this.table.Update(ctx, pendingClaim)
+}
+
+func (this pendingClaimTable) Save(ctx context.Context, pendingClaim *PendingClaim) error {
+	return this.table.Save(ctx, pendingClaim)
+}
+
+func (this pendingClaimTable) Delete(ctx context.Context, pendingClaim *PendingClaim) error {
+	return this.table.Delete(ctx, pendingClaim)
+}
+
+func (this pendingClaimTable) Has(ctx context.Context, src_chain_id uint64, req_id []byte) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, src_chain_id, req_id)
+}
+
+func (this pendingClaimTable) Get(ctx context.Context, src_chain_id uint64, req_id []byte) (*PendingClaim, error) {
+	var pendingClaim PendingClaim
+	found, err := this.table.PrimaryKey().Get(ctx, &pendingClaim, src_chain_id, req_id)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &pendingClaim, nil
+}
+
+func (this pendingClaimTable) List(ctx context.Context, prefixKey PendingClaimIndexKey, opts ...ormlist.Option) (PendingClaimIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return PendingClaimIterator{it}, err
+}
+
+func (this pendingClaimTable) ListRange(ctx context.Context, from, to PendingClaimIndexKey, opts ...ormlist.Option) (PendingClaimIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return PendingClaimIterator{it}, err
+}
+
+func (this pendingClaimTable) DeleteBy(ctx context.Context, prefixKey PendingClaimIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this pendingClaimTable) DeleteRange(ctx context.Context, from, to PendingClaimIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this pendingClaimTable) doNotImplement() {}
+
+var _ PendingClaimTable = pendingClaimTable{}
+
+func NewPendingClaimTable(db ormtable.Schema) (PendingClaimTable, error) {
+	table := db.GetTable(&PendingClaim{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&PendingClaim{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return pendingClaimTable{table}, nil
+}
+
 type SolverStore interface {
 	CursorTable() CursorTable
+	RequestTable() RequestTable
+	RequestEventTable() RequestEventTable
+	DeferredFulfillTable() DeferredFulfillTable
+	PendingClaimTable() PendingClaimTable
 
 	doNotImplement()
 }
 
 type solverStore struct {
-	cursor CursorTable
+	cursor          CursorTable
+	request         RequestTable
+	requestEvent    RequestEventTable
+	deferredFulfill DeferredFulfillTable
+	pendingClaim    PendingClaimTable
 }
 
 func (x solverStore) CursorTable() CursorTable {
 	return x.cursor
 }
 
+func (x solverStore) RequestTable() RequestTable {
+	return x.request
+}
+
+func (x solverStore) RequestEventTable() RequestEventTable {
+	return x.requestEvent
+}
+
+func (x solverStore) DeferredFulfillTable() DeferredFulfillTable {
+	return x.deferredFulfill
+}
+
+func (x solverStore) PendingClaimTable() PendingClaimTable {
+	return x.pendingClaim
+}
+
 func (solverStore) doNotImplement() {}
 
 var _ SolverStore = solverStore{}
@@ -152,7 +693,31 @@ func NewSolverStore(db ormtable.Schema) (SolverStore, error) {
 		return nil, err
 	}
 
+	requestTable, err := NewRequestTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	requestEventTable, err := NewRequestEventTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	deferredFulfillTable, err := NewDeferredFulfillTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	pendingClaimTable, err := NewPendingClaimTable(db)
+	if err != nil {
+		return nil, err
+	}
+
 	return solverStore{
 		cursorTable,
+		requestTable,
+		requestEventTable,
+		deferredFulfillTable,
+		pendingClaimTable,
 	}, nil
 }
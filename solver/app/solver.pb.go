@@ -82,6 +82,366 @@ func (x *Cursor) GetBlockHeight() uint64 {
 	return 0
 }
 
+// Request is the solver's view of a request, including its latest observed status and solver action.
+type Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SrcChainId   uint64 `protobuf:"varint,1,opt,name=src_chain_id,json=srcChainId,proto3" json:"src_chain_id,omitempty"`     // Source chain ID of the request
+	ReqId        []byte `protobuf:"bytes,2,opt,name=req_id,json=reqId,proto3" json:"req_id,omitempty"`                       // Request ID; 32 bytes.
+	Status       uint32 `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`                                 // Latest observed on-chain status
+	Height       uint64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`                                 // Source chain height of the latest processed event
+	Action       uint32 `protobuf:"varint,5,opt,name=action,proto3" json:"action,omitempty"`                                 // Latest solver action; accept, reject, fulfill, claim.
+	RejectReason uint32 `protobuf:"varint,6,opt,name=reject_reason,json=rejectReason,proto3" json:"reject_reason,omitempty"` // Reject reason if action is reject
+	TxHash       []byte `protobuf:"bytes,7,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`                    // Transaction hash of the latest action, if any
+	Error        string `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`                                    // Error of the latest action, if any
+	CreatedAt    uint64 `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`          // Unix timestamp (seconds) when the request was first recorded
+	UpdatedAt    uint64 `protobuf:"varint,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`         // Unix timestamp (seconds) when the request was last updated
+}
+
+func (x *Request) Reset() {
+	*x = Request{}
+	mi := &file_solver_app_solver_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Request) ProtoMessage() {}
+
+func (x *Request) ProtoReflect() protoreflect.Message {
+	mi := &file_solver_app_solver_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Request.ProtoReflect.Descriptor instead.
+func (*Request) Descriptor() ([]byte, []int) {
+	return file_solver_app_solver_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Request) GetSrcChainId() uint64 {
+	if x != nil {
+		return x.SrcChainId
+	}
+	return 0
+}
+
+func (x *Request) GetReqId() []byte {
+	if x != nil {
+		return x.ReqId
+	}
+	return nil
+}
+
+func (x *Request) GetStatus() uint32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *Request) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Request) GetAction() uint32 {
+	if x != nil {
+		return x.Action
+	}
+	return 0
+}
+
+func (x *Request) GetRejectReason() uint32 {
+	if x != nil {
+		return x.RejectReason
+	}
+	return 0
+}
+
+func (x *Request) GetTxHash() []byte {
+	if x != nil {
+		return x.TxHash
+	}
+	return nil
+}
+
+func (x *Request) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *Request) GetCreatedAt() uint64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Request) GetUpdatedAt() uint64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+// RequestEvent is an audit log entry of an event processed, or action taken, for a request.
+type RequestEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                         // Auto-incremented ID
+	SrcChainId   uint64 `protobuf:"varint,2,opt,name=src_chain_id,json=srcChainId,proto3" json:"src_chain_id,omitempty"`     // Source chain ID of the request
+	ReqId        []byte `protobuf:"bytes,3,opt,name=req_id,json=reqId,proto3" json:"req_id,omitempty"`                       // Request ID; 32 bytes.
+	EventStatus  uint32 `protobuf:"varint,4,opt,name=event_status,json=eventStatus,proto3" json:"event_status,omitempty"`    // Status of the processed event
+	Status       uint32 `protobuf:"varint,5,opt,name=status,proto3" json:"status,omitempty"`                                 // Observed on-chain status when processing the event
+	Height       uint64 `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`                                 // Source chain height of the event
+	Action       uint32 `protobuf:"varint,7,opt,name=action,proto3" json:"action,omitempty"`                                 // Solver action taken, if any; accept, reject, fulfill, claim.
+	RejectReason uint32 `protobuf:"varint,8,opt,name=reject_reason,json=rejectReason,proto3" json:"reject_reason,omitempty"` // Reject reason if action is reject
+	TxHash       []byte `protobuf:"bytes,9,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`                    // Transaction hash of the action, if any
+	Error        string `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`                                   // Error of the action, if any
+	CreatedAt    uint64 `protobuf:"varint,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`         // Unix timestamp (seconds) when the event was recorded
+}
+
+func (x *RequestEvent) Reset() {
+	*x = RequestEvent{}
+	mi := &file_solver_app_solver_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestEvent) ProtoMessage() {}
+
+func (x *RequestEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_solver_app_solver_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestEvent.ProtoReflect.Descriptor instead.
+func (*RequestEvent) Descriptor() ([]byte, []int) {
+	return file_solver_app_solver_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RequestEvent) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RequestEvent) GetSrcChainId() uint64 {
+	if x != nil {
+		return x.SrcChainId
+	}
+	return 0
+}
+
+func (x *RequestEvent) GetReqId() []byte {
+	if x != nil {
+		return x.ReqId
+	}
+	return nil
+}
+
+func (x *RequestEvent) GetEventStatus() uint32 {
+	if x != nil {
+		return x.EventStatus
+	}
+	return 0
+}
+
+func (x *RequestEvent) GetStatus() uint32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *RequestEvent) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *RequestEvent) GetAction() uint32 {
+	if x != nil {
+		return x.Action
+	}
+	return 0
+}
+
+func (x *RequestEvent) GetRejectReason() uint32 {
+	if x != nil {
+		return x.RejectReason
+	}
+	return 0
+}
+
+func (x *RequestEvent) GetTxHash() []byte {
+	if x != nil {
+		return x.TxHash
+	}
+	return nil
+}
+
+func (x *RequestEvent) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *RequestEvent) GetCreatedAt() uint64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// DeferredFulfill is an accepted request awaiting source chain finality before being fulfilled.
+type DeferredFulfill struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SrcChainId uint64 `protobuf:"varint,1,opt,name=src_chain_id,json=srcChainId,proto3" json:"src_chain_id,omitempty"` // Source chain ID of the request
+	ReqId      []byte `protobuf:"bytes,2,opt,name=req_id,json=reqId,proto3" json:"req_id,omitempty"`                   // Request ID; 32 bytes.
+	Height     uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`                             // Source chain height of the processed Accepted event
+}
+
+func (x *DeferredFulfill) Reset() {
+	*x = DeferredFulfill{}
+	mi := &file_solver_app_solver_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeferredFulfill) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeferredFulfill) ProtoMessage() {}
+
+func (x *DeferredFulfill) ProtoReflect() protoreflect.Message {
+	mi := &file_solver_app_solver_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeferredFulfill.ProtoReflect.Descriptor instead.
+func (*DeferredFulfill) Descriptor() ([]byte, []int) {
+	return file_solver_app_solver_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DeferredFulfill) GetSrcChainId() uint64 {
+	if x != nil {
+		return x.SrcChainId
+	}
+	return 0
+}
+
+func (x *DeferredFulfill) GetReqId() []byte {
+	if x != nil {
+		return x.ReqId
+	}
+	return nil
+}
+
+func (x *DeferredFulfill) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+// PendingClaim is a fulfilled request queued for claiming.
+type PendingClaim struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SrcChainId uint64 `protobuf:"varint,1,opt,name=src_chain_id,json=srcChainId,proto3" json:"src_chain_id,omitempty"` // Source chain ID of the request
+	ReqId      []byte `protobuf:"bytes,2,opt,name=req_id,json=reqId,proto3" json:"req_id,omitempty"`                   // Request ID; 32 bytes.
+}
+
+func (x *PendingClaim) Reset() {
+	*x = PendingClaim{}
+	mi := &file_solver_app_solver_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PendingClaim) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PendingClaim) ProtoMessage() {}
+
+func (x *PendingClaim) ProtoReflect() protoreflect.Message {
+	mi := &file_solver_app_solver_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PendingClaim.ProtoReflect.Descriptor instead.
+func (*PendingClaim) Descriptor() ([]byte, []int) {
+	return file_solver_app_solver_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PendingClaim) GetSrcChainId() uint64 {
+	if x != nil {
+		return x.SrcChainId
+	}
+	return 0
+}
+
+func (x *PendingClaim) GetReqId() []byte {
+	if x != nil {
+		return x.ReqId
+	}
+	return nil
+}
+
 var File_solver_app_solver_proto protoreflect.FileDescriptor
 
 var file_solver_app_solver_proto_rawDesc = []byte{
@@ -97,17 +457,75 @@ var file_solver_app_solver_proto_rawDesc = []byte{
 	0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
 	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x3a, 0x1f, 0xf2, 0x9e, 0xd3, 0x8e, 0x03, 0x19, 0x0a, 0x15,
 	0x0a, 0x13, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x2c, 0x63, 0x6f, 0x6e, 0x66, 0x5f,
-	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x42, 0x8f, 0x01, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x2e,
-	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x70, 0x42, 0x0b, 0x53, 0x6f, 0x6c, 0x76,
-	0x65, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x27, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x6d, 0x6e, 0x69, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f,
-	0x72, 0x6b, 0x2f, 0x6f, 0x6d, 0x6e, 0x69, 0x2f, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x2f, 0x61,
-	0x70, 0x70, 0xa2, 0x02, 0x03, 0x53, 0x41, 0x58, 0xaa, 0x02, 0x0a, 0x53, 0x6f, 0x6c, 0x76, 0x65,
-	0x72, 0x2e, 0x41, 0x70, 0x70, 0xca, 0x02, 0x0a, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x5c, 0x41,
-	0x70, 0x70, 0xe2, 0x02, 0x16, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x5c, 0x41, 0x70, 0x70, 0x5c,
-	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0b, 0x53, 0x6f,
-	0x6c, 0x76, 0x65, 0x72, 0x3a, 0x3a, 0x41, 0x70, 0x70, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x22, 0xd6, 0x02, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0c, 0x73, 0x72, 0x63, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x72, 0x63, 0x43, 0x68,
+	0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x65, 0x71, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x72, 0x65, 0x71, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x72, 0x65, 0x6a,
+	0x65, 0x63, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x78, 0x5f,
+	0x68, 0x61, 0x73, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61,
+	0x73, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x3a, 0x38, 0xf2, 0x9e, 0xd3, 0x8e, 0x03, 0x32, 0x0a, 0x15,
+	0x0a, 0x13, 0x73, 0x72, 0x63, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x2c, 0x72,
+	0x65, 0x71, 0x5f, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x13, 0x73, 0x72, 0x63, 0x5f, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x5f, 0x69, 0x64, 0x2c, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x10, 0x01, 0x18, 0x02,
+	0x22, 0xe0, 0x02, 0x0a, 0x0c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x20, 0x0a, 0x0c, 0x73, 0x72, 0x63, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x72, 0x63, 0x43, 0x68, 0x61, 0x69,
+	0x6e, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x65, 0x71, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x05, 0x72, 0x65, 0x71, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x5f,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x72, 0x65,
+	0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x78,
+	0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x74, 0x78, 0x48,
+	0x61, 0x73, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x3a, 0x29, 0xf2, 0x9e, 0xd3, 0x8e, 0x03, 0x23,
+	0x0a, 0x06, 0x0a, 0x02, 0x69, 0x64, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x73, 0x72, 0x63, 0x5f,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x2c, 0x72, 0x65, 0x71, 0x5f, 0x69, 0x64, 0x10,
+	0x01, 0x18, 0x03, 0x22, 0x83, 0x01, 0x0a, 0x0f, 0x44, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64,
+	0x46, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x12, 0x20, 0x0a, 0x0c, 0x73, 0x72, 0x63, 0x5f, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73,
+	0x72, 0x63, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x65, 0x71,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x72, 0x65, 0x71, 0x49, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x3a, 0x1f, 0xf2, 0x9e, 0xd3, 0x8e, 0x03, 0x19,
+	0x0a, 0x15, 0x0a, 0x13, 0x73, 0x72, 0x63, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64,
+	0x2c, 0x72, 0x65, 0x71, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x22, 0x68, 0x0a, 0x0c, 0x50, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x12, 0x20, 0x0a, 0x0c, 0x73, 0x72, 0x63,
+	0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0a, 0x73, 0x72, 0x63, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x72,
+	0x65, 0x71, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x72, 0x65, 0x71,
+	0x49, 0x64, 0x3a, 0x1f, 0xf2, 0x9e, 0xd3, 0x8e, 0x03, 0x19, 0x0a, 0x15, 0x0a, 0x13, 0x73, 0x72,
+	0x63, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x2c, 0x72, 0x65, 0x71, 0x5f, 0x69,
+	0x64, 0x18, 0x05, 0x42, 0x8f, 0x01, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x6f, 0x6c, 0x76,
+	0x65, 0x72, 0x2e, 0x61, 0x70, 0x70, 0x42, 0x0b, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x27, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x6f, 0x6d, 0x6e, 0x69, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x6f,
+	0x6d, 0x6e, 0x69, 0x2f, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x70, 0xa2, 0x02,
+	0x03, 0x53, 0x41, 0x58, 0xaa, 0x02, 0x0a, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x2e, 0x41, 0x70,
+	0x70, 0xca, 0x02, 0x0a, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x5c, 0x41, 0x70, 0x70, 0xe2, 0x02,
+	0x16, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x5c, 0x41, 0x70, 0x70, 0x5c, 0x47, 0x50, 0x42, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0b, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x72,
+	0x3a, 0x3a, 0x41, 0x70, 0x70, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -122,9 +540,13 @@ func file_solver_app_solver_proto_rawDescGZIP() []byte {
 	return file_solver_app_solver_proto_rawDescData
 }
 
-var file_solver_app_solver_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_solver_app_solver_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_solver_app_solver_proto_goTypes = []any{
-	(*Cursor)(nil), // 0: solver.app.Cursor
+	(*Cursor)(nil),          // 0: solver.app.Cursor
+	(*Request)(nil),         // 1: solver.app.Request
+	(*RequestEvent)(nil),    // 2: solver.app.RequestEvent
+	(*DeferredFulfill)(nil), // 3: solver.app.DeferredFulfill
+	(*PendingClaim)(nil),    // 4: solver.app.PendingClaim
 }
 var file_solver_app_solver_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -145,7 +567,7 @@ func file_solver_app_solver_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_solver_app_solver_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
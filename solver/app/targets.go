@@ -1,27 +1,102 @@
 package app
 
 import (
+	"encoding/json"
+	"os"
+
 	"github.com/omni-network/omni/contracts/bindings"
 	"github.com/omni-network/omni/e2e/solve/devapp"
 	"github.com/omni-network/omni/e2e/solve/symbiotic"
 	"github.com/omni-network/omni/lib/errors"
 	"github.com/omni-network/omni/lib/netconf"
+	"github.com/omni-network/omni/lib/tokens"
 	"github.com/omni-network/omni/solver/types"
+
+	"github.com/ethereum/go-ethereum/common"
 )
 
-var targetsByNetwork = map[netconf.ID][]types.Target{
+// builtinTargets are the targets always supported per network.
+// Additional targets are loaded from the solver targets registry file.
+var builtinTargets = map[netconf.ID][]types.Target{
 	netconf.Devnet: {devapp.MustGetApp(netconf.Devnet), symbiotic.MustGetApp(netconf.Devnet)},
 }
 
-// getTarget returns the target for the given network and call.
-func getTarget(network netconf.ID, call bindings.SolveCall) (types.Target, error) {
-	targets, ok := targetsByNetwork[network]
-	if !ok {
-		return nil, errors.New("no targets for network", "network", network)
+// targetsJSON is the targets registry file format.
+type targetsJSON struct {
+	ERC20Deposits []erc20TargetJSON `json:"erc20_deposits"`
+	Tokens        []tokenJSON       `json:"tokens"`
+}
+
+// tokenJSON maps an ERC20 token to the pricer token used to value it.
+// Amounts are valued using the decimals of the pricer token.
+type tokenJSON struct {
+	ChainID uint64         `json:"chain_id"`
+	Address common.Address `json:"address"`
+	Symbol  tokens.Token   `json:"symbol"`
+}
+
+// registry contains the solver targets and priced tokens.
+type registry struct {
+	Targets targets
+	Tokens  pricedTokens
+}
+
+// targets is a registry of solver targets.
+type targets []types.Target
+
+// loadRegistry returns the builtin targets of the network
+// and the targets and tokens loaded from the registry file at the given path (if not empty).
+func loadRegistry(network netconf.ID, path string) (registry, error) {
+	resp := registry{
+		Targets: targets(builtinTargets[network]),
+		Tokens:  make(pricedTokens),
+	}
+	if path == "" {
+		return resp, nil
+	}
+
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return registry{}, errors.Wrap(err, "read targets file", "path", path)
+	}
+
+	var tj targetsJSON
+	if err := json.Unmarshal(bz, &tj); err != nil {
+		return registry{}, errors.Wrap(err, "unmarshal targets file", "path", path)
+	}
+
+	for _, j := range tj.ERC20Deposits {
+		target, err := newERC20Target(j)
+		if err != nil {
+			return registry{}, errors.Wrap(err, "new erc20 target", "name", j.Name)
+		}
+
+		if _, err := resp.Targets.Get(bindings.SolveCall{DestChainId: target.ChainID(), Target: target.Address()}); err == nil {
+			return registry{}, errors.New("duplicate target", "name", j.Name)
+		}
+
+		resp.Targets = append(resp.Targets, target)
+	}
+
+	for _, j := range tj.Tokens {
+		if j.Symbol.CoingeckoID() == "" {
+			return registry{}, errors.New("unknown token symbol", "symbol", j.Symbol)
+		}
+
+		resp.Tokens[chainToken{ChainID: j.ChainID, Address: j.Address}] = j.Symbol
+	}
+
+	return resp, nil
+}
+
+// Get returns the target for the given call.
+func (t targets) Get(call bindings.SolveCall) (types.Target, error) {
+	if len(t) == 0 {
+		return nil, errors.New("no targets configured")
 	}
 
 	var resp *types.Target
-	for _, target := range targets {
+	for _, target := range t {
 		if target.ChainID() == call.DestChainId && target.Address() == call.Target {
 			if resp != nil {
 				return nil, errors.New("multiple targets found")
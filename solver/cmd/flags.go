@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"github.com/omni-network/omni/lib/netconf"
+	"github.com/omni-network/omni/lib/signer"
 	"github.com/omni-network/omni/lib/xchain"
 	solver "github.com/omni-network/omni/solver/app"
 
@@ -12,6 +13,13 @@ func bindRunFlags(flags *pflag.FlagSet, cfg *solver.Config) {
 	netconf.BindFlag(flags, &cfg.Network)
 	xchain.BindFlags(flags, &cfg.RPCEndpoints)
 	flags.StringVar(&cfg.PrivateKey, "private-key", cfg.PrivateKey, "The path to the private key e.g path/private.key")
+	signer.BindFlags(flags, &cfg.Signer)
 	flags.StringVar(&cfg.MonitoringAddr, "monitoring-addr", cfg.MonitoringAddr, "The address to bind the monitoring server")
+	flags.StringVar(&cfg.APIAddr, "api-addr", cfg.APIAddr, "The address to bind the solver API server")
 	flags.StringVar(&cfg.DBDir, "db-dir", cfg.DBDir, "The path to the database directory")
+	flags.StringVar(&cfg.TargetsFile, "targets-file", cfg.TargetsFile, "The path to the JSON targets registry file")
+	flags.Float64Var(&cfg.MinProfitMargin, "min-profit-margin", cfg.MinProfitMargin, "Minimum estimated profit margin of a request as a fraction of its cost. Negative disables the check")
+	flags.StringToStringVar(&cfg.ClaimBeneficiaries, "claim-beneficiaries", cfg.ClaimBeneficiaries, "Claim beneficiary addresses per source chain, defaults to the solver address. e.g. \"ethereum=0x...,optimism=0x...\"")
+	flags.IntVar(&cfg.ClaimBatchSize, "claim-batch-size", cfg.ClaimBatchSize, "Maximum number of fulfilled requests to claim per batch period, each by its own transaction. Values of 1 or less claim immediately")
+	flags.DurationVar(&cfg.ClaimBatchPeriod, "claim-batch-period", cfg.ClaimBatchPeriod, "Maximum duration to wait before claiming a non-full batch of queued requests")
 }
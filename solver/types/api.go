@@ -0,0 +1,105 @@
+package types
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/omni-network/omni/contracts/bindings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Call is the JSON representation of a SolveCall, the call a request asks the solver to make on the destination chain.
+type Call struct {
+	DestChainID uint64         `json:"dest_chain_id"`
+	Target      common.Address `json:"target"`
+	Value       *hexutil.Big   `json:"value"`
+	Data        hexutil.Bytes  `json:"data"`
+}
+
+// ToBinding returns the call as a SolveCall binding.
+func (c Call) ToBinding() bindings.SolveCall {
+	value := new(big.Int)
+	if c.Value != nil {
+		value = c.Value.ToInt()
+	}
+
+	return bindings.SolveCall{
+		DestChainId: c.DestChainID,
+		Target:      c.Target,
+		Value:       value,
+		Data:        c.Data,
+	}
+}
+
+// Deposit is the JSON representation of a SolveDeposit, a deposit paid to the solver on the source chain.
+type Deposit struct {
+	IsNative bool           `json:"is_native"`
+	Token    common.Address `json:"token"` // Ignored if IsNative
+	Amount   *hexutil.Big   `json:"amount"`
+}
+
+// ToBinding returns the deposit as a SolveDeposit binding.
+func (d Deposit) ToBinding() bindings.SolveDeposit {
+	amount := new(big.Int)
+	if d.Amount != nil {
+		amount = d.Amount.ToInt()
+	}
+
+	return bindings.SolveDeposit{
+		IsNative: d.IsNative,
+		Token:    d.Token,
+		Amount:   amount,
+	}
+}
+
+// CheckRequest is the request body of the solver /check endpoint.
+type CheckRequest struct {
+	SourceChainID uint64    `json:"source_chain_id"`
+	Call          Call      `json:"call"`
+	Deposits      []Deposit `json:"deposits"`
+}
+
+// CheckResponse is the response body of the solver /check endpoint.
+type CheckResponse struct {
+	Accepted          bool   `json:"accepted"`
+	RejectReason      string `json:"reject_reason,omitempty"`
+	RejectDescription string `json:"reject_description,omitempty"`
+}
+
+// QuoteRequest is the request body of the solver /quote endpoint.
+type QuoteRequest struct {
+	SourceChainID uint64         `json:"source_chain_id"`
+	Call          Call           `json:"call"`
+	DepositToken  common.Address `json:"deposit_token"` // Zero address for the native token of the source chain
+}
+
+// QuoteResponse is the response body of the solver /quote endpoint.
+// If the call cannot be fulfilled, only the reject fields are populated.
+type QuoteResponse struct {
+	Deposit           Deposit `json:"deposit"`     // Minimum deposit required for the solver to accept the request
+	DepositUSD        float64 `json:"deposit_usd"` // USD value of the minimum deposit
+	ExpenseUSD        float64 `json:"expense_usd"` // Estimated USD expense of fulfilling the call
+	RejectReason      string  `json:"reject_reason,omitempty"`
+	RejectDescription string  `json:"reject_description,omitempty"`
+}
+
+// StatusResponse is the response body of the solver /status/{reqID} endpoint.
+type StatusResponse struct {
+	SourceChainID uint64       `json:"source_chain_id"`
+	RequestID     common.Hash  `json:"request_id"`
+	Status        string       `json:"status"`                  // Latest observed on-chain status
+	Action        string       `json:"action"`                  // Latest solver action
+	RejectReason  string       `json:"reject_reason,omitempty"` // Only populated for reject actions
+	TxHash        *common.Hash `json:"tx_hash,omitempty"`
+	Error         string       `json:"error,omitempty"`
+	Height        uint64       `json:"height"` // Source chain height of the latest processed event
+	CreatedAt     time.Time    `json:"created_at"`
+	UpdatedAt     time.Time    `json:"updated_at"`
+}
+
+// ErrorResponse is the response body of the solver API on error.
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
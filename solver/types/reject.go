@@ -0,0 +1,20 @@
+package types
+
+//go:generate stringer -type=RejectReason -trimprefix=Reject
+
+// RejectReason is the reason a solver rejects a request.
+// It matches the Solve.RejectReason enum in the SolveInbox contract.
+type RejectReason uint8
+
+const (
+	RejectNone                  RejectReason = 0
+	RejectDestCallReverts       RejectReason = 1
+	RejectInsufficientFee       RejectReason = 2
+	RejectInsufficientInventory RejectReason = 3
+	RejectNoTarget              RejectReason = 4
+	RejectUnsupportedSrcChain   RejectReason = 5
+	RejectUnsupportedToken      RejectReason = 6
+	RejectInsufficientDeposit   RejectReason = 7
+	RejectInvalidCall           RejectReason = 8
+	RejectExpired               RejectReason = 9
+)
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/omni-network/omni/contracts/bindings"
+	"github.com/omni-network/omni/lib/xchain"
 
 	"github.com/ethereum/go-ethereum/common"
 )
@@ -16,12 +17,16 @@ type Target interface {
 	// Address returns the address of the target contract.
 	Address() common.Address
 
+	// FulfillConfLevel returns the source chain confirmation level a request must reach before it is fulfilled.
+	// Targets return xchain.ConfFinalized to avoid fronting funds for deposits that may be reorged away.
+	FulfillConfLevel() xchain.ConfLevel
+
 	// TokenPrereqs returns the token prerequisites required for the call.
 	TokenPrereqs(call bindings.SolveCall) ([]bindings.SolveTokenPrereq, error)
 
-	// Verify returns an error if the call should not be fulfilled.
-	// TODO(corver): Return reject reason.
-	Verify(srcChainID uint64, call bindings.SolveCall, deposits []bindings.SolveDeposit) error
+	// Verify returns a reject reason and an error describing it if the call should not be fulfilled.
+	// It returns RejectNone and nil if the call can be fulfilled.
+	Verify(srcChainID uint64, call bindings.SolveCall, deposits []bindings.SolveDeposit) (RejectReason, error)
 
 	// DebugCall logs the call for debugging purposes.
 	DebugCall(ctx context.Context, call bindings.SolveCall) error